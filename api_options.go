@@ -1,6 +1,7 @@
 package xmatters
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"time"
@@ -37,13 +38,31 @@ func WithHeaders(headers http.Header) Option {
 
 // WithRateLimit applies a non-default rate limit to client API requests
 // If not specified the default of 4rps will be applied.
+// Requests waiting for the rate limit are sent in order of priority, as set with Prioritized.
 func WithRateLimit(rps float64) Option {
 	return func(xmatters *XMattersAPI) error {
 		// because ratelimiter doesnt do any windowing
 		// setting burst makes it difficult to enforce a fixed rate
 		// so setting it equal to 1 this effectively disables bursting
 		// this doesn't check for sensible values, ultimately the xmatters will enforce that the value is ok
-		xmatters.rateLimiter = rate.NewLimiter(rate.Limit(rps), 1)
+		xmatters.scheduler = newScheduler(rate.NewLimiter(rate.Limit(rps), 1))
+		return nil
+	}
+}
+
+// WithMaxInFlight limits the number of requests the client has in flight at once, including requests waiting
+// to be retried, so a burst of goroutines sharing the client cannot open hundreds of simultaneous connections
+// to xMatters. Requests above the limit wait for one in flight to complete. The limit applies independently of
+// the rate limit, and is shared by the clients returned by Prioritized. Zero means no limit.
+func WithMaxInFlight(maxRequests int) Option {
+	return func(xmatters *XMattersAPI) error {
+		if maxRequests < 0 {
+			return fmt.Errorf("max requests in flight must not be negative, got %d", maxRequests)
+		}
+		xmatters.inFlight = nil
+		if maxRequests > 0 {
+			xmatters.inFlight = make(chan struct{}, maxRequests)
+		}
 		return nil
 	}
 }
@@ -62,6 +81,98 @@ func WithRetryPolicy(maxRetries int, minRetryDelaySecs int, maxRetryDelaySecs in
 	}
 }
 
+// WithMaxIdleConnsPerHost sets how many idle connections the default transport keeps open for reuse.
+// The default of GOMAXPROCS+1 serializes highly concurrent bulk operations on a few connections and makes
+// the transport open and discard a new connection for every request above it, which can exhaust ephemeral ports.
+// Like the other connection pool options, it has no effect on a client provided with WithHTTPClient.
+func WithMaxIdleConnsPerHost(maxIdle int) Option {
+	return func(xmatters *XMattersAPI) error {
+		if maxIdle < 1 {
+			return fmt.Errorf("max idle connections per host must be at least 1, got %d", maxIdle)
+		}
+		xmatters.transport.MaxIdleConnsPerHost = maxIdle
+		if xmatters.transport.MaxIdleConns < maxIdle {
+			xmatters.transport.MaxIdleConns = maxIdle
+		}
+		return nil
+	}
+}
+
+// WithMaxConnsPerHost limits the number of connections the default transport opens to xMatters,
+// including connections in use. Requests above the limit wait for a connection. Zero means no limit.
+func WithMaxConnsPerHost(maxConns int) Option {
+	return func(xmatters *XMattersAPI) error {
+		if maxConns < 0 {
+			return fmt.Errorf("max connections per host must not be negative, got %d", maxConns)
+		}
+		xmatters.transport.MaxConnsPerHost = maxConns
+		return nil
+	}
+}
+
+// WithKeepAlive configures how the default transport keeps connections alive.
+// The period is the interval between TCP keep-alive probes, and a negative period disables them.
+// The idle timeout is how long an idle connection is kept for reuse, and zero keeps it until the server closes it.
+// Defaults are a 30 second period and a 90 second idle timeout.
+func WithKeepAlive(period, idleTimeout time.Duration) Option {
+	return func(xmatters *XMattersAPI) error {
+		if idleTimeout < 0 {
+			return fmt.Errorf("idle connection timeout must not be negative, got %v", idleTimeout)
+		}
+		xmatters.dialer.KeepAlive = period
+		xmatters.transport.IdleConnTimeout = idleTimeout
+		return nil
+	}
+}
+
+// WithHTTP2 enables or disables HTTP/2 on the default transport. HTTP/2 is enabled by default and
+// multiplexes concurrent requests over a single connection. Disabling it uses HTTP/1.1 connections,
+// which are tuned with WithMaxIdleConnsPerHost and WithMaxConnsPerHost.
+func WithHTTP2(enabled bool) Option {
+	return func(xmatters *XMattersAPI) error {
+		xmatters.transport.ForceAttemptHTTP2 = enabled
+		if enabled {
+			xmatters.transport.TLSNextProto = nil
+		} else {
+			// A non-nil, empty map disables HTTP/2 on the transport
+			xmatters.transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+		}
+		return nil
+	}
+}
+
+// WithMaxPages limits the number of pages a single list call, such as GetPersonList, retrieves.
+// A list with more pages returns the items of the pages retrieved so far with ErrTruncated,
+// so a filter that matches far more records than intended cannot pull the whole instance into memory.
+// Zero means no limit.
+func WithMaxPages(maxPages int) Option {
+	return func(xmatters *XMattersAPI) error {
+		if maxPages < 0 {
+			return fmt.Errorf("max pages must not be negative, got %d", maxPages)
+		}
+		xmatters.maxPages = maxPages
+		return nil
+	}
+}
+
+// WithMaxItems limits the number of items a single list call retrieves across all of its pages.
+// A list with more items returns the first maxItems items with ErrTruncated. Zero means no limit.
+// Example usage:
+//
+//	people, err := client.GetPersonList(params)
+//	if errors.Is(err, xmatters.ErrTruncated) {
+//	    log.Printf("xmatters: only the first %d people were retrieved", len(people))
+//	}
+func WithMaxItems(maxItems int) Option {
+	return func(xmatters *XMattersAPI) error {
+		if maxItems < 0 {
+			return fmt.Errorf("max items must not be negative, got %d", maxItems)
+		}
+		xmatters.maxItems = maxItems
+		return nil
+	}
+}
+
 // Debug is an option for configuring the XMattersAPI client to enable or disable debugging mode.
 // When debugging is enabled, additional information and logs may be output to aid in troubleshooting.
 // Use this option by passing a pointer to a boolean indicating whether debugging should be enabled.
@@ -76,6 +187,108 @@ func Debug(debug bool) Option {
 	}
 }
 
+// WithLenientDecoding enables lenient decoding of API responses.
+// By default a response field that does not match its model, such as a number returned as a string,
+// fails the whole call with an unmarshal error. In lenient mode the value is coerced to the type of the field,
+// or dropped if it cannot be coerced, and the handler is called with a warning for each change.
+// List items that cannot be decoded are removed from the list instead of failing the list call.
+// The handler may be called concurrently when the client is shared between goroutines.
+// Example usage:
+//
+//	client, err := xmatters.NewWithToken(&hostname, &token, xmatters.WithLenientDecoding(func(w xmatters.DecodeWarning) {
+//	    log.Printf("xmatters: %s", w)
+//	}))
+func WithLenientDecoding(handler DecodeWarningHandler) Option {
+	return func(xmatters *XMattersAPI) error {
+		xmatters.decodeWarnings = handler
+		return nil
+	}
+}
+
+// WithStrictNumbers disables the conversion of numbers with an integral value, such as a count returned as 10.0,
+// into integer fields. By default such numbers are converted exactly, while numbers that would lose precision,
+// such as 10.5, fail the call with an unmarshal error. In strict mode any number that is not written as an integer
+// fails an integer field, matching encoding/json.
+func WithStrictNumbers(strict bool) Option {
+	return func(xmatters *XMattersAPI) error {
+		xmatters.strictNumbers = strict
+		return nil
+	}
+}
+
+// WithProgressReporter reports the progress of long-running operations to the reporter: each page of
+// a paginated list, each item of a batch Get such as GetPeopleByIDs, each membership change made by
+// PushGroupRoster, and the progress of snapshot exports and imports.
+func WithProgressReporter(reporter ProgressReporter) Option {
+	return func(xmatters *XMattersAPI) error {
+		xmatters.progress = reporter
+		return nil
+	}
+}
+
+// WithHolidays provides the holidays of the instance to the client. AnalyzeCoverage does not count members
+// during company-wide holidays when all of their devices have timeframes that exclude holidays, as xMatters
+// would not notify them.
+func WithHolidays(calendar *HolidayCalendar) Option {
+	return func(xmatters *XMattersAPI) error {
+		if err := calendar.Validate(); err != nil {
+			return err
+		}
+		xmatters.holidays = calendar
+		return nil
+	}
+}
+
+// WithCapabilityRoles sets the roles that grant each Capability reported by GetCapabilities, for instances
+// where the permissions of roles were configured or custom roles were added. Capabilities that are not listed
+// are never granted. The roles are copied, so later changes to the map do not affect the client.
+// Start from DefaultCapabilityRoles to extend the default roles.
+func WithCapabilityRoles(roles CapabilityRoles) Option {
+	return func(xmatters *XMattersAPI) error {
+		xmatters.capabilityRoles = copyCapabilityRoles(roles)
+		return nil
+	}
+}
+
+// WithSupervisoryRoles sets the roles that allow a person to supervise other people, which SetPersonSupervisors
+// checks each supervisor for, for instances with custom supervisory roles. Roles are matched without regard to case.
+// It defaults to the Person Supervisor, Company Supervisor, and Full Access User roles.
+func WithSupervisoryRoles(roles ...string) Option {
+	return func(xmatters *XMattersAPI) error {
+		if len(roles) == 0 {
+			return newValidationError("at least one supervisory role is required")
+		}
+		xmatters.supervisoryRoles = append([]string(nil), roles...)
+		return nil
+	}
+}
+
+// WithIncrementalSyncOverlap sets how far before the previous watermark an incremental sync, such as SyncPeople,
+// starts, so records created while the previous sync was running, or recorded with a slightly different server clock,
+// are not missed. Records within the overlap are returned again, so callers should upsert synced records by ID.
+// It defaults to one minute; zero disables the overlap.
+func WithIncrementalSyncOverlap(overlap time.Duration) Option {
+	return func(xmatters *XMattersAPI) error {
+		if overlap < 0 {
+			return newValidationError("the incremental sync overlap must not be negative")
+		}
+		xmatters.syncOverlap = &overlap
+		return nil
+	}
+}
+
+// WithDedupKeyProperty sets the name of the form property that the deduplication helpers, such as
+// TriggerEventDeduplicated, store the deduplication key of an event in. It defaults to DedupKeyProperty.
+func WithDedupKeyProperty(name string) Option {
+	return func(xmatters *XMattersAPI) error {
+		if name == "" {
+			return newValidationError("a deduplication key property name is required")
+		}
+		xmatters.dedupKeyProperty = name
+		return nil
+	}
+}
+
 // parseOptions parses the supplied options functions and returns a configured *XMattersAPI instance
 func (xmatters *XMattersAPI) parseOptions(opts ...Option) error {
 	// Range over each options function and apply it to our XMattersAPI type to
@@ -90,3 +303,89 @@ func (xmatters *XMattersAPI) parseOptions(opts ...Option) error {
 
 	return nil
 }
+
+// GetOption is a functional option for configuring a single Get method call,
+// such as GetPerson or GetGroup.
+type GetOption func(*getOptions)
+
+// getOptions holds the query parameters configured by GetOption functions.
+type getOptions struct {
+	Embed  string `url:"embed,omitempty"`
+	Fields string `url:"fields,omitempty"`
+}
+
+// WithEmbed replaces the objects a Get method embeds in its response by default.
+// Calling WithEmbed with no values requests the object without any embedded objects.
+// Example usage:
+//
+//	person, err := client.GetPerson("jsmith", WithEmbed("roles"))
+func WithEmbed(objects ...string) GetOption {
+	return func(opts *getOptions) {
+		opts.Embed = joinValues(objects)
+	}
+}
+
+// WithFields limits the response of a Get method to the provided fields.
+func WithFields(fields ...string) GetOption {
+	return func(opts *getOptions) {
+		opts.Fields = joinValues(fields)
+	}
+}
+
+// parseGetOptions applies the supplied GetOption functions over the default embedded objects of a Get method
+// and returns the resulting query parameters.
+func parseGetOptions(defaultEmbed string, opts []GetOption) getOptions {
+	options := getOptions{Embed: defaultEmbed}
+	for _, option := range opts {
+		option(&options)
+	}
+	return options
+}
+
+// PushOption is a functional option for configuring a single Push method call, such as PushPerson or PushGroup.
+type PushOption func(*pushOptions)
+
+// pushOptions holds the settings configured by PushOption functions.
+type pushOptions struct {
+	unchanged   any
+	externalKey string
+}
+
+// IfUnchanged makes a Push method that updates an object fail with ErrConflict when the object in xMatters
+// differs from read, the copy of the object it was last read as. The object is read again and compared just before
+// it is pushed, so a retried or concurrent update does not overwrite changes made by someone else in between.
+// The comparison ignores the embedded collections that read does not have, and the last login of a person.
+// The read and the push are separate requests, so a change made between them is not detected.
+// Example usage:
+//
+//	person, err := client.GetPerson("jsmith")
+//	params := person.PushParams()
+//	params.LastName = "Smith-Jones"
+//	_, err = client.PushPerson(params, xmatters.IfUnchanged(person))
+//	if errors.Is(err, xmatters.ErrConflict) {
+//	    // read the person again and reapply the change
+//	}
+func IfUnchanged[T Person | Group | Service](read T) PushOption {
+	return func(opts *pushOptions) {
+		opts.unchanged = read
+	}
+}
+
+// WithExternalKey sets the external key of the object pushed by PushPerson, PushGroup, or PushDevice and marks
+// the object as externally owned, so every object synced from a system of record is keyed and flagged the same
+// way. The object can then be found with GetPersonByExternalKey or GetDeviceByExternalKey, without keeping a table
+// of xMatters IDs.
+func WithExternalKey(externalKey string) PushOption {
+	return func(opts *pushOptions) {
+		opts.externalKey = externalKey
+	}
+}
+
+// parsePushOptions applies the supplied PushOption functions and returns the resulting settings.
+func parsePushOptions(opts []PushOption) pushOptions {
+	var options pushOptions
+	for _, option := range opts {
+		option(&options)
+	}
+	return options
+}
@@ -0,0 +1,255 @@
+package apply
+
+import (
+	"fmt"
+
+	"github.com/xmatters/xmatters-go"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Apply Structs
+// -------------------------------------------------------------------------------------------------
+
+// Result is the change planned or made for a single object, such as a person or the roster of a group.
+// Key is the human-readable identifier of the object. Err is set when the object could not be applied,
+// in which case Action is the change that was attempted, or empty if no change could be computed.
+type Result struct {
+	Resource xmatters.SnapshotResource
+	Key      string
+	Action   xmatters.ImportAction
+	Err      error
+}
+
+// Report lists a Result for every object that was, or would be, created or updated, and for every object
+// that failed. Objects that already match the manifest have no result. Skipped lists the resource types that
+// could not be applied.
+type Report struct {
+	Results []*Result
+	Skipped []xmatters.SnapshotResource
+}
+
+// -------------------------------------------------------------------------------------------------
+// Apply Methods
+// -------------------------------------------------------------------------------------------------
+
+// String returns a one-line description of the result, such as `CREATE people "jsmith"`.
+func (r *Result) String() string {
+	action := string(r.Action)
+	if action == "" {
+		action = "FAIL"
+	}
+	if r.Err != nil {
+		return fmt.Sprintf("%s %s %q: %v", action, r.Resource, r.Key, r.Err)
+	}
+	return fmt.Sprintf("%s %s %q", action, r.Resource, r.Key)
+}
+
+// Failed reports whether any object failed to apply.
+func (r *Report) Failed() bool {
+	for _, result := range r.Results {
+		if result.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Plan reads the current state of the instance and reports the changes Apply would make, without making them.
+// References to objects that would be created are resolved, so a plan can be reviewed before the first apply.
+func Plan(client *xmatters.XMattersAPI, manifest *Manifest) (*Report, error) {
+	return run(client, manifest, true)
+}
+
+// Apply reconciles the instance with the manifest and reports the changes it made. Resource types are
+// applied in dependency order: sites, people and their supervisors, devices, groups, rosters, shifts, services,
+// and service dependencies.
+// Failures for individual objects are reported in their results and the apply continues; an error is only
+// returned if the current state of the instance cannot be read.
+func Apply(client *xmatters.XMattersAPI, manifest *Manifest) (*Report, error) {
+	return run(client, manifest, false)
+}
+
+// run converts the manifest into a snapshot and imports it into the instance.
+func run(client *xmatters.XMattersAPI, manifest *Manifest, dryRun bool) (*Report, error) {
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+	importer := xmatters.NewImporter(client)
+	importer.DryRun = dryRun
+	importer.ResolveNames = true
+	importReport, err := importer.Import(manifest.snapshot())
+	if err != nil {
+		return nil, err
+	}
+	return newReport(importReport), nil
+}
+
+// newReport merges the changes and errors of an import into a single result per object.
+func newReport(importReport *xmatters.ImportReport) *Report {
+	report := &Report{Results: []*Result{}, Skipped: importReport.Skipped}
+	results := make(map[[2]string]*Result)
+	for _, change := range importReport.Changes {
+		result := &Result{Resource: change.Resource, Key: change.Key, Action: change.Action}
+		results[[2]string{string(change.Resource), change.Key}] = result
+		report.Results = append(report.Results, result)
+	}
+	for _, importErr := range importReport.Errors {
+		result, ok := results[[2]string{string(importErr.Resource), importErr.Key}]
+		if !ok {
+			result = &Result{Resource: importErr.Resource, Key: importErr.Key}
+			report.Results = append(report.Results, result)
+		}
+		result.Err = importErr.Err
+	}
+	return report
+}
+
+// snapshot converts the manifest into a snapshot for the importer. The human-readable identifier of each
+// object is used as its ID, so references resolve to declared objects or, by name, to existing ones.
+func (m *Manifest) snapshot() *xmatters.Snapshot {
+	snapshot := &xmatters.Snapshot{
+		Rosters: make(map[string][]*xmatters.GroupMember),
+		Shifts:  make(map[string][]*xmatters.Shift),
+	}
+
+	for _, site := range m.Sites {
+		snapshot.Sites = append(snapshot.Sites, &xmatters.Site{
+			ID:         xmatters.StringPtr(site.Name),
+			Name:       xmatters.StringPtr(site.Name),
+			Country:    xmatters.StringPtr(site.Country),
+			Language:   xmatters.StringPtr(site.Language),
+			Timezone:   xmatters.StringPtr(site.Timezone),
+			Address1:   site.Address1,
+			Address2:   site.Address2,
+			City:       site.City,
+			State:      site.State,
+			PostalCode: site.PostalCode,
+			Latitude:   site.Latitude,
+			Longitude:  site.Longitude,
+			Status:     xmatters.StringPtr(statusOrActive(site.Status)),
+		})
+	}
+
+	for _, person := range m.People {
+		converted := &xmatters.Person{
+			ID:              xmatters.StringPtr(person.TargetName),
+			TargetName:      xmatters.StringPtr(person.TargetName),
+			FirstName:       xmatters.StringPtr(person.FirstName),
+			LastName:        xmatters.StringPtr(person.LastName),
+			Status:          xmatters.StringPtr(statusOrActive(person.Status)),
+			Language:        xmatters.StringPtr(person.Language),
+			Timezone:        xmatters.StringPtr(person.Timezone),
+			LicenseType:     xmatters.StringPtr(person.LicenseType),
+			WebLogin:        xmatters.StringPtr(person.WebLogin),
+			PhoneLogin:      person.PhoneLogin,
+			ExternalKey:     person.ExternalKey,
+			ExternallyOwned: person.ExternallyOwned,
+			Site:            reference(person.Site),
+		}
+		for _, role := range person.Roles {
+			converted.Roles = append(converted.Roles, &xmatters.Role{Name: xmatters.StringPtr(role)})
+		}
+		// Omitted supervisors are applied as empty, like the other fields of the person
+		converted.Supervisors = []*xmatters.Person{}
+		for _, supervisor := range person.Supervisors {
+			converted.Supervisors = append(converted.Supervisors, &xmatters.Person{ID: xmatters.StringPtr(supervisor), TargetName: xmatters.StringPtr(supervisor)})
+		}
+		snapshot.People = append(snapshot.People, converted)
+	}
+
+	for _, device := range m.Devices {
+		snapshot.Devices = append(snapshot.Devices, &xmatters.Device{
+			ID:                xmatters.StringPtr(device.key()),
+			Owner:             &xmatters.PersonReference{ID: xmatters.StringPtr(device.Owner), TargetName: xmatters.StringPtr(device.Owner)},
+			Name:              xmatters.StringPtr(device.Name),
+			DeviceType:        xmatters.StringPtr(device.DeviceType),
+			EmailAddress:      device.EmailAddress,
+			PhoneNumber:       device.PhoneNumber,
+			Country:           device.Country,
+			DefaultDevice:     device.DefaultDevice,
+			Delay:             device.Delay,
+			Sequence:          device.Sequence,
+			PriorityThreshold: device.PriorityThreshold,
+			TestStatus:        device.TestStatus,
+			TwoWayDevice:      device.TwoWayDevice,
+			Timeframes:        device.Timeframes,
+			ExternalKey:       device.ExternalKey,
+			ExternallyOwned:   device.ExternallyOwned,
+			Status:            xmatters.StringPtr(statusOrActive(device.Status)),
+		})
+	}
+
+	for _, group := range m.Groups {
+		converted := &xmatters.Group{
+			ID:                xmatters.StringPtr(group.TargetName),
+			TargetName:        xmatters.StringPtr(group.TargetName),
+			Description:       group.Description,
+			GroupType:         xmatters.StringPtr(group.GroupType),
+			Site:              reference(group.Site),
+			ObservedByAll:     group.ObservedByAll,
+			AllowDuplicates:   group.AllowDuplicates,
+			UseDefaultDevices: group.UseDefaultDevices,
+			ExternalKey:       group.ExternalKey,
+			ExternallyOwned:   group.ExternallyOwned,
+			Status:            xmatters.StringPtr(statusOrActive(group.Status)),
+		}
+		if len(group.Supervisors) > 0 {
+			converted.Supervisors = xmatters.NewReferencesById(group.Supervisors...)
+		}
+		if len(group.Observers) > 0 {
+			converted.Observers = xmatters.NewReferencesByName(group.Observers...)
+		}
+		snapshot.Groups = append(snapshot.Groups, converted)
+
+		if group.Members != nil {
+			members := []*xmatters.GroupMember{}
+			for _, member := range group.Members {
+				name, recipientType, _ := member.reference()
+				members = append(members, xmatters.NewGroupMember(name, recipientType))
+			}
+			snapshot.Rosters[group.TargetName] = members
+		}
+		if len(group.Shifts) > 0 {
+			snapshot.Shifts[group.TargetName] = group.Shifts
+		}
+	}
+
+	for _, service := range m.Services {
+		converted := &xmatters.Service{
+			ID:           xmatters.StringPtr(service.TargetName),
+			TargetName:   xmatters.StringPtr(service.TargetName),
+			Description:  service.Description,
+			ServiceType:  xmatters.StringPtr(service.ServiceType),
+			ServiceTier:  service.ServiceTier,
+			ServiceLinks: service.ServiceLinks,
+		}
+		if service.OwnedBy != "" {
+			converted.OwnedBy = &xmatters.GroupReference{ID: xmatters.StringPtr(service.OwnedBy), TargetName: xmatters.StringPtr(service.OwnedBy)}
+		}
+		snapshot.Services = append(snapshot.Services, converted)
+
+		for _, dependency := range service.DependsOn {
+			snapshot.ServiceDependencies = append(snapshot.ServiceDependencies, &xmatters.ServiceDependency{
+				Service:          &xmatters.ServiceReference{ID: xmatters.StringPtr(dependency), TargetName: xmatters.StringPtr(dependency)},
+				DependentService: &xmatters.ServiceReference{ID: xmatters.StringPtr(service.TargetName), TargetName: xmatters.StringPtr(service.TargetName)},
+			})
+		}
+	}
+	return snapshot
+}
+
+// reference returns a reference to the object with the given name, or nil if the name is empty.
+func reference(name string) *xmatters.ReferenceById {
+	if name == "" {
+		return nil
+	}
+	return xmatters.NewReferenceById(name)
+}
+
+// statusOrActive returns the status, or ACTIVE if the status is empty.
+func statusOrActive(status string) string {
+	if status == "" {
+		return "ACTIVE"
+	}
+	return status
+}
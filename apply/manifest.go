@@ -0,0 +1,260 @@
+// Package apply reconciles an xMatters instance with a declarative YAML manifest of directory resources:
+// sites, people, devices, groups with their members and shifts, and services with their dependencies.
+//
+// Objects are matched by their human-readable identifiers, and references between objects use those
+// identifiers too: sites by name, people, groups, and services by target name, and devices by owner and
+// device name, such as "jsmith|Work Email". References may also name objects that already exist in the
+// instance without being declared in the manifest. Objects in the instance that are not in the manifest
+// are left unchanged.
+//
+// Usage:
+//
+//	manifest, err := apply.Load("directory.yaml")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	plan, err := apply.Plan(client, manifest)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, result := range plan.Results {
+//	    fmt.Println(result)
+//	}
+//	report, err := apply.Apply(client, manifest)
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/xmatters/xmatters-go"
+	"gopkg.in/yaml.v3"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Manifest Structs
+// -------------------------------------------------------------------------------------------------
+
+// Manifest describes the desired state of the directory resources of an xMatters instance.
+// Fields omitted from an object are applied as empty, so fields that xMatters fills in with defaults,
+// such as the language and timezone of a person, should be set to avoid updating the object on every apply.
+type Manifest struct {
+	Sites    []*Site    `json:"sites"`
+	People   []*Person  `json:"people"`
+	Devices  []*Device  `json:"devices"`
+	Groups   []*Group   `json:"groups"`
+	Services []*Service `json:"services"`
+}
+
+// Site is the desired state of a site. Status defaults to ACTIVE.
+type Site struct {
+	Name       string   `json:"name"`
+	Country    string   `json:"country"`
+	Language   string   `json:"language"`
+	Timezone   string   `json:"timezone"`
+	Address1   *string  `json:"address1"`
+	Address2   *string  `json:"address2"`
+	City       *string  `json:"city"`
+	State      *string  `json:"state"`
+	PostalCode *string  `json:"postalCode"`
+	Latitude   *float64 `json:"latitude"`
+	Longitude  *float64 `json:"longitude"`
+	Status     string   `json:"status"`
+}
+
+// Person is the desired state of a person. Site and Supervisors reference a site by name and people by
+// target name, and supervisors are assigned once every person has been applied. Status defaults to ACTIVE.
+type Person struct {
+	TargetName      string   `json:"targetName"`
+	FirstName       string   `json:"firstName"`
+	LastName        string   `json:"lastName"`
+	Roles           []string `json:"roles"`
+	Site            string   `json:"site"`
+	Supervisors     []string `json:"supervisors"`
+	Status          string   `json:"status"`
+	Language        string   `json:"language"`
+	Timezone        string   `json:"timezone"`
+	LicenseType     string   `json:"licenseType"`
+	WebLogin        string   `json:"webLogin"`
+	PhoneLogin      *string  `json:"phoneLogin"`
+	ExternalKey     *string  `json:"externalKey"`
+	ExternallyOwned *bool    `json:"externallyOwned"`
+}
+
+// Device is the desired state of a device owned by the person with the target name Owner.
+// Status defaults to ACTIVE.
+type Device struct {
+	Owner             string                      `json:"owner"`
+	Name              string                      `json:"name"`
+	DeviceType        string                      `json:"deviceType"`
+	EmailAddress      *string                     `json:"emailAddress"`
+	PhoneNumber       *string                     `json:"phoneNumber"`
+	Country           *string                     `json:"country"`
+	DefaultDevice     *bool                       `json:"defaultDevice"`
+	Delay             *int32                      `json:"delay"`
+	Sequence          *int32                      `json:"sequence"`
+	PriorityThreshold *string                     `json:"priorityThreshold"`
+	TestStatus        *string                     `json:"testStatus"`
+	TwoWayDevice      *bool                       `json:"twoWayDevice"`
+	Timeframes        []*xmatters.DeviceTimeframe `json:"timeframes"`
+	ExternalKey       *string                     `json:"externalKey"`
+	ExternallyOwned   *bool                       `json:"externallyOwned"`
+	Status            string                      `json:"status"`
+}
+
+// Group is the desired state of a group. Site and Supervisors reference a site by name and people by
+// target name, and Observers lists the names of the roles that can observe the group. Status defaults to ACTIVE.
+// The roster of the group is replaced by Members unless Members is omitted. Shifts use the field names of
+// the xMatters API and are matched by name; the recipients of their members reference people, groups, and
+// devices by target name. Shifts of the group that are not declared are left unchanged.
+type Group struct {
+	TargetName        string            `json:"targetName"`
+	Description       *string           `json:"description"`
+	GroupType         string            `json:"groupType"`
+	Site              string            `json:"site"`
+	Supervisors       []string          `json:"supervisors"`
+	Observers         []string          `json:"observers"`
+	ObservedByAll     *bool             `json:"observedByAll"`
+	AllowDuplicates   *bool             `json:"allowDuplicates"`
+	UseDefaultDevices *bool             `json:"useDefaultDevices"`
+	ExternalKey       *string           `json:"externalKey"`
+	ExternallyOwned   *bool             `json:"externallyOwned"`
+	Status            string            `json:"status"`
+	Members           []*Member         `json:"members"`
+	Shifts            []*xmatters.Shift `json:"shifts"`
+}
+
+// Member is a member of a group. Exactly one of Person, Group, or Device is set to the target name of the member.
+type Member struct {
+	Person string `json:"person"`
+	Group  string `json:"group"`
+	Device string `json:"device"`
+}
+
+// Service is the desired state of a service. OwnedBy references a group by target name, and DependsOn lists
+// the target names of the services this service depends on. Dependencies are only ever added.
+type Service struct {
+	TargetName   string                  `json:"targetName"`
+	Description  *string                 `json:"description"`
+	ServiceType  string                  `json:"serviceType"`
+	ServiceTier  *string                 `json:"serviceTier"`
+	OwnedBy      string                  `json:"ownedBy"`
+	ServiceLinks []*xmatters.ServiceLink `json:"serviceLinks"`
+	DependsOn    []string                `json:"dependsOn"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Manifest Methods
+// -------------------------------------------------------------------------------------------------
+
+// Load reads and validates a manifest from a YAML or JSON file.
+func Load(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+	manifest, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	return manifest, nil
+}
+
+// Parse decodes and validates a manifest from YAML or JSON. Unknown fields are rejected,
+// so a misspelled field is reported rather than silently applied as empty.
+func Parse(data []byte) (*Manifest, error) {
+	// Round-trip through JSON so the YAML keys are read using the JSON field names
+	var generic interface{}
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("decoding manifest: %w", err)
+	}
+	jsonBytes, err := json.Marshal(generic)
+	if err != nil {
+		return nil, fmt.Errorf("decoding manifest: %w", err)
+	}
+	var manifest Manifest
+	if err := decodeStrict(jsonBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("decoding manifest: %w", err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// Validate reports missing identifiers, duplicate objects, and malformed group members.
+func (m *Manifest) Validate() error {
+	var errs []error
+	seen := make(map[string]bool)
+	check := func(resource, key string) {
+		if key == "" || key == "|" {
+			errs = append(errs, fmt.Errorf("%s: an object is missing its identifier", resource))
+			return
+		}
+		if seen[resource+"/"+key] {
+			errs = append(errs, fmt.Errorf("%s %q is declared more than once", resource, key))
+		}
+		seen[resource+"/"+key] = true
+	}
+
+	for _, site := range m.Sites {
+		check("sites", site.Name)
+	}
+	for _, person := range m.People {
+		check("people", person.TargetName)
+	}
+	for _, device := range m.Devices {
+		check("devices", device.key())
+		if device.Owner == "" || device.Name == "" || device.DeviceType == "" {
+			errs = append(errs, fmt.Errorf("devices %q: owner, name, and deviceType are required", device.key()))
+		}
+	}
+	for _, group := range m.Groups {
+		check("groups", group.TargetName)
+		for _, member := range group.Members {
+			if _, _, err := member.reference(); err != nil {
+				errs = append(errs, fmt.Errorf("groups %q: %w", group.TargetName, err))
+			}
+		}
+	}
+	for _, service := range m.Services {
+		check("services", service.TargetName)
+	}
+	return errors.Join(errs...)
+}
+
+// key returns the identifier of a device, which matches the target name of the device in xMatters.
+func (d *Device) key() string {
+	return d.Owner + "|" + d.Name
+}
+
+// reference returns the target name and recipient type of a member.
+func (m *Member) reference() (string, xmatters.RecipientType, error) {
+	var set []string
+	var name string
+	var recipientType xmatters.RecipientType
+	for _, candidate := range []struct {
+		name          string
+		recipientType xmatters.RecipientType
+	}{{m.Person, xmatters.RecipientTypePerson}, {m.Group, xmatters.RecipientTypeGroup}, {m.Device, xmatters.RecipientTypeDevice}} {
+		if candidate.name != "" {
+			set = append(set, candidate.name)
+			name, recipientType = candidate.name, candidate.recipientType
+		}
+	}
+	if len(set) != 1 {
+		return "", "", fmt.Errorf("a member must set exactly one of person, group, or device")
+	}
+	return name, recipientType, nil
+}
+
+// decodeStrict decodes JSON into v, rejecting unknown fields.
+func decodeStrict(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
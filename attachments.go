@@ -0,0 +1,62 @@
+package xmatters
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Attachment Structs
+// -------------------------------------------------------------------------------------------------
+
+// Attachment represents a file uploaded to xMatters that can be included in event notifications.
+type Attachment struct {
+	ID          *string `json:"id"`
+	Name        *string `json:"name,omitempty"`
+	ContentType *string `json:"contentType,omitempty"`
+	Size        *int64  `json:"size,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Attachment Methods
+// -------------------------------------------------------------------------------------------------
+
+// UploadAttachment uploads a file to xMatters so it can be attached to triggered events.
+// It requires the filename to report to xMatters and an io.Reader providing the file content.
+// It returns the created Attachment object; reference its ID in TriggerEventParams.Attachments.
+func (xmatters *XMattersAPI) UploadAttachment(filename string, r io.Reader) (Attachment, error) {
+	uri := buildURI("/attachments", nil) // The URI for uploading an Attachment to xMatters
+
+	// Build the multipart request body containing the file
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return Attachment{}, fmt.Errorf("error creating multipart attachment body: %w", err)
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return Attachment{}, fmt.Errorf("error reading attachment content: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return Attachment{}, fmt.Errorf("error creating multipart attachment body: %w", err)
+	}
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, writer.FormDataContentType(), &body)
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	// Unmarshal the response into an Attachment struct.
+	var result Attachment
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Attachment{}, newUnmarshalError()
+	}
+
+	// Return the uploaded Attachment details.
+	return result, nil
+}
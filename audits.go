@@ -0,0 +1,114 @@
+package xmatters
+
+// -------------------------------------------------------------------------------------------------
+// Audit Structs
+// -------------------------------------------------------------------------------------------------
+
+// Audit represents a single record in the xMatters audit trail.
+// The fields that are populated depend on the audit Type; for example, Annotation is only set
+// for EVENT_ANNOTATED records and Response is only set for RESPONSE_RECEIVED records.
+// Administrative records describe the change in Message and Details, and the origin of the change in Source.
+type Audit struct {
+	ID             *string                `json:"id,omitempty"`
+	Type           *string                `json:"type"`
+	At             *Timestamp             `json:"at,omitempty"`
+	By             *PersonReference       `json:"by,omitempty"`
+	Event          *EventReference        `json:"event,omitempty"`
+	Annotation     *EventAnnotation       `json:"annotation,omitempty"`
+	Response       *UserDeliveryResponse  `json:"response,omitempty"`
+	Notification   *DeliveryNotification  `json:"notification,omitempty"`
+	Person         *PersonReference       `json:"person,omitempty"`
+	Recipient      *RecipientReference    `json:"recipient,omitempty"`
+	DeliveryStatus *string                `json:"deliveryStatus,omitempty"`
+	Message        *string                `json:"message,omitempty"`
+	Source         *string                `json:"source,omitempty"`
+	Details        map[string]interface{} `json:"details,omitempty"`
+}
+
+// AuditPagination contains a paginated list of audit records.
+// It extends the Pagination struct containing links to additional pages.
+type AuditPagination struct {
+	*Pagination
+	Audits []*Audit `json:"data"`
+}
+
+// AuditType represents the type of an audit record in xMatters.
+type AuditType string
+
+const (
+	// AuditTypeEventAnnotated is recorded when a comment is added to an event.
+	AuditTypeEventAnnotated AuditType = "EVENT_ANNOTATED"
+	// AuditTypeResponseReceived is recorded when a recipient responds to a notification.
+	AuditTypeResponseReceived AuditType = "RESPONSE_RECEIVED"
+	// AuditTypeNotificationDelivered is recorded when a notification is delivered to a device.
+	AuditTypeNotificationDelivered AuditType = "NOTIFICATION_DELIVERED"
+	// AuditTypeNotificationFailed is recorded when a notification cannot be delivered to a device.
+	AuditTypeNotificationFailed AuditType = "NOTIFICATION_FAILED"
+	// AuditTypeEventEscalated is recorded when an event escalates to the next recipient.
+	AuditTypeEventEscalated AuditType = "EVENT_ESCALATED"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// GetAuditsParams contains available API query parameters for the GetAudits method.
+// AuditType accepts a comma-separated list of audit types, such as "EVENT_ANNOTATED,RESPONSE_RECEIVED".
+type GetAuditsParams struct {
+	EventID   string `url:"eventId,omitempty"`
+	AuditType string `url:"auditType,omitempty"`
+	SortOrder string `url:"sortOrder,omitempty"`
+}
+
+// GetAuditListParams contains available API query parameters for the GetAuditList method.
+// AuditType accepts a comma-separated list of audit types, and By filters records to a single actor by ID or targetName.
+type GetAuditListParams struct {
+	EventID   string     `url:"eventId,omitempty"`
+	AuditType string     `url:"auditType,omitempty"`
+	By        string     `url:"by,omitempty"`
+	From      *Timestamp `url:"from,omitempty"`
+	To        *Timestamp `url:"to,omitempty"`
+	SortOrder string     `url:"sortOrder,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Audit Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetAudits retrieves the audit trail for an event in xMatters.
+// It accepts query parameters to select the event and audit types, and returns a slice of Audit objects
+// describing who did what, and when, over the life of the event.
+func (xmatters *XMattersAPI) GetAudits(params GetAuditsParams) ([]*Audit, error) {
+	if params.EventID == "" {
+		return []*Audit{}, newValidationError("an event ID is required to retrieve audits")
+	}
+
+	return xmatters.GetAuditList(GetAuditListParams{
+		EventID:   params.EventID,
+		AuditType: params.AuditType,
+		SortOrder: params.SortOrder,
+	})
+}
+
+// GetAuditList retrieves a list of audit records in xMatters, including administrative records that are not tied to an event.
+// It accepts optional query parameters to filter the results by audit type, actor, and date range,
+// and returns a slice of Audit objects, such as for streaming audit data into a SIEM.
+func (xmatters *XMattersAPI) GetAuditList(params GetAuditListParams) ([]*Audit, error) {
+	uri := buildURI("/audits", params) // The URI including any Query Parameters
+
+	// Use the GetAuditPaginationSet method to get all paginated results
+	auditList, err := xmatters.GetAuditPaginationSet(uri)
+	if err != nil {
+		return auditList, err
+	}
+
+	// Return the full list of Audits.
+	return auditList, nil
+}
+
+// GetAuditPaginationSet retrieves a paginated list of audits.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetAuditPaginationSet(uri string) ([]*Audit, error) {
+	return getPaginationSet[Audit](xmatters, uri)
+}
@@ -0,0 +1,90 @@
+package xmatters
+
+import (
+	"sync"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Batch Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetPeopleByIDs retrieves many people in xMatters with individual requests, running up to concurrency
+// requests at a time under the client rate limiter. Each ID may be a person ID or target name.
+// It returns the people that were retrieved and the errors of those that were not, both keyed by the
+// provided IDs. Optional GetOption values are applied to every request, as in GetPerson.
+func (xmatters *XMattersAPI) GetPeopleByIDs(ids []string, concurrency int, opts ...GetOption) (map[string]*Person, map[string]error) {
+	return getByIDs(xmatters.startProgress("GetPeopleByIDs", 0), ids, concurrency, func(id string) (Person, error) {
+		return xmatters.GetPerson(id, opts...)
+	})
+}
+
+// GetGroupsByIDs retrieves many groups in xMatters with individual requests, running up to concurrency
+// requests at a time under the client rate limiter. Each ID may be a group ID or target name.
+// It returns the groups that were retrieved and the errors of those that were not, both keyed by the
+// provided IDs. Optional GetOption values are applied to every request, as in GetGroup.
+func (xmatters *XMattersAPI) GetGroupsByIDs(ids []string, concurrency int, opts ...GetOption) (map[string]*Group, map[string]error) {
+	return getByIDs(xmatters.startProgress("GetGroupsByIDs", 0), ids, concurrency, func(id string) (Group, error) {
+		return xmatters.GetGroup(id, opts...)
+	})
+}
+
+// GetDevicesByIDs retrieves many devices in xMatters with individual requests, running up to concurrency
+// requests at a time under the client rate limiter.
+// It returns the devices that were retrieved and the errors of those that were not, both keyed by the
+// provided IDs. Optional GetOption values are applied to every request, as in GetDevice.
+func (xmatters *XMattersAPI) GetDevicesByIDs(ids []string, concurrency int, opts ...GetOption) (map[string]*Device, map[string]error) {
+	return getByIDs(xmatters.startProgress("GetDevicesByIDs", 0), ids, concurrency, func(id string) (Device, error) {
+		return xmatters.GetDevice(id, opts...)
+	})
+}
+
+// getByIDs calls get for every distinct ID using up to concurrency workers, and collects the results and errors.
+// A concurrency below 1 runs the requests one at a time. Each completed request advances the progress.
+func getByIDs[T any](progress *progressTracker, ids []string, concurrency int, get func(id string) (T, error)) (map[string]*T, map[string]error) {
+	results := make(map[string]*T, len(ids))
+	errs := make(map[string]error)
+
+	// Request every ID once, even if it is provided more than once
+	seen := make(map[string]bool, len(ids))
+	pending := make(chan string, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			pending <- id
+		}
+	}
+	close(pending)
+	if progress != nil {
+		progress.total = len(seen)
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > len(seen) {
+		concurrency = len(seen)
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range pending {
+				result, err := get(id)
+				mu.Lock()
+				if err != nil {
+					errs[id] = err
+				} else {
+					results[id] = &result
+				}
+				mu.Unlock()
+				progress.advance(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results, errs
+}
@@ -0,0 +1,205 @@
+package xmatters_test
+
+// The benchmarks cover the pagination and decoding hot paths of xmatters-go. They decode a 10,000 person payload
+// directly and through GetPersonList, which aggregates ten pages served by a local test server.
+// TestBenchmarkBudgets fails when a benchmark exceeds its time or allocations per operation, so regressions
+// in the custom unmarshallers are caught before release. The budgets depend on the machine, so they are only
+// enforced when XMATTERS_BENCH_BUDGETS=1, and never under the race detector.
+//
+// Usage:
+//
+//	go test -run '^$' -bench .
+//	XMATTERS_BENCH_BUDGETS=1 go test -run TestBenchmarkBudgets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xmatters/xmatters-go"
+)
+
+const (
+	// personCount is the number of people in the benchmark payloads
+	personCount = 10000
+	// pageSize is the number of people in each page served to GetPersonList
+	pageSize = 1000
+)
+
+var (
+	// payloadOnce builds the benchmark payloads once, as they are shared by the benchmarks and the budget test
+	payloadOnce sync.Once
+	payload     []byte
+	pages       map[string][]byte
+)
+
+// budgets are the performance budgets enforced by TestBenchmarkBudgets.
+var budgets = []struct {
+	name      string
+	run       func(b *testing.B)
+	maxTime   time.Duration
+	maxAllocs int64
+}{
+	{name: "UnmarshalPeople10k", run: BenchmarkUnmarshalPeople10k, maxTime: 120 * time.Millisecond, maxAllocs: 375000},
+	{name: "GetPersonList10k", run: BenchmarkGetPersonList10k, maxTime: 120 * time.Millisecond, maxAllocs: 360000},
+}
+
+func BenchmarkUnmarshalPeople10k(b *testing.B) {
+	payloadOnce.Do(buildPayloads)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(payload)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var people []*xmatters.Person
+		if err := json.Unmarshal(payload, &people); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetPersonList10k(b *testing.B) {
+	payloadOnce.Do(buildPayloads)
+	server := httptest.NewServer(pageHandler(pages))
+	defer server.Close()
+
+	hostname := "bench.invalid"
+	token := "bench"
+	client, err := xmatters.NewWithToken(&hostname, &token, xmatters.WithBaseURL(server.URL), xmatters.WithHTTPClient(server.Client()))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		people, err := client.GetPersonList(xmatters.GetPeopleParams{})
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(people) != personCount {
+			b.Fatalf("got %d people, want %d", len(people), personCount)
+		}
+	}
+}
+
+func TestBenchmarkBudgets(t *testing.T) {
+	if os.Getenv("XMATTERS_BENCH_BUDGETS") != "1" {
+		t.Skip("skipping the performance budgets; set XMATTERS_BENCH_BUDGETS=1 to enforce them")
+	}
+	for _, budget := range budgets {
+		t.Run(budget.name, func(t *testing.T) {
+			result := testing.Benchmark(budget.run)
+			if result.N == 0 {
+				t.Fatal("the benchmark failed")
+			}
+			t.Logf("%s %s", result.String(), result.MemString())
+			if raceEnabled {
+				// The race detector slows down and instruments every operation
+				return
+			}
+			if perOp := time.Duration(result.NsPerOp()); perOp > budget.maxTime {
+				t.Errorf("took %v per operation, over the budget of %v", perOp, budget.maxTime)
+			}
+			if allocs := result.AllocsPerOp(); allocs > budget.maxAllocs {
+				t.Errorf("made %d allocations per operation, over the budget of %d", allocs, budget.maxAllocs)
+			}
+		})
+	}
+}
+
+// buildPayloads builds the payload decoded directly and the pages served to GetPersonList.
+func buildPayloads() {
+	payload = personPayload()
+	pages = personPages()
+}
+
+// person returns the JSON representation of a person with embedded roles and supervisors.
+func person(i int) map[string]interface{} {
+	id := fmt.Sprintf("8f2b6a4e-1c3d-4e5f-9a7b-%012d", i)
+	return map[string]interface{}{
+		"id":              id,
+		"targetName":      "user" + strconv.Itoa(i),
+		"firstName":       "First" + strconv.Itoa(i),
+		"lastName":        "Last" + strconv.Itoa(i),
+		"status":          "ACTIVE",
+		"webLogin":        "user" + strconv.Itoa(i),
+		"timezone":        "America/Los_Angeles",
+		"language":        "en",
+		"licenseType":     "FULL_USER",
+		"externallyOwned": false,
+		"lastLogin":       "2024-03-01T12:00:00.000Z",
+		"site":            map[string]interface{}{"id": "b5f8c0a2-3d4e-4f5a-8b6c-7d8e9f0a1b2c"},
+		"roles": map[string]interface{}{
+			"count": 2,
+			"total": 2,
+			"data": []interface{}{
+				map[string]interface{}{"id": "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f", "name": "Standard User"},
+				map[string]interface{}{"id": "d1e2f3a4-b5c6-4d7e-8f9a-0b1c2d3e4f5a", "name": "Group Supervisor"},
+			},
+		},
+		"supervisors": map[string]interface{}{
+			"count": 1,
+			"total": 1,
+			"data": []interface{}{
+				map[string]interface{}{"id": "e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a5b", "targetName": "manager", "firstName": "Team", "lastName": "Manager"},
+			},
+		},
+	}
+}
+
+// personPayload returns a JSON array of personCount people.
+func personPayload() []byte {
+	people := make([]interface{}, personCount)
+	for i := range people {
+		people[i] = person(i)
+	}
+	payload, err := json.Marshal(people)
+	if err != nil {
+		panic(err)
+	}
+	return payload
+}
+
+// personPages returns the pages of people served to GetPersonList, keyed by offset.
+func personPages() map[string][]byte {
+	pages := make(map[string][]byte)
+	for offset := 0; offset < personCount; offset += pageSize {
+		data := make([]interface{}, 0, pageSize)
+		for i := offset; i < offset+pageSize; i++ {
+			data = append(data, person(i))
+		}
+		links := map[string]interface{}{"self": fmt.Sprintf("/api/xm/1/people?offset=%d&limit=%d", offset, pageSize)}
+		if offset+pageSize < personCount {
+			links["next"] = fmt.Sprintf("/api/xm/1/people?offset=%d&limit=%d", offset+pageSize, pageSize)
+		}
+		page, err := json.Marshal(map[string]interface{}{"count": len(data), "total": personCount, "data": data, "links": links})
+		if err != nil {
+			panic(err)
+		}
+		pages[strconv.Itoa(offset)] = page
+	}
+	return pages
+}
+
+// pageHandler serves the pages of people by offset.
+func pageHandler(pages map[string][]byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset := r.URL.Query().Get("offset")
+		if offset == "" {
+			offset = "0"
+		}
+		page, ok := pages[offset]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(page)
+	})
+}
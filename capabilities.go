@@ -0,0 +1,140 @@
+package xmatters
+
+import (
+	"sort"
+	"strings"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Capability Structs
+// -------------------------------------------------------------------------------------------------
+
+// Capability is a group of client operations that require the same permission in xMatters.
+type Capability string
+
+const (
+	// CapabilityViewPeople covers reading people, devices, groups, and on-call schedules, such as GetPersonList.
+	CapabilityViewPeople Capability = "VIEW_PEOPLE"
+	// CapabilityManagePeople covers creating, modifying, and deleting people and their devices, such as PushPerson.
+	CapabilityManagePeople Capability = "MANAGE_PEOPLE"
+	// CapabilityManageRoles covers changing the roles and supervisors of people, such as UpdatePersonRoles.
+	CapabilityManageRoles Capability = "MANAGE_ROLES"
+	// CapabilityManageGroups covers creating, modifying, and deleting groups, rosters, and shifts, such as PushGroup.
+	CapabilityManageGroups Capability = "MANAGE_GROUPS"
+	// CapabilityManageSites covers creating, modifying, and deleting sites, such as PushSite.
+	CapabilityManageSites Capability = "MANAGE_SITES"
+	// CapabilityManageServices covers creating, modifying, and deleting services and their dependencies, such as PushService.
+	CapabilityManageServices Capability = "MANAGE_SERVICES"
+	// CapabilityManageWorkflows covers managing workflows, forms, and integrations, such as PushPlan and PushIntegration.
+	CapabilityManageWorkflows Capability = "MANAGE_WORKFLOWS"
+	// CapabilityTriggerEvents covers triggering and terminating events, such as TriggerEvent.
+	CapabilityTriggerEvents Capability = "TRIGGER_EVENTS"
+)
+
+// defaultCapabilityRoles maps each Capability to the default xMatters roles that grant it.
+var defaultCapabilityRoles = map[Capability][]string{
+	CapabilityViewPeople: {"Standard User", "Group Supervisor", "Person Supervisor", "Company Supervisor",
+		"Full Access User", "Developer", "REST Web Service User"},
+	CapabilityManagePeople:    {"Person Supervisor", "Company Supervisor", "Full Access User", "REST Web Service User"},
+	CapabilityManageRoles:     {"Person Supervisor", "Company Supervisor", "Full Access User"},
+	CapabilityManageGroups:    {"Group Supervisor", "Company Supervisor", "Full Access User", "REST Web Service User"},
+	CapabilityManageSites:     {"Company Supervisor", "Full Access User"},
+	CapabilityManageServices:  {"Group Supervisor", "Company Supervisor", "Full Access User", "REST Web Service User"},
+	CapabilityManageWorkflows: {"Developer", "Full Access User"},
+	CapabilityTriggerEvents: {"Standard User", "Group Supervisor", "Person Supervisor", "Company Supervisor",
+		"Full Access User", "Developer", "REST Web Service User"},
+}
+
+// CapabilityRoles maps each Capability to the roles that grant it, matched without regard to case.
+type CapabilityRoles map[Capability][]string
+
+// Capabilities reports the capabilities of a user, as granted by their roles.
+type Capabilities struct {
+	User    string              `json:"user"`
+	Roles   []string            `json:"roles"`
+	Granted map[Capability]bool `json:"granted"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Capability Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetCapabilities retrieves the roles of a user and reports which capabilities they grant, so tools can check
+// before starting that the client is authorized for the operations they need, and disable the others,
+// rather than failing part way with 403 Forbidden responses.
+// The user parameter is the target name or ID of the user the client authenticates as; it may be empty for
+// a client with basic authentication, which uses its username. Capabilities are derived from the roles that grant
+// them, which are the default xMatters roles unless the client was created WithCapabilityRoles, so they are
+// a prediction: xMatters remains the authority on what a request is allowed to do.
+// Example usage:
+//
+//	capabilities, err := client.GetCapabilities("")
+//	if err == nil && !capabilities.Can(xmatters.CapabilityManagePeople) {
+//	    log.Print("read-only mode: the client cannot modify people")
+//	}
+func (xmatters *XMattersAPI) GetCapabilities(user string) (*Capabilities, error) {
+	if user == "" && xmatters.Username != nil {
+		user = *xmatters.Username
+	}
+	if err := validateIdentifier("user target name or ID", user); err != nil {
+		return nil, err
+	}
+	roles, err := xmatters.GetPersonRoles(user)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilityRoles := xmatters.capabilityRoles
+	if capabilityRoles == nil {
+		capabilityRoles = defaultCapabilityRoles
+	}
+	capabilities := &Capabilities{User: user, Roles: []string{}, Granted: make(map[Capability]bool, len(capabilityRoles))}
+	held := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		capabilities.Roles = append(capabilities.Roles, stringValue(role.Name))
+		held[strings.ToLower(stringValue(role.Name))] = true
+	}
+	sort.Strings(capabilities.Roles)
+	for capability, granting := range capabilityRoles {
+		capabilities.Granted[capability] = false
+		for _, role := range granting {
+			if held[strings.ToLower(role)] {
+				capabilities.Granted[capability] = true
+				break
+			}
+		}
+	}
+	return capabilities, nil
+}
+
+// DefaultCapabilityRoles returns a copy of the default xMatters roles that grant each Capability, which can be
+// extended with the custom roles of an instance and passed to WithCapabilityRoles.
+func DefaultCapabilityRoles() CapabilityRoles {
+	return copyCapabilityRoles(defaultCapabilityRoles)
+}
+
+// copyCapabilityRoles returns a deep copy of the roles, so later changes to either copy do not affect the other.
+func copyCapabilityRoles(roles map[Capability][]string) CapabilityRoles {
+	copied := make(CapabilityRoles, len(roles))
+	for capability, granting := range roles {
+		copied[capability] = append([]string(nil), granting...)
+	}
+	return copied
+}
+
+// Can reports whether the capability is granted.
+func (c *Capabilities) Can(capability Capability) bool {
+	return c != nil && c.Granted[capability]
+}
+
+// Missing returns the capabilities of the list that are not granted, so a tool can report everything it
+// lacks at once.
+func (c *Capabilities) Missing(required ...Capability) []Capability {
+	missing := []Capability{}
+	for _, capability := range required {
+		if !c.Can(capability) {
+			missing = append(missing, capability)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,112 @@
+package xmatters
+
+import (
+	"net/http"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Change Event Structs
+// -------------------------------------------------------------------------------------------------
+
+// ChangeEvent represents a change, such as a deployment or configuration update, recorded against services in xMatters.
+type ChangeEvent struct {
+	ID          *string                `json:"id"`
+	Summary     *string                `json:"summary,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	ChangeType  *string                `json:"changeType,omitempty"`
+	Source      *string                `json:"source,omitempty"`
+	ExternalURL *string                `json:"externalUrl,omitempty"`
+	Services    []*ServiceReference    `json:"services,omitempty"`
+	Properties  map[string]interface{} `json:"properties,omitempty"`
+	Occurred    *Timestamp             `json:"occurred,omitempty"`
+	Created     *Timestamp             `json:"created,omitempty"`
+}
+
+// ChangeEventPagination contains a paginated list of change events.
+// It extends the Pagination struct containing links to additional pages.
+type ChangeEventPagination struct {
+	*Pagination
+	ChangeEvents []*ChangeEvent `json:"data"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// GetChangeEventsParams contains available API query parameters for the GetChangeEvents method.
+// Services accepts a comma-separated list of service IDs.
+type GetChangeEventsParams struct {
+	Search    string     `url:"search,omitempty"`
+	Services  string     `url:"services,omitempty"`
+	From      *Timestamp `url:"from,omitempty"`
+	To        *Timestamp `url:"to,omitempty"`
+	SortOrder string     `url:"sortOrder,omitempty"`
+}
+
+// PostChangeEventParams contains available API body parameters for the PostChangeEvent method.
+type PostChangeEventParams struct {
+	// Required Fields
+	Summary  string           `json:"summary"`
+	Services []*ReferenceById `json:"services"`
+	// Optional Fields
+	Description *string                `json:"description,omitempty"`
+	ChangeType  *string                `json:"changeType,omitempty"`
+	Source      *string                `json:"source,omitempty"`
+	ExternalURL *string                `json:"externalUrl,omitempty"`
+	Properties  map[string]interface{} `json:"properties,omitempty"`
+	Occurred    *Timestamp             `json:"occurred,omitempty"` // Defaults to the time the change is received
+}
+
+// -------------------------------------------------------------------------------------------------
+// Change Event Methods
+// -------------------------------------------------------------------------------------------------
+
+// PostChangeEvent records a change, such as a deployment, against one or more services in xMatters.
+// It requires the PostChangeEventParams struct containing the change summary and affected services,
+// and returns the created ChangeEvent object.
+func (xmatters *XMattersAPI) PostChangeEvent(params PostChangeEventParams) (ChangeEvent, error) {
+	if params.Summary == "" || len(params.Services) == 0 {
+		return ChangeEvent{}, newValidationError("a summary and at least one service are required to post a change event")
+	}
+
+	uri := buildURI("/change-events", nil) // The URI for creating a Change Event in xMatters
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
+	if err != nil {
+		return ChangeEvent{}, err
+	}
+
+	// Unmarshal the response into a ChangeEvent struct.
+	var result ChangeEvent
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return ChangeEvent{}, newUnmarshalError()
+	}
+
+	// Return the created ChangeEvent object.
+	return result, nil
+}
+
+// GetChangeEvents retrieves a list of change events in xMatters.
+// It accepts optional query parameters, such as the services and date range, to find recent changes during triage.
+// It returns a slice of ChangeEvent objects.
+func (xmatters *XMattersAPI) GetChangeEvents(params GetChangeEventsParams) ([]*ChangeEvent, error) {
+	uri := buildURI("/change-events", params) // The URI including any Query Parameters
+
+	// Use the GetChangeEventPaginationSet method to get all paginated results
+	changeEventList, err := xmatters.GetChangeEventPaginationSet(uri)
+	if err != nil {
+		return changeEventList, err
+	}
+
+	// Return the full list of Change Events.
+	return changeEventList, nil
+}
+
+// GetChangeEventPaginationSet retrieves a paginated list of change events.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetChangeEventPaginationSet(uri string) ([]*ChangeEvent, error) {
+	return getPaginationSet[ChangeEvent](xmatters, uri)
+}
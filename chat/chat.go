@@ -0,0 +1,174 @@
+// Package chat formats xMatters events and on-call periods as chat messages: Slack Block Kit messages and
+// Microsoft Teams messages carrying an Adaptive Card. The messages marshal to the JSON expected by Slack
+// incoming webhooks and chat.postMessage, and by Teams incoming webhooks and workflows.
+//
+// Usage:
+//
+//	event, err := client.GetEvent(eventId)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	payload, err := json.Marshal(chat.SlackEvent(event))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	http.Post(slackWebhookURL, "application/json", bytes.NewReader(payload))
+//
+//	onCalls, err := client.GetOnCallList(xmatters.GetOnCallParams{Groups: "Database Admins", Embed: "members"})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	payload, err = json.Marshal(chat.TeamsOnCall(onCalls))
+package chat
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/xmatters/xmatters-go"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Card Structs
+// -------------------------------------------------------------------------------------------------
+
+// card is the content of a message, independent of the chat service it is rendered for.
+type card struct {
+	title    string
+	sections []*section
+}
+
+// section is a titled group of facts within a card.
+type section struct {
+	title string
+	facts []fact
+}
+
+// fact is a labelled value within a section.
+type fact struct {
+	name  string
+	value string
+}
+
+// -------------------------------------------------------------------------------------------------
+// Card Methods
+// -------------------------------------------------------------------------------------------------
+
+// eventCard describes an event: its status and origin, followed by its properties and recipients.
+func eventCard(event xmatters.Event) *card {
+	title := "Event"
+	if id := xmatters.Value(event.EventID, ""); id != "" {
+		title += " " + id
+	}
+	if name := xmatters.Value(event.Name, ""); name != "" {
+		title += ": " + name
+	}
+	if priority := xmatters.Value(event.Priority, ""); priority != "" {
+		title = "[" + priority + "] " + title
+	}
+
+	details := &section{}
+	details.add("Status", xmatters.Value(event.Status, ""))
+	details.add("Priority", xmatters.Value(event.Priority, ""))
+	if event.Form != nil {
+		details.add("Form", xmatters.Value(event.Form.Name, ""))
+	}
+	if event.Plan != nil {
+		details.add("Workflow", xmatters.Value(event.Plan.Name, ""))
+	}
+	if event.Submitter != nil {
+		details.add("Submitted by", xmatters.Value(event.Submitter.TargetName, ""))
+	}
+	if event.Created != nil {
+		details.add("Created", event.Created.String())
+	}
+	details.add("Incident", xmatters.Value(event.Incident, ""))
+	c := &card{title: title, sections: []*section{details}}
+
+	// Properties are listed by name, as their order is not preserved by the API
+	if len(event.Properties) > 0 {
+		properties := &section{title: "Properties"}
+		names := make([]string, 0, len(event.Properties))
+		for name := range event.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			properties.add(name, formatValue(event.Properties[name]))
+		}
+		c.sections = append(c.sections, properties)
+	}
+
+	recipients := event.Recipients
+	if len(recipients) == 0 {
+		recipients = event.TargetedRecipients
+	}
+	if len(recipients) > 0 {
+		names := make([]string, 0, len(recipients))
+		for _, recipient := range recipients {
+			names = append(names, recipientName(recipient))
+		}
+		c.sections = append(c.sections, &section{facts: []fact{{name: "Recipients", value: strings.Join(names, ", ")}}})
+	}
+	return c
+}
+
+// onCallCard describes on-call periods: a section per group shift, listing its members by escalation position.
+func onCallCard(onCalls []*xmatters.OnCall) *card {
+	c := &card{title: "Who's on call"}
+	for _, onCall := range onCalls {
+		title := ""
+		if onCall.Group != nil {
+			title = xmatters.Value(onCall.Group.TargetName, xmatters.Value(onCall.Group.ID, ""))
+		}
+		if onCall.Shift != nil && onCall.Shift.Name != nil {
+			title += " - " + *onCall.Shift.Name
+		}
+		if onCall.End != nil {
+			title += " (until " + onCall.End.String() + ")"
+		}
+		s := &section{title: title}
+		for _, member := range onCall.Members {
+			if member.Member != nil {
+				s.add(fmt.Sprintf("%d.", xmatters.Value(member.Position, 0)), recipientName(member.Member))
+			}
+		}
+		if len(s.facts) == 0 {
+			s.add("Nobody", "No members are on call")
+		}
+		c.sections = append(c.sections, s)
+	}
+	if len(c.sections) == 0 {
+		c.sections = append(c.sections, &section{facts: []fact{{name: "Nobody", value: "No on-call periods found"}}})
+	}
+	return c
+}
+
+// add appends a fact to the section, unless its value is empty.
+func (s *section) add(name, value string) {
+	if value != "" {
+		s.facts = append(s.facts, fact{name: name, value: value})
+	}
+}
+
+// recipientName returns the target name of a recipient, or its ID when the name is not known.
+func recipientName(recipient *xmatters.RecipientReference) string {
+	return xmatters.Value(recipient.TargetName, xmatters.Value(recipient.ID, ""))
+}
+
+// formatValue formats an event property value, listing the values of list properties separated by commas.
+func formatValue(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			values = append(values, formatValue(item))
+		}
+		return strings.Join(values, ", ")
+	default:
+		return fmt.Sprint(value)
+	}
+}
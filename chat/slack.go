@@ -0,0 +1,99 @@
+package chat
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xmatters/xmatters-go"
+)
+
+// slackMaxFields is the number of fields Slack allows in a section block.
+const slackMaxFields = 10
+
+// slackMaxHeader is the number of characters Slack allows in the text of a header block.
+const slackMaxHeader = 150
+
+// slackMaxText is the number of characters Slack allows in the text of a field.
+const slackMaxText = 2000
+
+// -------------------------------------------------------------------------------------------------
+// Slack Structs
+// -------------------------------------------------------------------------------------------------
+
+// SlackMessage is a Slack message built from Block Kit blocks. Text is the fallback shown in notifications.
+type SlackMessage struct {
+	Text   string        `json:"text"`
+	Blocks []*SlackBlock `json:"blocks"`
+}
+
+// SlackBlock is a Block Kit layout block, such as a header, section, or divider.
+type SlackBlock struct {
+	Type   string       `json:"type"`
+	Text   *SlackText   `json:"text,omitempty"`
+	Fields []*SlackText `json:"fields,omitempty"`
+}
+
+// SlackText is a Block Kit text object, in plain_text or mrkdwn.
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Slack Methods
+// -------------------------------------------------------------------------------------------------
+
+// SlackEvent formats an event as a Slack message, with a header naming the event and sections for its
+// details, properties, and recipients.
+func SlackEvent(event xmatters.Event) *SlackMessage {
+	return eventCard(event).slack()
+}
+
+// SlackOnCall formats on-call periods as a Slack message, with a section per group shift listing its
+// members by escalation position. Members are only listed when the periods embed them.
+func SlackOnCall(onCalls []*xmatters.OnCall) *SlackMessage {
+	return onCallCard(onCalls).slack()
+}
+
+// slack renders the card as Block Kit blocks. Sections with more facts than a section block allows are
+// split over several blocks.
+func (c *card) slack() *SlackMessage {
+	message := &SlackMessage{
+		Text:   escapeSlack(c.title),
+		Blocks: []*SlackBlock{{Type: "header", Text: &SlackText{Type: "plain_text", Text: truncate(c.title, slackMaxHeader)}}},
+	}
+	for i, s := range c.sections {
+		if i > 0 {
+			message.Blocks = append(message.Blocks, &SlackBlock{Type: "divider"})
+		}
+		if s.title != "" {
+			message.Blocks = append(message.Blocks, &SlackBlock{Type: "section", Text: &SlackText{Type: "mrkdwn", Text: "*" + escapeSlack(s.title) + "*"}})
+		}
+		for start := 0; start < len(s.facts); start += slackMaxFields {
+			end := start + slackMaxFields
+			if end > len(s.facts) {
+				end = len(s.facts)
+			}
+			block := &SlackBlock{Type: "section"}
+			for _, f := range s.facts[start:end] {
+				block.Fields = append(block.Fields, &SlackText{Type: "mrkdwn", Text: truncate("*"+escapeSlack(f.name)+"*\n"+escapeSlack(f.value), slackMaxText)})
+			}
+			message.Blocks = append(message.Blocks, block)
+		}
+	}
+	return message
+}
+
+// escapeSlack escapes the characters that Slack treats as control characters in mrkdwn text.
+func escapeSlack(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
+
+// truncate shortens text to at most max characters, ending it with an ellipsis when it is shortened.
+func truncate(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:max-1]) + "…"
+}
@@ -0,0 +1,91 @@
+package chat
+
+import (
+	"github.com/xmatters/xmatters-go"
+)
+
+// AdaptiveCardVersion is the Adaptive Card schema version of the cards built for Teams.
+const AdaptiveCardVersion = "1.4"
+
+// -------------------------------------------------------------------------------------------------
+// Teams Structs
+// -------------------------------------------------------------------------------------------------
+
+// TeamsMessage is a Microsoft Teams message carrying Adaptive Card attachments.
+type TeamsMessage struct {
+	Type        string             `json:"type"`
+	Attachments []*TeamsAttachment `json:"attachments"`
+}
+
+// TeamsAttachment is an attachment of a Teams message.
+type TeamsAttachment struct {
+	ContentType string        `json:"contentType"`
+	Content     *AdaptiveCard `json:"content"`
+}
+
+// AdaptiveCard is an Adaptive Card, whose body is a list of card elements.
+type AdaptiveCard struct {
+	Schema  string         `json:"$schema"`
+	Type    string         `json:"type"`
+	Version string         `json:"version"`
+	Body    []*CardElement `json:"body"`
+}
+
+// CardElement is an element of an Adaptive Card body: a TextBlock with Text, or a FactSet with Facts.
+type CardElement struct {
+	Type      string      `json:"type"`
+	Text      string      `json:"text,omitempty"`
+	Size      string      `json:"size,omitempty"`
+	Weight    string      `json:"weight,omitempty"`
+	Wrap      bool        `json:"wrap,omitempty"`
+	Separator bool        `json:"separator,omitempty"`
+	Facts     []*CardFact `json:"facts,omitempty"`
+}
+
+// CardFact is a labelled value of a FactSet.
+type CardFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Teams Methods
+// -------------------------------------------------------------------------------------------------
+
+// TeamsEvent formats an event as a Teams message, with a title naming the event and fact sets for its
+// details, properties, and recipients.
+func TeamsEvent(event xmatters.Event) *TeamsMessage {
+	return eventCard(event).teams()
+}
+
+// TeamsOnCall formats on-call periods as a Teams message, with a fact set per group shift listing its
+// members by escalation position. Members are only listed when the periods embed them.
+func TeamsOnCall(onCalls []*xmatters.OnCall) *TeamsMessage {
+	return onCallCard(onCalls).teams()
+}
+
+// teams renders the card as an Adaptive Card attached to a message.
+func (c *card) teams() *TeamsMessage {
+	adaptiveCard := &AdaptiveCard{
+		Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+		Type:    "AdaptiveCard",
+		Version: AdaptiveCardVersion,
+		Body:    []*CardElement{{Type: "TextBlock", Text: c.title, Size: "Large", Weight: "Bolder", Wrap: true}},
+	}
+	for i, s := range c.sections {
+		if s.title != "" {
+			adaptiveCard.Body = append(adaptiveCard.Body, &CardElement{Type: "TextBlock", Text: s.title, Weight: "Bolder", Wrap: true, Separator: i > 0})
+		}
+		facts := &CardElement{Type: "FactSet", Separator: i > 0 && s.title == ""}
+		for _, f := range s.facts {
+			facts.Facts = append(facts.Facts, &CardFact{Title: f.name, Value: f.value})
+		}
+		if len(facts.Facts) > 0 {
+			adaptiveCard.Body = append(adaptiveCard.Body, facts)
+		}
+	}
+	return &TeamsMessage{
+		Type:        "message",
+		Attachments: []*TeamsAttachment{{ContentType: "application/vnd.microsoft.card.adaptive", Content: adaptiveCard}},
+	}
+}
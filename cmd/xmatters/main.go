@@ -0,0 +1,230 @@
+// Command xmatters exposes the operations of xmatters-go on the command line, so operators can script
+// against xMatters without writing Go.
+//
+// The client is configured with flags, which default to the XMATTERS_HOSTNAME, XMATTERS_TOKEN,
+// XMATTERS_USERNAME, and XMATTERS_PASSWORD environment variables. A token takes precedence over
+// a username and password. With -config, or the XMATTERS_CONFIG environment variable, the client is
+// configured from a profile of a configuration file instead, as described by xmatters.ClientConfig.
+// Results are written to standard output as JSON, or as CSV with -o csv.
+//
+// Usage:
+//
+//	xmatters [flags] <people|groups|devices|sites|services> list [-search terms] [-params json]
+//	xmatters [flags] <people|groups|devices|sites|services> get <id>
+//	xmatters [flags] <people|groups|devices|sites|services> push -f <file>
+//	xmatters [flags] <people|groups|devices|sites|services> delete <id>
+//	xmatters [flags] oncall [-at time] [-members n] <group>...
+//	xmatters [flags] events trigger -form <formId> -f <file>
+//
+// Push and trigger read the JSON request body from a file, or from standard input when the file is "-".
+// The -params flag of list filters the results with a JSON object of the fields of the resource's
+// parameters struct, such as {"Embed": "roles"} for GetPeopleParams.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/xmatters/xmatters-go"
+)
+
+// errUsage reports invalid arguments, for which the usage is printed.
+var errUsage = errors.New("invalid usage")
+
+// cli holds the global configuration of a command.
+type cli struct {
+	config   string
+	profile  string
+	hostname string
+	token    string
+	username string
+	password string
+	rate     float64
+	output   string
+	stdin    io.Reader
+	stdout   io.Writer
+	stderr   io.Writer
+}
+
+func main() {
+	c := &cli{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+	err := c.run(os.Args[1:])
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		os.Exit(0)
+	case errors.Is(err, errUsage):
+		fmt.Fprintln(c.stderr, err)
+		c.usage()
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintln(c.stderr, "xmatters:", err)
+		os.Exit(1)
+	}
+}
+
+// run parses the global flags and runs the command named by the remaining arguments.
+func (c *cli) run(args []string) error {
+	flags := flag.NewFlagSet("xmatters", flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	flags.Usage = c.usage
+	flags.StringVar(&c.config, "config", os.Getenv("XMATTERS_CONFIG"), "client configuration file")
+	flags.StringVar(&c.profile, "profile", os.Getenv(xmatters.EnvProfile), "profile of the configuration file")
+	flags.StringVar(&c.hostname, "hostname", os.Getenv(xmatters.EnvHostname), "xMatters hostname, such as company.xmatters.com")
+	flags.StringVar(&c.token, "token", os.Getenv(xmatters.EnvToken), "API token")
+	flags.StringVar(&c.username, "username", os.Getenv(xmatters.EnvUsername), "username for basic authentication")
+	flags.StringVar(&c.password, "password", os.Getenv(xmatters.EnvPassword), "password for basic authentication")
+	flags.Float64Var(&c.rate, "rate", 0, "requests per second, or 0 for the client default")
+	flags.StringVar(&c.output, "o", "json", "output format: json or csv")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if c.output != "json" && c.output != "csv" {
+		return fmt.Errorf("%w: unknown output format %q", errUsage, c.output)
+	}
+
+	args = flags.Args()
+	if len(args) == 0 {
+		return fmt.Errorf("%w: missing command", errUsage)
+	}
+	switch args[0] {
+	case "oncall":
+		return c.onCall(args[1:])
+	case "events":
+		if len(args) < 2 || args[1] != "trigger" {
+			return fmt.Errorf("%w: events supports the trigger action", errUsage)
+		}
+		return c.triggerEvent(args[2:])
+	}
+
+	r, ok := resources[args[0]]
+	if !ok {
+		return fmt.Errorf("%w: unknown command %q", errUsage, args[0])
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("%w: missing action for %s", errUsage, args[0])
+	}
+	return c.runResource(r, args[1], args[2:])
+}
+
+// usage prints the commands and global flags.
+func (c *cli) usage() {
+	fmt.Fprint(c.stderr, `Usage:
+  xmatters [flags] <people|groups|devices|sites|services> list [-search terms] [-params json]
+  xmatters [flags] <people|groups|devices|sites|services> get <id>
+  xmatters [flags] <people|groups|devices|sites|services> push -f <file>
+  xmatters [flags] <people|groups|devices|sites|services> delete <id>
+  xmatters [flags] oncall [-at time] [-members n] <group>...
+  xmatters [flags] events trigger -form <formId> -f <file>
+
+Flags:
+  -config string     client configuration file (XMATTERS_CONFIG)
+  -profile string    profile of the configuration file (XMATTERS_PROFILE)
+  -hostname string   xMatters hostname (XMATTERS_HOSTNAME)
+  -token string      API token (XMATTERS_TOKEN)
+  -username string   username for basic authentication (XMATTERS_USERNAME)
+  -password string   password for basic authentication (XMATTERS_PASSWORD)
+  -rate float        requests per second, or 0 for the client default
+  -o string          output format: json or csv (default "json")
+`)
+}
+
+// client returns an xMatters client for the configured profile, or for the configured hostname and credentials.
+func (c *cli) client() (*xmatters.XMattersAPI, error) {
+	var opts []xmatters.Option
+	if c.rate > 0 {
+		opts = append(opts, xmatters.WithRateLimit(c.rate))
+	}
+	if c.config != "" {
+		return xmatters.NewFromProfile(c.config, c.profile, opts...)
+	}
+
+	if c.hostname == "" {
+		return nil, errors.New("a hostname is required, set -hostname or XMATTERS_HOSTNAME")
+	}
+	if c.token == "" && c.username == "" {
+		return nil, errors.New("credentials are required, set -token or -username and -password")
+	}
+	profile := xmatters.ClientProfile{Hostname: c.hostname, Token: c.token, Username: c.username, Password: c.password}
+	return profile.NewClient(opts...)
+}
+
+// readBody reads a JSON request body from the named file, or from standard input when the name is "-".
+func (c *cli) readBody(name string) ([]byte, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%w: a request body is required, set -f to a file or - for standard input", errUsage)
+	}
+	if name == "-" {
+		return io.ReadAll(c.stdin)
+	}
+	return os.ReadFile(name)
+}
+
+// onCall writes the on-call members of the named groups.
+func (c *cli) onCall(args []string) error {
+	flags := flag.NewFlagSet("oncall", flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	at := flags.String("at", "", "time to look up, such as 2024-01-02T15:04:05Z, instead of now")
+	members := flags.Int64("members", 0, "number of members returned for each shift")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return fmt.Errorf("%w: oncall requires at least one group", errUsage)
+	}
+
+	params := xmatters.GetOnCallParams{Groups: strings.Join(flags.Args(), ","), MembersPerShift: *members}
+	if *at != "" {
+		timestamp, err := xmatters.ParseTimestamp(*at)
+		if err != nil {
+			return fmt.Errorf("%w: invalid -at time: %v", errUsage, err)
+		}
+		params.At = &timestamp
+	}
+
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	onCallList, err := client.GetOnCallList(params)
+	if err != nil {
+		return err
+	}
+	return c.write(onCallList)
+}
+
+// triggerEvent triggers an event on a form with the request body read from a file.
+func (c *cli) triggerEvent(args []string) error {
+	flags := flag.NewFlagSet("events trigger", flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	formID := flags.String("form", "", "ID of the form to trigger")
+	file := flags.String("f", "", "file containing the JSON request body, or - for standard input")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *formID == "" {
+		return fmt.Errorf("%w: events trigger requires -form", errUsage)
+	}
+	body, err := c.readBody(*file)
+	if err != nil {
+		return err
+	}
+	var params xmatters.TriggerEventParams
+	if err := decodeStrict(body, &params); err != nil {
+		return fmt.Errorf("decoding request body: %w", err)
+	}
+	params.FormID = *formID
+
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	trigger, err := client.TriggerEvent(params)
+	if err != nil {
+		return err
+	}
+	return c.write(trigger)
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// write writes a result to standard output in the configured format.
+func (c *cli) write(result interface{}) error {
+	if c.output == "csv" {
+		return c.writeCSV(result)
+	}
+	encoder := json.NewEncoder(c.stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+// writeCSV writes a result, or each item of a list result, as a CSV row.
+// The columns are the top-level JSON fields of the items in alphabetical order. Nested objects and
+// lists are written as compact JSON, and fields missing from an item are left empty.
+func (c *cli) writeCSV(result interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return err
+	}
+
+	var rows []map[string]interface{}
+	switch typed := document.(type) {
+	case []interface{}:
+		for _, item := range typed {
+			row, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("CSV output requires a list of objects")
+			}
+			rows = append(rows, row)
+		}
+	case map[string]interface{}:
+		rows = append(rows, typed)
+	default:
+		return fmt.Errorf("CSV output requires an object or a list of objects")
+	}
+
+	columnSet := make(map[string]bool)
+	for _, row := range rows {
+		for column := range row {
+			columnSet[column] = true
+		}
+	}
+	columns := make([]string, 0, len(columnSet))
+	for column := range columnSet {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	writer := csv.NewWriter(c.stdout)
+	if err := writer.Write(columns); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			record[i], err = csvValue(row[column])
+			if err != nil {
+				return err
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvValue formats a decoded JSON value as a CSV field.
+func csvValue(value interface{}) (string, error) {
+	switch typed := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return typed, nil
+	case json.Number:
+		return typed.String(), nil
+	case bool:
+		return fmt.Sprint(typed), nil
+	}
+	text, err := json.Marshal(value)
+	return string(text), err
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+
+	"github.com/xmatters/xmatters-go"
+)
+
+// resource holds the operations of a resource type, such as people, bound to the client methods.
+type resource struct {
+	list   func(client *xmatters.XMattersAPI, search string, params []byte) (interface{}, error)
+	get    func(client *xmatters.XMattersAPI, id string) (interface{}, error)
+	push   func(client *xmatters.XMattersAPI, body []byte) (interface{}, error)
+	delete func(client *xmatters.XMattersAPI, id string) error
+	// searchable is true when list accepts search terms.
+	searchable bool
+}
+
+// resources maps each command name to its resource.
+var resources = map[string]resource{
+	"people": {
+		list:       lister((*xmatters.XMattersAPI).GetPersonList, func(p *xmatters.GetPeopleParams) *xmatters.SearchQuery { return &p.SearchQuery }),
+		get:        getter((*xmatters.XMattersAPI).GetPerson),
+		push:       pusher(withoutPushOptions((*xmatters.XMattersAPI).PushPerson)),
+		delete:     func(client *xmatters.XMattersAPI, id string) error { return client.DeletePerson(&id) },
+		searchable: true,
+	},
+	"groups": {
+		list:       lister((*xmatters.XMattersAPI).GetGroupList, func(p *xmatters.GetGroupsParams) *xmatters.SearchQuery { return &p.SearchQuery }),
+		get:        getter((*xmatters.XMattersAPI).GetGroup),
+		push:       pusher(withoutPushOptions((*xmatters.XMattersAPI).PushGroup)),
+		delete:     (*xmatters.XMattersAPI).DeleteGroup,
+		searchable: true,
+	},
+	"devices": {
+		list:   lister[xmatters.GetDevicesParams]((*xmatters.XMattersAPI).GetDeviceList, nil),
+		get:    getter((*xmatters.XMattersAPI).GetDevice),
+		push:   pusher(withoutPushOptions((*xmatters.XMattersAPI).PushDevice)),
+		delete: (*xmatters.XMattersAPI).DeleteDevice,
+	},
+	"sites": {
+		list:       lister((*xmatters.XMattersAPI).GetSiteList, func(p *xmatters.GetSitesParams) *xmatters.SearchQuery { return &p.SearchQuery }),
+		get:        getter((*xmatters.XMattersAPI).GetSite),
+		push:       pusher((*xmatters.XMattersAPI).PushSite),
+		delete:     func(client *xmatters.XMattersAPI, id string) error { return client.DeleteSite(&id) },
+		searchable: true,
+	},
+	"services": {
+		list:       lister((*xmatters.XMattersAPI).GetServiceList, func(p *xmatters.GetServicesParams) *xmatters.SearchQuery { return &p.SearchQuery }),
+		get:        getter((*xmatters.XMattersAPI).GetService),
+		push:       pusher(withoutPushOptions((*xmatters.XMattersAPI).PushService)),
+		delete:     (*xmatters.XMattersAPI).DeleteService,
+		searchable: true,
+	},
+}
+
+// runResource runs a list, get, push, or delete action on a resource.
+func (c *cli) runResource(r resource, action string, args []string) error {
+	flags := flag.NewFlagSet(action, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	var search, params, file *string
+	switch action {
+	case "list":
+		if r.searchable {
+			search = flags.String("search", "", "search terms matched against the default search fields")
+		}
+		params = flags.String("params", "", "JSON object of list parameters")
+	case "push":
+		file = flags.String("f", "", "file containing the JSON request body, or - for standard input")
+	case "get", "delete":
+	default:
+		return fmt.Errorf("%w: unknown action %q", errUsage, action)
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var id string
+	if action == "get" || action == "delete" {
+		if flags.NArg() != 1 {
+			return fmt.Errorf("%w: %s requires a single ID", errUsage, action)
+		}
+		id = flags.Arg(0)
+	} else if flags.NArg() != 0 {
+		return fmt.Errorf("%w: unexpected arguments %q", errUsage, flags.Args())
+	}
+	var body []byte
+	if action == "push" {
+		var err error
+		if body, err = c.readBody(*file); err != nil {
+			return err
+		}
+	}
+
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	var result interface{}
+	switch action {
+	case "list":
+		var terms string
+		if search != nil {
+			terms = *search
+		}
+		result, err = r.list(client, terms, []byte(*params))
+	case "get":
+		result, err = r.get(client, id)
+	case "push":
+		result, err = r.push(client, body)
+	case "delete":
+		return r.delete(client, id)
+	}
+	if err != nil {
+		return err
+	}
+	return c.write(result)
+}
+
+// lister adapts a list method, decoding its parameters from JSON and setting the search terms
+// through search, which is nil for resources that cannot be searched.
+func lister[P any, T any](list func(*xmatters.XMattersAPI, P) ([]*T, error), search func(*P) *xmatters.SearchQuery) func(*xmatters.XMattersAPI, string, []byte) (interface{}, error) {
+	return func(client *xmatters.XMattersAPI, terms string, data []byte) (interface{}, error) {
+		var params P
+		if len(data) > 0 {
+			if err := decodeStrict(data, &params); err != nil {
+				return nil, fmt.Errorf("%w: invalid -params: %v", errUsage, err)
+			}
+		}
+		if terms != "" && search != nil {
+			*search(&params) = xmatters.NewSearchQuery(terms)
+		}
+		return list(client, params)
+	}
+}
+
+// getter adapts a Get method that accepts GetOption functions, calling it with the default options.
+func getter[T any](get func(*xmatters.XMattersAPI, string, ...xmatters.GetOption) (T, error)) func(*xmatters.XMattersAPI, string) (interface{}, error) {
+	return func(client *xmatters.XMattersAPI, id string) (interface{}, error) {
+		return get(client, id)
+	}
+}
+
+// pusher adapts a Push method, decoding its parameters from the JSON request body.
+func pusher[P any, T any](push func(*xmatters.XMattersAPI, P) (T, error)) func(*xmatters.XMattersAPI, []byte) (interface{}, error) {
+	return func(client *xmatters.XMattersAPI, body []byte) (interface{}, error) {
+		var params P
+		if err := decodeStrict(body, &params); err != nil {
+			return nil, fmt.Errorf("decoding request body: %w", err)
+		}
+		return push(client, params)
+	}
+}
+
+// withoutPushOptions adapts a Push method that accepts PushOption functions to one called with the default options.
+func withoutPushOptions[P any, T any](push func(*xmatters.XMattersAPI, P, ...xmatters.PushOption) (T, error)) func(*xmatters.XMattersAPI, P) (T, error) {
+	return func(client *xmatters.XMattersAPI, params P) (T, error) {
+		return push(client, params)
+	}
+}
+
+// decodeStrict decodes JSON into v, rejecting unknown fields so a misspelled field is not silently ignored.
+func decodeStrict(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
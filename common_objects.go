@@ -1,5 +1,11 @@
 package xmatters
 
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
 // Pagination represents a page of results. Use the links in the links field to retrieve the rest of the result set.
 type Pagination struct {
 	Count *int64           `json:"count"`
@@ -14,12 +20,277 @@ type PaginationLinks struct {
 	Self     *string `json:"self"`
 }
 
+// embeddedList is the pagination object in which xMatters nests embedded collections, such as the roles of a person.
+// It is used by the custom marshallers to produce the same shape the custom unmarshallers read.
+type embeddedList[T any] struct {
+	Count int64            `json:"count"`
+	Total int64            `json:"total"`
+	Data  []T              `json:"data"`
+	Links *PaginationLinks `json:"links,omitempty"`
+}
+
+// newEmbeddedList wraps a collection in an embeddedList, or returns nil for a nil collection so it is omitted.
+func newEmbeddedList[T any](data []T) *embeddedList[T] {
+	if data == nil {
+		return nil
+	}
+	return &embeddedList[T]{Count: int64(len(data)), Total: int64(len(data)), Data: data}
+}
+
+// paginatedJSON is a page of a paginated list whose items are decoded into their JSON representation,
+// such as personJSON, so models with embedded collections are decoded in a single pass.
+type paginatedJSON[T any] struct {
+	*Pagination
+	Data []*T `json:"data"`
+}
+
+// countResults returns the total number of items of the list at path matching the query parameters.
+// It requests a single item and reads the total of its page, rather than retrieving every page.
+func (xmatters *XMattersAPI) countResults(path string, params interface{}) (int64, error) {
+	uri := buildURI(path, params)
+	if strings.Contains(uri, "?") {
+		uri += "&limit=1"
+	} else {
+		uri += "?limit=1"
+	}
+
+	var page Pagination
+	if err := xmatters.getJSON(uri, &page); err != nil {
+		return 0, err
+	}
+	if page.Total == nil {
+		return 0, newUnmarshalErrorIn(getExportedCallerName(1))
+	}
+	return *page.Total, nil
+}
+
+// getPaginationSet retrieves the items of a paginated list starting at uri, following the next links until
+// all pages are retrieved. When the MaxPages or MaxItems limit of the client is reached first, the items
+// retrieved so far are returned with ErrTruncated.
+func getPaginationSet[T any](xmatters *XMattersAPI, uri string) ([]*T, error) {
+	return getConvertedPaginationSet(xmatters, uri, func(item *T) *T { return item })
+}
+
+// getConvertedPaginationSet retrieves a paginated list like getPaginationSet, decoding each page into
+// JSON representations, such as personJSON, and converting them into models.
+func getConvertedPaginationSet[J any, T any](xmatters *XMattersAPI, uri string, convert func(*J) *T) ([]*T, error) {
+	var progress *progressTracker
+	if xmatters.progress != nil {
+		progress = xmatters.startProgress(getExportedCallerName(1), 0)
+	}
+
+	var items []*T
+	for pages := 1; ; pages++ {
+		var page paginatedJSON[J]
+		if err := xmatters.getJSON(uri, &page); err != nil {
+			return []*T{}, err
+		}
+		items = append(items, convertAll(page.Data, convert)...)
+		if page.Pagination != nil {
+			progress.pageDone(pages, len(items), page.Pagination.Total)
+		} else {
+			progress.pageDone(pages, len(items), nil)
+		}
+
+		if xmatters.maxItems > 0 && len(items) > xmatters.maxItems {
+			return items[:xmatters.maxItems], ErrTruncated
+		}
+		if page.Pagination == nil || page.Pagination.Links == nil || page.Pagination.Links.Next == nil {
+			return items, nil
+		}
+		if (xmatters.maxItems > 0 && len(items) == xmatters.maxItems) || (xmatters.maxPages > 0 && pages >= xmatters.maxPages) {
+			return items, ErrTruncated
+		}
+
+		next, err := xmatters.linkURI(*page.Pagination.Links.Next)
+		if err != nil {
+			return []*T{}, err
+		}
+		uri = next
+	}
+}
+
+// convertAll converts decoded JSON representations into models, keeping a nil list nil.
+func convertAll[J any, T any](items []*J, convert func(*J) *T) []*T {
+	if items == nil {
+		return nil
+	}
+	result := make([]*T, len(items))
+	for i, item := range items {
+		if item != nil {
+			result[i] = convert(item)
+		}
+	}
+	return result
+}
+
+// getEmbeddedPages appends the remaining pages of an embedded collection to data, following the next links
+// of the embedded pagination object. Single-object requests only include the first page of embedded collections.
+func getEmbeddedPages[T any](xmatters *XMattersAPI, data []T, links *PaginationLinks) ([]T, error) {
+	for links != nil && links.Next != nil {
+		nextUri, err := xmatters.linkURI(*links.Next)
+		if err != nil {
+			return data, err
+		}
+		var page embeddedList[T]
+		if err := xmatters.getJSON(nextUri, &page); err != nil {
+			return data, err
+		}
+		data = append(data, page.Data...)
+		links = page.Links
+	}
+	return data, nil
+}
+
+// FollowLink retrieves the resource a link returned by xMatters points to, such as the next page of a list,
+// and decodes the JSON response into dest. The link may be a path, such as "/api/xm/1/people?offset=100&limit=100",
+// or an absolute URL of the instance of the client; links to other hosts are rejected so the credentials of the
+// client are never sent elsewhere.
+// Example usage:
+//
+//	var next xmatters.PersonPagination
+//	err := client.FollowLink(*page.Links.Next, &next)
+func (xmatters *XMattersAPI) FollowLink(link string, dest interface{}) error {
+	uri, err := xmatters.linkURI(link)
+	if err != nil {
+		return err
+	}
+	return xmatters.getJSON(uri, dest)
+}
+
+// linkURI converts a link returned by xMatters into a URI relative to the base URL of the client, as accepted by
+// Request. The base path of the API, such as /api/xm/1, is removed from the start of the link path.
+func (xmatters *XMattersAPI) linkURI(link string) (string, error) {
+	parsed, base, err := xmatters.parseInstanceURL(link)
+	if err != nil {
+		return "", err
+	}
+
+	path := parsed.EscapedPath()
+	for _, segment := range strings.Split(path, "/") {
+		if unescaped, err := url.PathUnescape(segment); segment == ".." || err != nil || unescaped == ".." {
+			return "", newValidationError(fmt.Sprintf("link %q must not contain parent path segments", link))
+		}
+	}
+	basePath := strings.TrimSuffix(base.EscapedPath(), "/")
+	if basePath != "" && (path == basePath || strings.HasPrefix(path, basePath+"/")) {
+		path = strings.TrimPrefix(path, basePath)
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if parsed.RawQuery != "" {
+		path += "?" + parsed.RawQuery
+	}
+	return path, nil
+}
+
+// parseInstanceURL parses a URL, which may be relative, along with the base URL of the client.
+// It returns a validation error when the URL names a host or scheme other than those of the client,
+// so the credentials of the client are only ever sent to its own xMatters instance.
+func (xmatters *XMattersAPI) parseInstanceURL(link string) (*url.URL, *url.URL, error) {
+	parsed, err := url.Parse(link)
+	if err != nil || link == "" {
+		return nil, nil, newValidationError(fmt.Sprintf("invalid link %q", link))
+	}
+	base, err := url.Parse(*xmatters.BaseURL)
+	if err != nil {
+		return nil, nil, newValidationError(fmt.Sprintf("invalid base URL %q", *xmatters.BaseURL))
+	}
+	if parsed.Host != "" && (!strings.EqualFold(parsed.Host, base.Host) || (parsed.Scheme != "" && parsed.Scheme != base.Scheme)) {
+		return nil, nil, newValidationError(fmt.Sprintf("link %q is not on the xMatters instance of the client", link))
+	}
+	return parsed, base, nil
+}
+
 // ReferenceById represents the identifier of a resource.
 type ReferenceById struct {
-	ID *string `json:"id"`
+	ID *string `json:"id" tfsdk:"id"`
 }
 
 // ReferenceByName identifies a resource by name.
 type ReferenceByName struct {
-	Name *string `json:"name"`
+	Name *string `json:"name" tfsdk:"name"`
+}
+
+// Status represents the status of a person or group in xMatters.
+type Status string
+
+const (
+	// StatusActive indicates the person or group is active.
+	StatusActive Status = "ACTIVE"
+	// StatusInactive indicates the person or group is inactive.
+	StatusInactive Status = "INACTIVE"
+)
+
+// IsValid reports whether the Status is one of the statuses supported by xMatters.
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusActive, StatusInactive:
+		return true
+	}
+	return false
+}
+
+// LicenseType represents the license assigned to a person in xMatters.
+type LicenseType string
+
+const (
+	// LicenseTypeFullAccess is the license for people who can respond to and manage events.
+	LicenseTypeFullAccess LicenseType = "FULL_ACCESS_USER"
+	// LicenseTypeStakeholder is the license for people who only receive stakeholder notifications.
+	LicenseTypeStakeholder LicenseType = "STAKEHOLDER_USER"
+)
+
+// IsValid reports whether the LicenseType is one of the license types supported by xMatters.
+func (l LicenseType) IsValid() bool {
+	switch l {
+	case LicenseTypeFullAccess, LicenseTypeStakeholder:
+		return true
+	}
+	return false
+}
+
+// GroupType represents the type of a group in xMatters.
+type GroupType string
+
+const (
+	// GroupTypeOnCall is a group that notifies members according to its shifts.
+	GroupTypeOnCall GroupType = "ON_CALL"
+	// GroupTypeBroadcast is a group that notifies all members at once.
+	GroupTypeBroadcast GroupType = "BROADCAST"
+	// GroupTypeDynamic is a group whose members are determined by criteria.
+	GroupTypeDynamic GroupType = "DYNAMIC"
+)
+
+// IsValid reports whether the GroupType is one of the group types supported by xMatters.
+func (g GroupType) IsValid() bool {
+	switch g {
+	case GroupTypeOnCall, GroupTypeBroadcast, GroupTypeDynamic:
+		return true
+	}
+	return false
+}
+
+// RecipientType represents the type of a recipient in xMatters.
+type RecipientType string
+
+const (
+	// RecipientTypePerson is a person.
+	RecipientTypePerson RecipientType = "PERSON"
+	// RecipientTypeGroup is a group.
+	RecipientTypeGroup RecipientType = "GROUP"
+	// RecipientTypeDevice is a device.
+	RecipientTypeDevice RecipientType = "DEVICE"
+	// RecipientTypeDynamicTeam is a dynamic team.
+	RecipientTypeDynamicTeam RecipientType = "DYNAMIC_TEAM"
+)
+
+// IsValid reports whether the RecipientType is one of the recipient types supported by xMatters.
+func (r RecipientType) IsValid() bool {
+	switch r {
+	case RecipientTypePerson, RecipientTypeGroup, RecipientTypeDevice, RecipientTypeDynamicTeam:
+		return true
+	}
+	return false
 }
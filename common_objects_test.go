@@ -0,0 +1,97 @@
+package xmatters
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLinkURI(t *testing.T) {
+	tests := []struct {
+		name    string
+		link    string
+		want    string
+		wantErr bool
+	}{
+		{name: "path", link: "/api/xm/1/people?offset=100&limit=100", want: "/people?offset=100&limit=100"},
+		{name: "path without the base path", link: "/people?offset=100", want: "/people?offset=100"},
+		{name: "relative path", link: "people", want: "/people"},
+		{name: "base path only", link: "/api/xm/1", want: "/"},
+		{name: "absolute URL", link: "https://acme.xmatters.com/api/xm/1/groups/a%2Fb/members", want: "/groups/a%2Fb/members"},
+		{name: "host in another case", link: "https://ACME.xmatters.com/api/xm/1/people", want: "/people"},
+		{name: "similar base path", link: "/api/xm/10/people", want: "/api/xm/10/people"},
+		{name: "other host", link: "https://evil.example.com/api/xm/1/people", wantErr: true},
+		{name: "subdomain of the host", link: "https://acme.xmatters.com.evil.example.com/api/xm/1/people", wantErr: true},
+		{name: "other port", link: "https://acme.xmatters.com:8443/api/xm/1/people", wantErr: true},
+		{name: "other scheme", link: "http://acme.xmatters.com/api/xm/1/people", wantErr: true},
+		{name: "scheme-relative URL", link: "//evil.example.com/api/xm/1/people", wantErr: true},
+		{name: "parent segment", link: "/api/xm/1/../../admin", wantErr: true},
+		{name: "escaped parent segment", link: "/api/xm/1/%2e%2E/admin", wantErr: true},
+		{name: "empty", link: "", wantErr: true},
+		{name: "unparsable", link: "https://acme.xmatters.com/%zz", wantErr: true},
+	}
+	hostname := "acme.xmatters.com"
+	client, err := NewWithToken(&hostname, StringPtr("token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.linkURI(tt.link)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %q, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginationCrossHostNextLink(t *testing.T) {
+	otherRequests := 0
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		otherRequests++
+		fmt.Fprint(w, `{"count":0,"total":0,"data":[]}`)
+	}))
+	defer other.Close()
+
+	tests := []struct {
+		name    string
+		next    string
+		wantErr bool
+	}{
+		{name: "same instance", next: "/api/xm/1/sites?offset=1"},
+		{name: "other host", next: other.URL + "/api/xm/1/sites?offset=1", wantErr: true},
+		{name: "scheme-relative", next: "//" + other.Listener.Addr().String() + "/api/xm/1/sites?offset=1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("offset") == "1" {
+					fmt.Fprint(w, `{"count":1,"total":2,"data":[{"id":"s2","name":"Second"}]}`)
+					return
+				}
+				fmt.Fprintf(w, `{"count":1,"total":2,"data":[{"id":"s1","name":"First"}],"links":{"next":%q}}`, tt.next)
+			})
+			sites, err := client.GetSiteList(GetSitesParams{})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %d sites, want an error", len(sites))
+				}
+			} else if err != nil || len(sites) != 2 {
+				t.Fatalf("got %d sites and %v, want 2 sites", len(sites), err)
+			}
+			if otherRequests != 0 {
+				t.Errorf("sent %d requests to the other host", otherRequests)
+			}
+		})
+	}
+}
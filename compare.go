@@ -0,0 +1,280 @@
+package xmatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Drift Report Structs
+// -------------------------------------------------------------------------------------------------
+
+// DriftReport describes the differences between two xMatters instances.
+// Missing objects exist only in the first instance, Extra objects exist only in the second instance,
+// and Differing objects exist in both but with different configuration.
+type DriftReport struct {
+	Missing   []*DriftItem `json:"missing"`
+	Extra     []*DriftItem `json:"extra"`
+	Differing []*DriftItem `json:"differing"`
+}
+
+// DriftItem identifies a single object in a DriftReport.
+// Key is the human-readable identifier used to match the object between instances, and Fields lists
+// the top-level fields that differ for objects in DriftReport.Differing.
+type DriftItem struct {
+	Resource SnapshotResource `json:"resource"`
+	Key      string           `json:"key"`
+	Fields   []string         `json:"fields,omitempty"`
+}
+
+// HasDrift reports whether any differences were found.
+func (r *DriftReport) HasDrift() bool {
+	return len(r.Missing) > 0 || len(r.Extra) > 0 || len(r.Differing) > 0
+}
+
+// -------------------------------------------------------------------------------------------------
+// Drift Detection Methods
+// -------------------------------------------------------------------------------------------------
+
+// Compare exports the selected resource types from two xMatters instances and reports the differences between them,
+// such as for keeping a non-production instance aligned with production. All resource types are compared when
+// no scope is provided. Objects are matched by human-readable identifiers and references are compared by name,
+// so differing IDs between instances are not reported as drift.
+func Compare(instanceA, instanceB *XMattersAPI, scope ...SnapshotResource) (*DriftReport, error) {
+	// Groups are needed to compare rosters and shifts, and everything referenced is needed to resolve names
+	resources := AllSnapshotResources
+	if len(scope) > 0 {
+		resources = []SnapshotResource{SnapshotSites, SnapshotPeople, SnapshotDevices, SnapshotGroups, SnapshotServices}
+		resources = append(resources, scope...)
+	}
+
+	snapshotA, err := (&Exporter{Resources: resources, xmatters: instanceA}).Export()
+	if err != nil {
+		return nil, err
+	}
+	snapshotB, err := (&Exporter{Resources: resources, xmatters: instanceB}).Export()
+	if err != nil {
+		return nil, err
+	}
+	return CompareSnapshots(snapshotA, snapshotB, scope...), nil
+}
+
+// CompareSnapshots reports the differences between two snapshots, using the same rules as Compare.
+func CompareSnapshots(snapshotA, snapshotB *Snapshot, scope ...SnapshotResource) *DriftReport {
+	report := &DriftReport{Missing: []*DriftItem{}, Extra: []*DriftItem{}, Differing: []*DriftItem{}}
+	if len(scope) == 0 {
+		scope = AllSnapshotResources
+	}
+
+	namesA := newSnapshotNames(snapshotA)
+	namesB := newSnapshotNames(snapshotB)
+	for _, resource := range scope {
+		compareNormalized(report, resource, namesA.normalize(resource, snapshotA), namesB.normalize(resource, snapshotB))
+	}
+	return report
+}
+
+// snapshotNames maps the IDs of objects in a snapshot to their human-readable identifiers.
+type snapshotNames struct {
+	sites    map[string]string
+	people   map[string]string
+	devices  map[string]string
+	groups   map[string]string
+	services map[string]string
+}
+
+// newSnapshotNames indexes the human-readable identifiers of the objects in a snapshot.
+func newSnapshotNames(snapshot *Snapshot) *snapshotNames {
+	names := &snapshotNames{
+		sites:    make(map[string]string),
+		people:   make(map[string]string),
+		devices:  make(map[string]string),
+		groups:   make(map[string]string),
+		services: make(map[string]string),
+	}
+	for _, site := range snapshot.Sites {
+		names.sites[stringValue(site.ID)] = stringValue(site.Name)
+	}
+	for _, person := range snapshot.People {
+		names.people[stringValue(person.ID)] = stringValue(person.TargetName)
+	}
+	for _, device := range snapshot.Devices {
+		names.devices[stringValue(device.ID)] = deviceKey(device)
+	}
+	for _, group := range snapshot.Groups {
+		names.groups[stringValue(group.ID)] = stringValue(group.TargetName)
+	}
+	for _, service := range snapshot.Services {
+		names.services[stringValue(service.ID)] = stringValue(service.TargetName)
+	}
+	return names
+}
+
+// normalize returns the objects of a resource type keyed by their human-readable identifiers,
+// with IDs removed and references replaced by names so they can be compared between instances.
+func (names *snapshotNames) normalize(resource SnapshotResource, snapshot *Snapshot) map[string]interface{} {
+	normalized := make(map[string]interface{})
+	switch resource {
+	case SnapshotSites:
+		for _, site := range snapshot.Sites {
+			params := sitePushParams(site)
+			params.ID = ""
+			normalized[params.Name] = params
+		}
+	case SnapshotPeople:
+		for _, person := range snapshot.People {
+			params, _ := personPushParams(person, names.lookup(names.sites), names.lookup(names.people))
+			params.ID = ""
+			sort.Slice(params.Supervisors, func(a, b int) bool { return stringValue(params.Supervisors[a]) < stringValue(params.Supervisors[b]) })
+			normalized[params.TargetName] = params
+		}
+	case SnapshotDevices:
+		for _, device := range snapshot.Devices {
+			params, _ := devicePushParams(device, names.lookup(names.people))
+			params.ID = ""
+			normalized[deviceKey(device)] = params
+		}
+	case SnapshotGroups:
+		for _, group := range snapshot.Groups {
+			params, _ := groupPushParams(group, names.lookup(names.sites), names.lookup(names.people))
+			params.ID = ""
+			normalized[params.TargetName] = params
+		}
+	case SnapshotRosters:
+		for groupId, members := range snapshot.Rosters {
+			keys := []string{}
+			for _, member := range members {
+				keys = append(keys, stringValue(member.MemberType)+":"+names.member(member.MemberType, member.ID))
+			}
+			sort.Strings(keys)
+			normalized[names.groups[groupId]] = keys
+		}
+	case SnapshotShifts:
+		for groupId, shifts := range snapshot.Shifts {
+			for _, shift := range shifts {
+				copied := *shift
+				copied.ID = nil
+				copied.Group = nil
+				copied.Members = nil
+				for _, member := range shift.Members {
+					memberCopy := *member
+					memberCopy.Shift = nil
+					if member.Recipient != nil {
+						memberCopy.Recipient = &RecipientPointer{
+							ID:   StringPtr(names.member(member.Recipient.Type, member.Recipient.ID)),
+							Type: member.Recipient.Type,
+						}
+					}
+					copied.Members = append(copied.Members, &memberCopy)
+				}
+				normalized[names.groups[groupId]+"/"+stringValue(shift.Name)] = copied
+			}
+		}
+	case SnapshotServices:
+		for _, service := range snapshot.Services {
+			params, _ := servicePushParams(service, names.lookup(names.groups))
+			params.ID = ""
+			normalized[params.TargetName] = params
+		}
+	case SnapshotServiceDependencies:
+		for _, dependency := range snapshot.ServiceDependencies {
+			if dependency.Service == nil || dependency.DependentService == nil {
+				continue
+			}
+			key := fmt.Sprintf("%s -> %s", names.services[stringValue(dependency.DependentService.ID)], names.services[stringValue(dependency.Service.ID)])
+			normalized[key] = key
+		}
+	case SnapshotDynamicTeams:
+		for _, team := range snapshot.DynamicTeams {
+			copied := *team
+			copied.ID = nil
+			normalized[stringValue(team.TargetName)] = copied
+		}
+	}
+	return normalized
+}
+
+// lookup returns a function that maps an ID to its human-readable identifier, keeping unknown IDs as-is.
+func (names *snapshotNames) lookup(ids map[string]string) func(string) (string, bool) {
+	return func(id string) (string, bool) {
+		if name, ok := ids[id]; ok {
+			return name, true
+		}
+		return id, true
+	}
+}
+
+// member returns the human-readable identifier of a group or shift member.
+func (names *snapshotNames) member(memberType, id *string) string {
+	var ids map[string]string
+	switch stringValue(memberType) {
+	case "PERSON":
+		ids = names.people
+	case "GROUP":
+		ids = names.groups
+	case "DEVICE":
+		ids = names.devices
+	}
+	if name, ok := ids[stringValue(id)]; ok {
+		return name
+	}
+	return stringValue(id)
+}
+
+// compareNormalized adds the differences between two sets of normalized objects to the report.
+func compareNormalized(report *DriftReport, resource SnapshotResource, a, b map[string]interface{}) {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		valueA, inA := a[key]
+		valueB, inB := b[key]
+		switch {
+		case !inB:
+			report.Missing = append(report.Missing, &DriftItem{Resource: resource, Key: key})
+		case !inA:
+			report.Extra = append(report.Extra, &DriftItem{Resource: resource, Key: key})
+		default:
+			if fields := differingFields(valueA, valueB); len(fields) > 0 {
+				report.Differing = append(report.Differing, &DriftItem{Resource: resource, Key: key, Fields: fields})
+			}
+		}
+	}
+}
+
+// differingFields returns the top-level JSON fields that differ between two values.
+// Values that do not encode to JSON objects are reported with a single "value" field when they differ.
+func differingFields(a, b interface{}) []string {
+	aBytes, _ := json.Marshal(a)
+	bBytes, _ := json.Marshal(b)
+	if string(aBytes) == string(bBytes) {
+		return nil
+	}
+
+	var aFields, bFields map[string]json.RawMessage
+	if json.Unmarshal(aBytes, &aFields) != nil || json.Unmarshal(bBytes, &bFields) != nil {
+		return []string{"value"}
+	}
+	fields := []string{}
+	for name, value := range aFields {
+		if string(value) != string(bFields[name]) {
+			fields = append(fields, name)
+		}
+	}
+	for name := range bFields {
+		if _, ok := aFields[name]; !ok {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
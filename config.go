@@ -0,0 +1,245 @@
+package xmatters
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by NewFromEnv and NewFromConfigFile.
+const (
+	EnvHostname      = "XMATTERS_HOSTNAME"
+	EnvInstance      = "XMATTERS_INSTANCE"
+	EnvEnvironment   = "XMATTERS_ENVIRONMENT"
+	EnvRegion        = "XMATTERS_REGION"
+	EnvAuthType      = "XMATTERS_AUTH_TYPE"
+	EnvToken         = "XMATTERS_TOKEN"
+	EnvUsername      = "XMATTERS_USERNAME"
+	EnvPassword      = "XMATTERS_PASSWORD"
+	EnvRateLimit     = "XMATTERS_RATE_LIMIT"
+	EnvMaxRetries    = "XMATTERS_MAX_RETRIES"
+	EnvMinRetryDelay = "XMATTERS_MIN_RETRY_DELAY"
+	EnvMaxRetryDelay = "XMATTERS_MAX_RETRY_DELAY"
+	EnvProfile       = "XMATTERS_PROFILE"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Config Structs
+// -------------------------------------------------------------------------------------------------
+
+// ClientProfile contains the settings of a client for a single xMatters instance.
+// AuthType is "token" or "basic", and is inferred from the credentials when empty.
+// Instead of a hostname, a profile can name its Instance, Environment and Region, from which the hostname
+// is built as by Hostname.
+// Retry delays are in seconds, and the retry policy is only applied when MaxRetries is set.
+type ClientProfile struct {
+	Hostname      string  `yaml:"hostname"`
+	Instance      string  `yaml:"instance"`
+	Environment   string  `yaml:"environment"`
+	Region        string  `yaml:"region"`
+	AuthType      string  `yaml:"authType"`
+	Token         string  `yaml:"token"`
+	Username      string  `yaml:"username"`
+	Password      string  `yaml:"password"`
+	RateLimit     float64 `yaml:"rateLimit"`
+	MaxRetries    *int    `yaml:"maxRetries"`
+	MinRetryDelay int     `yaml:"minRetryDelay"`
+	MaxRetryDelay int     `yaml:"maxRetryDelay"`
+}
+
+// ClientConfig is a configuration file with a profile for each xMatters instance, such as production and
+// staging. Default names the profile used when no profile is selected.
+// Example configuration file:
+//
+//	default: production
+//	profiles:
+//	  production:
+//	    hostname: company.xmatters.com
+//	    token: ${XMATTERS_PRODUCTION_TOKEN}
+//	    rateLimit: 4
+//	  staging:
+//	    instance: company
+//	    environment: np
+//	    username: integration
+//	    password: ${XMATTERS_STAGING_PASSWORD}
+//	    maxRetries: 5
+//	    minRetryDelay: 1
+//	    maxRetryDelay: 30
+type ClientConfig struct {
+	Default  string                    `yaml:"default"`
+	Profiles map[string]*ClientProfile `yaml:"profiles"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Config Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewFromEnv creates a new instance of XMattersAPI from the XMATTERS_* environment variables,
+// such as XMATTERS_HOSTNAME and XMATTERS_TOKEN. Retry delays are in seconds.
+// The provided options are applied after the settings read from the environment, so they take precedence.
+func NewFromEnv(opts ...Option) (*XMattersAPI, error) {
+	profile, err := profileFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return profile.NewClient(opts...)
+}
+
+// NewFromConfigFile creates a new instance of XMattersAPI from a YAML configuration file.
+// The profile named by the XMATTERS_PROFILE environment variable is used, or the default profile of the file.
+// The provided options are applied after the settings of the profile, so they take precedence.
+func NewFromConfigFile(path string, opts ...Option) (*XMattersAPI, error) {
+	return NewFromProfile(path, os.Getenv(EnvProfile), opts...)
+}
+
+// NewFromProfile creates a new instance of XMattersAPI from the named profile of a YAML configuration file.
+// An empty name selects the default profile of the file.
+func NewFromProfile(path, name string, opts ...Option) (*XMattersAPI, error) {
+	config, err := LoadClientConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := config.Profile(name)
+	if err != nil {
+		return nil, err
+	}
+	return profile.NewClient(opts...)
+}
+
+// LoadClientConfig reads a YAML configuration file. References to environment variables in string values,
+// such as ${XMATTERS_TOKEN}, are expanded so credentials can be kept out of the file.
+func LoadClientConfig(path string) (*ClientConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading client config: %w", err)
+	}
+	var config ClientConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("decoding client config %s: %w", path, err)
+	}
+	for _, profile := range config.Profiles {
+		if profile == nil {
+			continue
+		}
+		for _, value := range []*string{&profile.Hostname, &profile.Instance, &profile.Environment, &profile.Region, &profile.AuthType, &profile.Token, &profile.Username, &profile.Password} {
+			*value = os.ExpandEnv(*value)
+		}
+	}
+	return &config, nil
+}
+
+// Profile returns the named profile, or the default profile when name is empty.
+// A file with a single profile and no default uses that profile.
+func (c *ClientConfig) Profile(name string) (*ClientProfile, error) {
+	if name == "" {
+		name = c.Default
+	}
+	if name == "" && len(c.Profiles) == 1 {
+		for _, profile := range c.Profiles {
+			return profile, nil
+		}
+	}
+	if name == "" {
+		return nil, newValidationError("no profile was selected and the client config has no default profile")
+	}
+	profile, ok := c.Profiles[name]
+	if !ok || profile == nil {
+		return nil, newValidationError(fmt.Sprintf("the client config has no profile named %q", name))
+	}
+	return profile, nil
+}
+
+// NewClient creates a new instance of XMattersAPI with the settings of the profile.
+// The provided options are applied after the settings of the profile, so they take precedence.
+func (p *ClientProfile) NewClient(opts ...Option) (*XMattersAPI, error) {
+	hostname := strings.TrimSuffix(strings.TrimPrefix(p.Hostname, "https://"), "/")
+	if hostname == "" && p.Instance != "" {
+		var err error
+		if hostname, err = Hostname(p.Instance, Environment(strings.ToLower(p.Environment)), Region(p.Region)); err != nil {
+			return nil, err
+		}
+	}
+	if hostname == "" {
+		return nil, ErrNoHostname
+	}
+
+	var profileOpts []Option
+	if p.RateLimit > 0 {
+		profileOpts = append(profileOpts, WithRateLimit(p.RateLimit))
+	}
+	if p.MaxRetries != nil {
+		profileOpts = append(profileOpts, WithRetryPolicy(*p.MaxRetries, p.MinRetryDelay, p.MaxRetryDelay))
+	}
+	opts = append(profileOpts, opts...)
+
+	authType := strings.ToLower(p.AuthType)
+	if authType == "" {
+		authType = "basic"
+		if p.Token != "" {
+			authType = "token"
+		}
+	}
+	switch authType {
+	case "token", "oauth":
+		if p.Token == "" {
+			return nil, newValidationError("a token is required for token authentication")
+		}
+		return NewWithToken(&hostname, StringPtr(p.Token), opts...)
+	case "basic":
+		if p.Username == "" {
+			return nil, newValidationError("a username and password, or a token, are required")
+		}
+		return NewWithBasicAuth(&hostname, StringPtr(p.Username), StringPtr(p.Password), opts...)
+	}
+	return nil, newValidationError(fmt.Sprintf("unknown auth type %q, expected token or basic", p.AuthType))
+}
+
+// profileFromEnv reads a ClientProfile from the XMATTERS_* environment variables.
+func profileFromEnv() (*ClientProfile, error) {
+	profile := &ClientProfile{
+		Hostname:    os.Getenv(EnvHostname),
+		Instance:    os.Getenv(EnvInstance),
+		Environment: os.Getenv(EnvEnvironment),
+		Region:      os.Getenv(EnvRegion),
+		AuthType:    os.Getenv(EnvAuthType),
+		Token:       os.Getenv(EnvToken),
+		Username:    os.Getenv(EnvUsername),
+		Password:    os.Getenv(EnvPassword),
+	}
+
+	var errs []error
+	if value := os.Getenv(EnvRateLimit); value != "" {
+		rateLimit, err := strconv.ParseFloat(value, 64)
+		if err != nil || rateLimit < 0 {
+			errs = append(errs, fmt.Errorf("%s must be a non-negative number, got %q", EnvRateLimit, value))
+		}
+		profile.RateLimit = rateLimit
+	}
+	for _, setting := range []struct {
+		name  string
+		value *int
+	}{{EnvMinRetryDelay, &profile.MinRetryDelay}, {EnvMaxRetryDelay, &profile.MaxRetryDelay}} {
+		if value := os.Getenv(setting.name); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed < 0 {
+				errs = append(errs, fmt.Errorf("%s must be a non-negative number of seconds, got %q", setting.name, value))
+			}
+			*setting.value = parsed
+		}
+	}
+	if value := os.Getenv(EnvMaxRetries); value != "" {
+		maxRetries, err := strconv.Atoi(value)
+		if err != nil || maxRetries < 0 {
+			errs = append(errs, fmt.Errorf("%s must be a non-negative integer, got %q", EnvMaxRetries, value))
+		}
+		profile.MaxRetries = &maxRetries
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
@@ -0,0 +1,71 @@
+package xmatters
+
+import "fmt"
+
+// -------------------------------------------------------------------------------------------------
+// Conflict Methods
+// -------------------------------------------------------------------------------------------------
+
+// checkUnchanged reads the object with the given ID again and compares it with the copy set by IfUnchanged,
+// returning ErrConflict when they differ or the object no longer exists. It does nothing without IfUnchanged.
+func checkUnchanged[T Person | Group | Service](id string, options pushOptions, get func(id string, opts ...GetOption) (T, error),
+	unchanged func(read, current T) bool) error {
+	if options.unchanged == nil {
+		return nil
+	}
+	read, ok := options.unchanged.(T)
+	if !ok {
+		return newValidationError(fmt.Sprintf("IfUnchanged was given a %T, but the pushed object is a %T", options.unchanged, read))
+	}
+	if id == "" {
+		return newValidationError("IfUnchanged requires the ID of the object to update")
+	}
+	current, err := get(id)
+	if isNotFound(err) {
+		return ErrConflict
+	}
+	if err != nil {
+		return err
+	}
+	if !unchanged(read, current) {
+		return ErrConflict
+	}
+	return nil
+}
+
+// unchangedPerson reports whether a person is unchanged since it was read, ignoring their last login,
+// which changes without the person being modified, and the roles and supervisors that were not embedded.
+func unchangedPerson(read, current Person) bool {
+	current.LastLogin = read.LastLogin
+	if read.Roles == nil {
+		current.Roles = nil
+	}
+	if read.Supervisors == nil {
+		current.Supervisors = nil
+	}
+	return read.Equal(&current)
+}
+
+// unchangedGroup reports whether a group is unchanged since it was read, ignoring the observers, supervisors
+// and services that were not embedded.
+func unchangedGroup(read, current Group) bool {
+	if read.Observers == nil {
+		current.Observers = nil
+	}
+	if read.Supervisors == nil {
+		current.Supervisors = nil
+	}
+	if read.Services == nil {
+		current.Services = nil
+	}
+	return read.Equal(&current)
+}
+
+// unchangedService reports whether a service is unchanged since it was read, ignoring the service links
+// when they were not embedded.
+func unchangedService(read, current Service) bool {
+	if read.ServiceLinks == nil {
+		current.ServiceLinks = nil
+	}
+	return read.Equal(&current)
+}
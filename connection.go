@@ -0,0 +1,168 @@
+package xmatters
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Connection Structs
+// -------------------------------------------------------------------------------------------------
+
+// ConnectionFailure classifies why VerifyConnection could not use an instance.
+type ConnectionFailure string
+
+const (
+	// ConnectionDNS means the hostname does not resolve, such as a mistyped instance name.
+	ConnectionDNS ConnectionFailure = "DNS"
+	// ConnectionTLS means the TLS handshake failed, such as a certificate that is not trusted or does not match the hostname.
+	ConnectionTLS ConnectionFailure = "TLS"
+	// ConnectionNetwork means the host could not be reached, such as a refused connection, a timeout, or a proxy failure.
+	ConnectionNetwork ConnectionFailure = "NETWORK"
+	// ConnectionNotXMatters means the host responded, but not as the xMatters REST API, such as a wrong hostname or base URL.
+	ConnectionNotXMatters ConnectionFailure = "NOT_XMATTERS"
+	// ConnectionCredentials means the instance rejected the credentials of the client.
+	ConnectionCredentials ConnectionFailure = "CREDENTIALS"
+	// ConnectionUnavailable means the instance responded with a server error, such as during maintenance.
+	ConnectionUnavailable ConnectionFailure = "UNAVAILABLE"
+)
+
+// ConnectionError is returned by VerifyConnection when the client cannot use its instance.
+// Err is the underlying error, such as a *net.DNSError or ErrInavlidCredentials.
+type ConnectionError struct {
+	Failure ConnectionFailure
+	Host    string
+	Err     error
+}
+
+// connectionHints are the actions suggested for each connection failure.
+var connectionHints = map[ConnectionFailure]string{
+	ConnectionDNS:         "check the hostname of the instance",
+	ConnectionTLS:         "check the hostname of the instance and the trusted certificate authorities",
+	ConnectionNetwork:     "check the network connection, firewall, and proxy settings",
+	ConnectionNotXMatters: "check the hostname and base URL of the instance",
+	ConnectionCredentials: "check the username and password or API token of the client",
+	ConnectionUnavailable: "try again later",
+}
+
+// -------------------------------------------------------------------------------------------------
+// Connection Methods
+// -------------------------------------------------------------------------------------------------
+
+// Error implements the error interface.
+func (e *ConnectionError) Error() string {
+	detail := fmt.Sprint(e.Err)
+	var xmerr XMattersError
+	if errors.As(e.Err, &xmerr) {
+		detail = xmerr.String()
+	}
+	return fmt.Sprintf("cannot connect to xMatters at %s (%s): %s; %s", e.Host, e.Failure, detail, connectionHints[e.Failure])
+}
+
+// Unwrap returns the underlying error.
+func (e *ConnectionError) Unwrap() error {
+	return e.Err
+}
+
+// VerifyConnection checks that the client can reach its instance and that the instance accepts its credentials,
+// so tools can fail fast at startup with a clear message. It makes a single authenticated request for one person,
+// without the retries of other requests, and returns a *ConnectionError classifying the failure, if any.
+// Credentials that are valid but lack the permission to view people still verify the connection.
+// Example usage:
+//
+//	if err := client.VerifyConnection(); err != nil {
+//	    var connErr *xmatters.ConnectionError
+//	    if errors.As(err, &connErr) && connErr.Failure == xmatters.ConnectionCredentials {
+//	        log.Fatal("xmatters: the API token was rejected")
+//	    }
+//	    log.Fatal(err)
+//	}
+func (xmatters *XMattersAPI) VerifyConnection() error {
+	host := *xmatters.BaseURL
+	if parsed, err := url.Parse(host); err == nil && parsed.Host != "" {
+		host = parsed.Host
+	}
+	fail := func(failure ConnectionFailure, err error) error {
+		return &ConnectionError{Failure: failure, Host: host, Err: err}
+	}
+
+	request, err := http.NewRequest(http.MethodGet, *xmatters.BaseURL+buildURI("/people", struct {
+		Limit int `url:"limit"`
+	}{Limit: 1}), nil)
+	if err != nil {
+		return fail(ConnectionNotXMatters, err)
+	}
+	request.Header.Set("Content-Type", ContentJSON)
+	request.Header.Set("User-Agent", *xmatters.UserAgent)
+	copyHeader(request.Header, xmatters.headers)
+
+	// Send the request once, bypassing the retries of the default client
+	client := xmatters.httpClient
+	if retrying, ok := client.Transport.(*retryablehttp.RoundTripper); ok && retrying.Client != nil && retrying.Client.HTTPClient != nil {
+		client = retrying.Client.HTTPClient
+	}
+	if xmatters.scheduler != nil {
+		if err := xmatters.scheduler.wait(context.Background(), PriorityInteractive); err != nil {
+			return fail(ConnectionNetwork, err)
+		}
+	}
+	release := xmatters.acquire()
+	defer release()
+	response, err := client.Do(request)
+	if err != nil {
+		return fail(classifyConnectionError(err), err)
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(response.Body, 1<<20))
+	if err != nil {
+		return fail(ConnectionNetwork, err)
+	}
+
+	switch {
+	case response.StatusCode == http.StatusUnauthorized:
+		return fail(ConnectionCredentials, ErrInavlidCredentials)
+	case response.StatusCode == http.StatusForbidden:
+		return nil
+	case response.StatusCode >= 500:
+		return fail(ConnectionUnavailable, fmt.Errorf("the instance responded with status %s", response.Status))
+	case response.StatusCode != http.StatusOK:
+		return fail(ConnectionNotXMatters, fmt.Errorf("the host responded with status %s", response.Status))
+	}
+
+	// A successful response must be a page of people
+	var page struct {
+		Count *int64 `json:"count"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil || page.Count == nil {
+		return fail(ConnectionNotXMatters, errors.New("the host did not respond with an xMatters API response"))
+	}
+	return nil
+}
+
+// classifyConnectionError returns the connection failure of an error returned by an HTTP client.
+func classifyConnectionError(err error) ConnectionFailure {
+	var dnsErr *net.DNSError
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidCert x509.CertificateInvalidError
+	var recordHeaderErr tls.RecordHeaderError
+	var verificationErr *tls.CertificateVerificationError
+	switch {
+	case errors.As(err, &dnsErr):
+		return ConnectionDNS
+	case errors.As(err, &unknownAuthority), errors.As(err, &hostnameErr), errors.As(err, &invalidCert),
+		errors.As(err, &recordHeaderErr), errors.As(err, &verificationErr):
+		return ConnectionTLS
+	}
+	return ConnectionNetwork
+}
@@ -0,0 +1,175 @@
+package xmatters
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Coverage Structs
+// -------------------------------------------------------------------------------------------------
+
+// CoverageReport describes how well the shifts of a group cover a window of time.
+// Gaps lists the periods that nobody is on call for, and SinglePerson the periods that rely on a single member,
+// each in chronological order.
+type CoverageReport struct {
+	Group        string            `json:"group"`
+	From         time.Time         `json:"from"`
+	To           time.Time         `json:"to"`
+	Gaps         []*CoveragePeriod `json:"gaps"`
+	SinglePerson []*CoveragePeriod `json:"singlePerson"`
+}
+
+// CoveragePeriod is a period of a CoverageReport. Members lists the target names of the members on call
+// during the period, and is empty for a gap.
+type CoveragePeriod struct {
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	Members []string  `json:"members,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Coverage Methods
+// -------------------------------------------------------------------------------------------------
+
+// AnalyzeCoverage resolves the shifts and rotations of a group over the window from from to to, and reports
+// the periods that nobody is on call for and the periods covered by a single member.
+// Temporary absences are applied, so an absent member counts as their replacement, or not at all when the
+// absence has no replacement. Members are counted once per period even when they are on call in several shifts.
+// When the client has holidays, set with WithHolidays, members are not counted during company-wide holidays
+// if none of their active devices can be reached on holidays.
+// The groupId parameter may be a group ID or target name.
+func (xmatters *XMattersAPI) AnalyzeCoverage(groupId string, from, to time.Time) (*CoverageReport, error) {
+	if err := validateIdentifier("group ID or target name", groupId); err != nil {
+		return nil, err
+	}
+	if !to.After(from) {
+		return nil, newValidationError("the end of the coverage window must be after its start")
+	}
+	from, to = from.UTC(), to.UTC()
+
+	groupName, spans, err := xmatters.getOnCallSpans(groupId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the members that cannot be reached during holidays
+	holidays := xmatters.holidays.companyHolidays(from, to)
+	unreachable := make(map[string]bool)
+	if len(holidays) > 0 {
+		checked := make(map[string]bool)
+		for _, span := range spans {
+			id := stringValue(span.member.ID)
+			if checked[id] || stringValue(span.member.RecipientType) != string(RecipientTypePerson) {
+				continue
+			}
+			checked[id] = true
+			devices, err := xmatters.GetPersonDevices(id)
+			if err != nil {
+				return nil, err
+			}
+			unreachable[id] = !reachableOnHolidays(devices)
+		}
+	}
+
+	// Split the window at every start and end of a span and holiday, so the members on call are constant
+	// between boundaries
+	boundaries := []time.Time{from, to}
+	for _, span := range spans {
+		boundaries = append(boundaries, span.start, span.end)
+	}
+	for _, holiday := range holidays {
+		boundaries = append(boundaries, holiday.Start, holiday.End)
+	}
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i].Before(boundaries[j]) })
+
+	report := &CoverageReport{Group: groupName, From: from, To: to, Gaps: []*CoveragePeriod{}, SinglePerson: []*CoveragePeriod{}}
+	for i := 0; i+1 < len(boundaries); i++ {
+		start, end := boundaries[i], boundaries[i+1]
+		if !end.After(start) {
+			continue
+		}
+		var skip map[string]bool
+		for _, holiday := range holidays {
+			if !start.Before(holiday.Start) && !end.After(holiday.End) {
+				skip = unreachable
+			}
+		}
+		members := onCallMembersBetween(spans, start, end, skip)
+		switch len(members) {
+		case 0:
+			report.Gaps = appendCoveragePeriod(report.Gaps, start, end, members)
+		case 1:
+			report.SinglePerson = appendCoveragePeriod(report.SinglePerson, start, end, members)
+		}
+	}
+	return report, nil
+}
+
+// Duration returns the length of the period.
+func (p CoveragePeriod) Duration() time.Duration {
+	return p.End.Sub(p.Start)
+}
+
+// String returns the period and its members, such as "2024-01-06T00:00:00Z - 2024-01-06T08:00:00Z (jsmith)".
+func (p CoveragePeriod) String() string {
+	period := fmt.Sprintf("%s - %s", p.Start.Format(time.RFC3339), p.End.Format(time.RFC3339))
+	if len(p.Members) > 0 {
+		period += " (" + strings.Join(p.Members, ", ") + ")"
+	}
+	return period
+}
+
+// onCallMembersBetween returns the sorted names of the distinct members whose spans cover the time from start to end,
+// leaving out the members whose IDs are skipped.
+func onCallMembersBetween(spans []*onCallSpan, start, end time.Time, skip map[string]bool) []string {
+	seen := make(map[string]bool)
+	members := []string{}
+	for _, span := range spans {
+		if span.start.After(start) || span.end.Before(end) {
+			continue
+		}
+		id := stringValue(span.member.ID)
+		if seen[id] || skip[id] {
+			continue
+		}
+		seen[id] = true
+		members = append(members, Value(span.member.TargetName, id))
+	}
+	sort.Strings(members)
+	return members
+}
+
+// appendCoveragePeriod appends a period to the list, extending the last period instead when it ends where the new
+// one starts and has the same members.
+func appendCoveragePeriod(periods []*CoveragePeriod, start, end time.Time, members []string) []*CoveragePeriod {
+	if len(periods) > 0 {
+		last := periods[len(periods)-1]
+		if last.End.Equal(start) && strings.Join(last.Members, "\x00") == strings.Join(members, "\x00") {
+			last.End = end
+			return periods
+		}
+	}
+	return append(periods, &CoveragePeriod{Start: start, End: end, Members: members})
+}
+
+// reachableOnHolidays reports whether any of the active devices can be notified on holidays: a device without
+// timeframes is always active, and a device with timeframes needs one that does not exclude holidays.
+func reachableOnHolidays(devices []*Device) bool {
+	for _, device := range devices {
+		if stringValue(device.Status) == string(StatusInactive) {
+			continue
+		}
+		if len(device.Timeframes) == 0 {
+			return true
+		}
+		for _, timeframe := range device.Timeframes {
+			if !Value(timeframe.ExcludeHolidays, false) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,392 @@
+package xmatters
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Decoding Structs
+// -------------------------------------------------------------------------------------------------
+
+// DecodeWarning describes a field of an API response that did not match its model
+// and was coerced or dropped while decoding in lenient mode.
+type DecodeWarning struct {
+	// Path is the JSON path of the field within the response, such as "data[3].externallyOwned".
+	Path string
+	// Type is the Go type of the model field.
+	Type string
+	// Value is the JSON value returned by xMatters.
+	Value string
+	// Dropped is true when the value could not be coerced and was left out of the decoded model.
+	// Dropped list items are removed from the list.
+	Dropped bool
+}
+
+// DecodeWarningHandler receives the warnings of responses decoded in lenient mode.
+type DecodeWarningHandler func(DecodeWarning)
+
+var (
+	timestampType       = reflect.TypeOf(Timestamp{})
+	int64Type           = reflect.TypeOf(int64(0))
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// -------------------------------------------------------------------------------------------------
+// Decoding Methods
+// -------------------------------------------------------------------------------------------------
+
+// String returns a one-line description of the warning, such as
+// `data[3].externallyOwned: coerced "true" to *bool`.
+func (w DecodeWarning) String() string {
+	if w.Dropped {
+		return fmt.Sprintf("%s: dropped %s, expected %s", w.Path, w.Value, w.Type)
+	}
+	return fmt.Sprintf("%s: coerced %s to %s", w.Path, w.Value, w.Type)
+}
+
+// coercion holds the state of a single coercion pass over a decoded response.
+type coercion struct {
+	// numbersOnly limits the pass to converting numbers with an integral value, such as 3.0, into integer fields.
+	numbersOnly bool
+	warnings    []DecodeWarning
+}
+
+// decode unmarshals an API response into v.
+// A response with a number such as 3.0 in an integer field is decoded again with the number converted exactly,
+// unless the client uses strict number decoding. Numbers that cannot be converted without losing precision,
+// such as 3.5, still fail to decode.
+// In lenient mode, a response that fails to decode is coerced to match the shape of v and decoded again,
+// and every coerced or dropped value is reported to the DecodeWarningHandler.
+// The original error is returned when the response is not valid JSON or cannot be coerced.
+func (xmatters *XMattersAPI) decode(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	if err == nil {
+		return nil
+	}
+	lenient := xmatters.decodeWarnings != nil
+	if !lenient && (xmatters.strictNumbers || !isNumberError(err)) {
+		return err
+	}
+
+	// Decode numbers as json.Number so large identifiers and integers keep their exact text
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document interface{}
+	if decoder.Decode(&document) != nil {
+		return err
+	}
+
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return err
+	}
+	c := &coercion{numbersOnly: !lenient}
+	document, ok := c.coerceValue("", document, target.Type().Elem())
+	if !ok {
+		return err
+	}
+	coerced, marshalErr := json.Marshal(document)
+	if marshalErr != nil {
+		return err
+	}
+
+	// Decode into a fresh value so nothing from the failed attempt is kept
+	fresh := reflect.New(target.Type().Elem())
+	if json.Unmarshal(coerced, fresh.Interface()) != nil {
+		return err
+	}
+	target.Elem().Set(fresh.Elem())
+
+	if !lenient {
+		return nil
+	}
+
+	// Report warnings in a stable order, as object fields are coerced in map order
+	sort.SliceStable(c.warnings, func(i, j int) bool { return c.warnings[i].Path < c.warnings[j].Path })
+	for _, warning := range c.warnings {
+		xmatters.decodeWarnings(warning)
+	}
+	return nil
+}
+
+// isNumberError reports whether err is caused by a JSON number that does not fit the type of its field.
+func isNumberError(err error) bool {
+	var typeErr *json.UnmarshalTypeError
+	return errors.As(err, &typeErr) && strings.HasPrefix(typeErr.Value, "number")
+}
+
+// coerceValue converts a value decoded from JSON to the shape expected by t, recording a warning for
+// every value it changes. It returns false when the value cannot be coerced and should be dropped.
+// Collections that models unwrap from pagination objects, such as Person.Roles, are coerced within the object.
+// When the pass is limited to numbers, every other value is left unchanged and nothing is dropped.
+func (c *coercion) coerceValue(path string, value interface{}, t reflect.Type) (interface{}, bool) {
+	fieldType := t
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if value == nil || t.Kind() == reflect.Interface {
+		return value, true
+	}
+
+	coerced := func(result interface{}) (interface{}, bool) {
+		c.warnings = append(c.warnings, DecodeWarning{Path: path, Type: fieldType.String(), Value: jsonText(value)})
+		return result, true
+	}
+	dropped := func() (interface{}, bool) {
+		if c.numbersOnly {
+			return value, true
+		}
+		c.warnings = append(c.warnings, DecodeWarning{Path: path, Type: fieldType.String(), Value: jsonText(value), Dropped: true})
+		return nil, false
+	}
+
+	// Outside lenient mode, only numbers decoded into integer fields are converted
+	if c.numbersOnly && t.Kind() != reflect.Struct && t.Kind() != reflect.Slice && t.Kind() != reflect.Array && t.Kind() != reflect.Map {
+		if _, isNumber := value.(json.Number); !isNumber || !isIntegerKind(t.Kind()) {
+			return value, true
+		}
+	}
+
+	// Timestamps are returned as formatted strings, but some instances return epoch milliseconds
+	if t == timestampType {
+		if c.numbersOnly {
+			return value, true
+		}
+		switch typed := value.(type) {
+		case string:
+			if typed == "" {
+				return value, true
+			}
+			if _, err := ParseTimestamp(typed); err == nil {
+				return value, true
+			}
+		case json.Number:
+			if millis, err := typed.Int64(); err == nil {
+				return coerced(time.UnixMilli(millis).UTC().Format(TimestampFormat))
+			}
+		}
+		return dropped()
+	}
+
+	// Other types that decode themselves, such as Nullable, are left unchanged
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) ||
+		(t.Kind() != reflect.Struct && reflect.PointerTo(t).Implements(jsonUnmarshalerType)) {
+		return value, true
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return dropped()
+		}
+		fields := jsonFieldTypes(t)
+		for name, field := range object {
+			fieldType, known := fields[name]
+			if !known {
+				continue
+			}
+			if result, keep := c.coerceValue(joinJSONPath(path, name), field, fieldType); keep {
+				object[name] = result
+			} else {
+				delete(object, name)
+			}
+		}
+		return object, true
+
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return value, true
+		}
+		switch typed := value.(type) {
+		case []interface{}:
+			elements := make([]interface{}, 0, len(typed))
+			for i, element := range typed {
+				if result, keep := c.coerceValue(fmt.Sprintf("%s[%d]", path, i), element, t.Elem()); keep {
+					elements = append(elements, result)
+				}
+			}
+			return elements, true
+		case map[string]interface{}:
+			// Embedded collections are nested within pagination objects
+			if data, ok := typed["data"].([]interface{}); ok {
+				result, _ := c.coerceValue(joinJSONPath(path, "data"), data, t)
+				typed["data"] = result
+				for _, name := range []string{"count", "total"} {
+					count, present := typed[name]
+					if !present {
+						continue
+					}
+					if result, keep := c.coerceValue(joinJSONPath(path, name), count, int64Type); keep {
+						typed[name] = result
+					} else {
+						delete(typed, name)
+					}
+				}
+				return typed, true
+			}
+		}
+		return dropped()
+
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return dropped()
+		}
+		for key, element := range object {
+			if result, keep := c.coerceValue(joinJSONPath(path, key), element, t.Elem()); keep {
+				object[key] = result
+			} else {
+				delete(object, key)
+			}
+		}
+		return object, true
+
+	case reflect.String:
+		switch typed := value.(type) {
+		case string:
+			return value, true
+		case json.Number:
+			return coerced(typed.String())
+		case bool:
+			return coerced(strconv.FormatBool(typed))
+		}
+
+	case reflect.Bool:
+		switch typed := value.(type) {
+		case bool:
+			return value, true
+		case string:
+			if parsed, err := strconv.ParseBool(strings.TrimSpace(typed)); err == nil {
+				return coerced(parsed)
+			}
+		case json.Number:
+			if parsed, err := strconv.ParseBool(typed.String()); err == nil {
+				return coerced(parsed)
+			}
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		unsigned := t.Kind() >= reflect.Uint
+		switch typed := value.(type) {
+		case json.Number:
+			if isInteger(typed.String(), unsigned) {
+				return value, true
+			}
+			if number, ok := integralNumber(typed.String(), unsigned); ok {
+				return coerced(number)
+			}
+		case string:
+			if number, ok := integralNumber(strings.TrimSpace(typed), unsigned); ok {
+				return coerced(number)
+			}
+		}
+
+	case reflect.Float32, reflect.Float64:
+		switch typed := value.(type) {
+		case json.Number:
+			return value, true
+		case string:
+			if _, err := strconv.ParseFloat(strings.TrimSpace(typed), 64); err == nil {
+				return coerced(json.Number(strings.TrimSpace(typed)))
+			}
+		}
+	}
+	return dropped()
+}
+
+// jsonFieldTypes returns the JSON field names of a struct and their types, following the encoding/json rules
+// for embedded structs and skipping fields tagged with "-".
+func jsonFieldTypes(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			for embeddedName, embeddedType := range jsonFieldTypes(fieldType) {
+				if _, ok := fields[embeddedName]; !ok {
+					fields[embeddedName] = embeddedType
+				}
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// isIntegerKind reports whether kind is a signed or unsigned integer kind.
+func isIntegerKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Uint64
+}
+
+// isInteger reports whether text is an integer within the range of a 64-bit signed or unsigned integer.
+func isInteger(text string, unsigned bool) bool {
+	if unsigned {
+		_, err := strconv.ParseUint(text, 10, 64)
+		return err == nil
+	}
+	_, err := strconv.ParseInt(text, 10, 64)
+	return err == nil
+}
+
+// integralNumber converts text holding an integer, or a number without a fractional part such as "3.0" or "1e3",
+// to a json.Number integer. The text is converted exactly, without passing through a float64,
+// so integers beyond 2^53 keep every digit.
+func integralNumber(text string, unsigned bool) (json.Number, bool) {
+	if isInteger(text, unsigned) {
+		return json.Number(text), true
+	}
+	// Reject exponents that would expand to an enormous integer before parsing them
+	if _, exponent, ok := strings.Cut(strings.ToLower(text), "e"); ok && len(strings.TrimLeft(exponent, "+-0")) > 2 {
+		return "", false
+	}
+	parsed, ok := new(big.Rat).SetString(text)
+	if !ok || !parsed.IsInt() {
+		return "", false
+	}
+	integer := parsed.Num().String()
+	return json.Number(integer), isInteger(integer, unsigned)
+}
+
+// joinJSONPath appends a field name to a dotted JSON path.
+func joinJSONPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// jsonText returns the JSON representation of a decoded value for warnings.
+func jsonText(value interface{}) string {
+	text, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(text)
+}
@@ -0,0 +1,216 @@
+package xmatters
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Delete Plan Structs
+// -------------------------------------------------------------------------------------------------
+
+// DeletePlanAction is an action of a DeletePlan step.
+type DeletePlanAction string
+
+const (
+	// DeleteStepRemovePersonSupervisor removes the person from the supervisors of another person.
+	DeleteStepRemovePersonSupervisor DeletePlanAction = "REMOVE_PERSON_SUPERVISOR"
+	// DeleteStepRemoveGroupSupervisor removes the person from the supervisors of a group.
+	DeleteStepRemoveGroupSupervisor DeletePlanAction = "REMOVE_GROUP_SUPERVISOR"
+	// DeleteStepRemoveGroupMember removes the person, or one of their devices, from the roster of a group.
+	DeleteStepRemoveGroupMember DeletePlanAction = "REMOVE_GROUP_MEMBER"
+	// DeleteStepDevice deletes a device owned by the person.
+	DeleteStepDevice DeletePlanAction = "DELETE_DEVICE"
+	// DeleteStepPerson deletes the person.
+	DeleteStepPerson DeletePlanAction = "DELETE_PERSON"
+)
+
+// DeletePlan lists the references to a person that must be removed before the person can be deleted,
+// and the order to remove them in. Warnings describe data that deleting the person would leave behind,
+// such as a service owned by a group that would have no members left; they are not changed by the plan.
+type DeletePlan struct {
+	PersonID   string            `json:"personId"`
+	TargetName string            `json:"targetName"`
+	Steps      []*DeletePlanStep `json:"steps"`
+	Warnings   []string          `json:"warnings"`
+}
+
+// DeletePlanStep is a single change of a DeletePlan. ObjectID identifies the object changed by the step:
+// the supervised person or group, the group of a roster, or the deleted device or person. MemberID is the
+// ID of the roster member removed by a DeleteStepRemoveGroupMember step. Done is set once the step was executed.
+type DeletePlanStep struct {
+	Action      DeletePlanAction `json:"action"`
+	ObjectID    string           `json:"objectId"`
+	MemberID    string           `json:"memberId,omitempty"`
+	Description string           `json:"description"`
+	Done        bool             `json:"done"`
+}
+
+// groupSupervisorsParams is the body of a request that modifies only the supervisors of a group.
+type groupSupervisorsParams struct {
+	ID          string   `json:"id"`
+	Supervisors []string `json:"supervisors"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Delete Plan Methods
+// -------------------------------------------------------------------------------------------------
+
+// DeletePersonPlan finds the references that would block or be orphaned by deleting a person, and returns
+// the steps that remove them followed by the deletion of the person, without changing anything.
+// The plan removes the person from the supervisors of people and groups, removes the person and their devices
+// from group rosters, deletes their devices, and then deletes the person. The personId parameter may be
+// a person ID or target name. Review the plan, such as its Warnings, and run it with ExecutePlan.
+func (xmatters *XMattersAPI) DeletePersonPlan(personId string) (*DeletePlan, error) {
+	person, err := xmatters.GetPerson(personId, WithEmbed())
+	if err != nil {
+		return nil, err
+	}
+	id, name := stringValue(person.ID), stringValue(person.TargetName)
+	plan := &DeletePlan{PersonID: id, TargetName: name, Steps: []*DeletePlanStep{}, Warnings: []string{}}
+	step := func(action DeletePlanAction, objectId, memberId, format string, args ...interface{}) {
+		plan.Steps = append(plan.Steps, &DeletePlanStep{Action: action, ObjectID: objectId, MemberID: memberId, Description: fmt.Sprintf(format, args...)})
+	}
+
+	// Supervision of people and groups
+	supervised, err := xmatters.GetPersonList(GetPeopleParams{Supervisors: id})
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range supervised {
+		step(DeleteStepRemovePersonSupervisor, stringValue(other.ID), "", "remove %s from the supervisors of %s", name, stringValue(other.TargetName))
+	}
+	supervisedGroups, err := xmatters.GetGroupList(GetGroupsParams{Supervisors: id})
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range supervisedGroups {
+		step(DeleteStepRemoveGroupSupervisor, stringValue(group.ID), "", "remove %s from the supervisors of group %s", name, stringValue(group.TargetName))
+	}
+
+	// Roster memberships of the person and their devices
+	groups, err := xmatters.GetGroupList(GetGroupsParams{Members: id})
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		step(DeleteStepRemoveGroupMember, stringValue(group.ID), id, "remove %s from the roster of group %s", name, stringValue(group.TargetName))
+		if err := xmatters.warnOrphanedServices(plan, group, id); err != nil {
+			return nil, err
+		}
+	}
+	devices, err := xmatters.GetPersonDevices(id)
+	if err != nil {
+		return nil, err
+	}
+	for _, device := range devices {
+		deviceGroups, err := xmatters.GetGroupList(GetGroupsParams{Members: stringValue(device.ID)})
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range deviceGroups {
+			step(DeleteStepRemoveGroupMember, stringValue(group.ID), stringValue(device.ID), "remove device %s of %s from the roster of group %s",
+				stringValue(device.Name), name, stringValue(group.TargetName))
+		}
+	}
+
+	// Devices, then the person
+	for _, device := range devices {
+		step(DeleteStepDevice, stringValue(device.ID), "", "delete device %s of %s", stringValue(device.Name), name)
+	}
+	step(DeleteStepPerson, id, "", "delete %s", name)
+	return plan, nil
+}
+
+// ExecutePlan runs the steps of a DeletePlan in order, marking each step Done once it succeeds.
+// It stops at the first step that fails and returns its error, so the plan can be fixed and executed again;
+// steps that are already Done are skipped.
+func (xmatters *XMattersAPI) ExecutePlan(plan *DeletePlan) error {
+	if plan == nil {
+		return newValidationError("a delete plan is required")
+	}
+	for _, step := range plan.Steps {
+		if step.Done {
+			continue
+		}
+		var err error
+		switch step.Action {
+		case DeleteStepRemovePersonSupervisor:
+			err = xmatters.removePersonSupervisor(step.ObjectID, plan.PersonID)
+		case DeleteStepRemoveGroupSupervisor:
+			err = xmatters.removeGroupSupervisor(step.ObjectID, plan.PersonID)
+		case DeleteStepRemoveGroupMember:
+			err = xmatters.DeleteGroupMembership(step.ObjectID, step.MemberID)
+		case DeleteStepDevice:
+			err = xmatters.DeleteDevice(step.ObjectID)
+		case DeleteStepPerson:
+			err = xmatters.DeletePerson(&step.ObjectID)
+		default:
+			err = newValidationError(fmt.Sprintf("unknown delete plan action %q", step.Action))
+		}
+		if isNotFound(err) {
+			// The reference was already removed
+			err = nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", step.Description, err)
+		}
+		step.Done = true
+	}
+	return nil
+}
+
+// warnOrphanedServices adds a warning to the plan for each service owned by the group when the member
+// is its only member, as the service would be left owned by an empty group.
+func (xmatters *XMattersAPI) warnOrphanedServices(plan *DeletePlan, group *Group, memberId string) error {
+	memberships, err := xmatters.GetGroupMemberships(stringValue(group.ID))
+	if err != nil {
+		return err
+	}
+	for _, membership := range memberships {
+		if stringValue(membership.Member.ID) != memberId {
+			return nil
+		}
+	}
+	services, err := xmatters.GetServiceList(GetServicesParams{OwnedBy: stringValue(group.ID)})
+	if err != nil {
+		return err
+	}
+	for _, service := range services {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf("service %s is owned by group %s, which would have no members left",
+			stringValue(service.TargetName), stringValue(group.TargetName)))
+	}
+	return nil
+}
+
+// removePersonSupervisor removes a supervisor from the supervisors of a person, keeping the others.
+func (xmatters *XMattersAPI) removePersonSupervisor(personId, supervisorId string) error {
+	person, err := xmatters.GetPerson(personId, WithEmbed("supervisors"))
+	if err != nil {
+		return err
+	}
+	supervisors := []string{}
+	for _, supervisor := range person.Supervisors {
+		if stringValue(supervisor.ID) != supervisorId {
+			supervisors = append(supervisors, stringValue(supervisor.ID))
+		}
+	}
+	_, err = xmatters.Request(http.MethodPost, buildURI("/people", nil), ContentJSON, personSupervisorsParams{ID: personId, Supervisors: supervisors})
+	return err
+}
+
+// removeGroupSupervisor removes a supervisor from the supervisors of a group, keeping the others.
+func (xmatters *XMattersAPI) removeGroupSupervisor(groupId, supervisorId string) error {
+	group, err := xmatters.GetGroup(groupId, WithEmbed("supervisors"))
+	if err != nil {
+		return err
+	}
+	supervisors := []string{}
+	for _, supervisor := range group.Supervisors {
+		if stringValue(supervisor.ID) != supervisorId {
+			supervisors = append(supervisors, stringValue(supervisor.ID))
+		}
+	}
+	_, err = xmatters.Request(http.MethodPost, buildURI("/groups", nil), ContentJSON, groupSupervisorsParams{ID: groupId, Supervisors: supervisors})
+	return err
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 )
 
 // -------------------------------------------------------------------------------------------------
@@ -13,25 +12,25 @@ import (
 
 // Device represents a device in xMatters.
 type Device struct {
-	ID                *string            `json:"id"`
-	TargetName        *string            `json:"targetName,omitempty"`
-	Country           *string            `json:"country,omitempty"`
-	DefaultDevice     *bool              `json:"defaultDevice,omitempty"`
-	Delay             *int32             `json:"delay,omitempty"`
-	DeviceType        *string            `json:"deviceType"`
-	EmailAddress      *string            `json:"emailAddress,omitempty"`
-	ExternalKey       *string            `json:"externalKey,omitempty"`
-	ExternallyOwned   *bool              `json:"externallyOwned,omitempty"`
-	Name              *string            `json:"name"`
-	Owner             *PersonReference   `json:"owner"`
-	PhoneNumber       *string            `json:"phoneNumber,omitempty"`
-	PIN               *string            `json:"pin,omitempty"`
-	PriorityThreshold *string            `json:"priorityThreshold,omitempty"`
-	Sequence          *int32             `json:"sequence,omitempty"`
-	Status            *string            `json:"status,omitempty"`
-	TestStatus        *string            `json:"testStatus,omitempty"`
-	Timeframes        []*DeviceTimeframe `json:"timeframes,omitempty"`
-	TwoWayDevice      *bool              `json:"twoWayDevice,omitempty"`
+	ID                *string            `json:"id" tfsdk:"id"`
+	TargetName        *string            `json:"targetName,omitempty" tfsdk:"target_name"`
+	Country           *string            `json:"country,omitempty" tfsdk:"country"`
+	DefaultDevice     *bool              `json:"defaultDevice,omitempty" tfsdk:"default_device"`
+	Delay             *int32             `json:"delay,omitempty" tfsdk:"delay"`
+	DeviceType        *string            `json:"deviceType" tfsdk:"device_type"`
+	EmailAddress      *string            `json:"emailAddress,omitempty" tfsdk:"email_address"`
+	ExternalKey       *string            `json:"externalKey,omitempty" tfsdk:"external_key"`
+	ExternallyOwned   *bool              `json:"externallyOwned,omitempty" tfsdk:"externally_owned"`
+	Name              *string            `json:"name" tfsdk:"name"`
+	Owner             *PersonReference   `json:"owner" tfsdk:"owner"`
+	PhoneNumber       *string            `json:"phoneNumber,omitempty" tfsdk:"phone_number"`
+	PIN               *string            `json:"pin,omitempty" tfsdk:"pin"`
+	PriorityThreshold *string            `json:"priorityThreshold,omitempty" tfsdk:"priority_threshold"`
+	Sequence          *int32             `json:"sequence,omitempty" tfsdk:"sequence"`
+	Status            *string            `json:"status,omitempty" tfsdk:"status"`
+	TestStatus        *string            `json:"testStatus,omitempty" tfsdk:"test_status"`
+	Timeframes        []*DeviceTimeframe `json:"timeframes,omitempty" tfsdk:"timeframes"`
+	TwoWayDevice      *bool              `json:"twoWayDevice,omitempty" tfsdk:"two_way_device"`
 }
 
 // DevicePagination contains a paginated list of devices.
@@ -41,6 +40,23 @@ type DevicePagination struct {
 	*Pagination
 }
 
+// deviceJSON is the JSON representation of a Device, with its embedded collections nested within pagination objects.
+type deviceJSON struct {
+	deviceFields
+	Timeframes embeddedList[*DeviceTimeframe] `json:"timeframes"`
+}
+
+// deviceFields has the fields of a Device without its methods, so decoding it does not call UnmarshalJSON.
+type deviceFields Device
+
+// DeviceReference represents a shorthand version of a device in xMatters.
+type DeviceReference struct {
+	ID         *string `json:"id" tfsdk:"id"`
+	TargetName *string `json:"targetName,omitempty" tfsdk:"target_name"`
+	Name       *string `json:"name,omitempty" tfsdk:"name"`
+	DeviceType *string `json:"deviceType,omitempty" tfsdk:"device_type"`
+}
+
 // -----------------------------------------------------------------------------------
 // DeviceTimeframe Structs
 // -----------------------------------------------------------------------------------
@@ -71,6 +87,7 @@ type GetDevicesParams struct {
 	DeviceStatus string `url:"deviceStatus,omitempty"`
 	DeviceType   string `url:"deviceType,omitempty"`
 	DeviceNames  string `url:"deviceNames,omitempty"`
+	ExternalKey  string `url:"externalKey,omitempty"`
 }
 
 // PushDeviceParams contains available API body parameters for the PushDevice method.
@@ -84,17 +101,17 @@ type PushDeviceParams struct {
 	TestStatus        string             `json:"testStatus"`
 	Timeframes        []*DeviceTimeframe `json:"timeframes"`
 	// Optional Fields
-	ID              string  `json:"id,omitempty"`
-	Country         string  `json:"country,omitempty"`
-	DefaultDevice   *bool   `json:"defaultDevice,omitempty"`
-	Delay           *int32  `json:"delay"`
-	EmailAddress    string  `json:"emailAddress,omitempty"`
-	ExternalKey     *string `json:"externalKey"`
-	ExternallyOwned *bool   `json:"externallyOwned"`
-	PhoneNumber     string  `json:"phoneNumber,omitempty"`
-	PIN             string  `json:"pin,omitempty"`
-	Status          string  `json:"status,omitempty"`
-	TwoWayDevice    *bool   `json:"twoWayDevice"`
+	ID              string           `json:"id,omitempty"`
+	Country         string           `json:"country,omitempty"`
+	DefaultDevice   *bool            `json:"defaultDevice,omitempty"`
+	Delay           Nullable[int32]  `json:"delay,omitempty"`
+	EmailAddress    string           `json:"emailAddress,omitempty"`
+	ExternalKey     Nullable[string] `json:"externalKey,omitempty"`
+	ExternallyOwned Nullable[bool]   `json:"externallyOwned,omitempty"`
+	PhoneNumber     string           `json:"phoneNumber,omitempty"`
+	PIN             string           `json:"pin,omitempty"`
+	Status          string           `json:"status,omitempty"`
+	TwoWayDevice    Nullable[bool]   `json:"twoWayDevice,omitempty"`
 }
 
 // -------------------------------------------------------------------------------------------------
@@ -104,35 +121,55 @@ type PushDeviceParams struct {
 // Custom Unmarshaller for Device to handle embedded timeframes
 // This is necessary because the JSON structure for timeframes is nested within a pagination object.
 func (d *Device) UnmarshalJSON(data []byte) error {
-	// Define an alias to avoid recursion
-	type Alias Device
-	aux := &struct {
-		Timeframes struct {
-			Data []*DeviceTimeframe `json:"data"`
-		} `json:"timeframes"`
-		*Alias
-	}{
-		Alias: (*Alias)(d),
-	}
-
-	// Unmarshal the JSON into the auxiliary struct
-	if err := json.Unmarshal(data, &aux); err != nil {
+	var decoded deviceJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
 		return fmt.Errorf("failed to unmarshal Device: %w", err)
 	}
+	*d = *decoded.device()
+	return nil
+}
 
-	// Assign the extracted device timeframes
-	d.Timeframes = aux.Timeframes.Data
+// Custom Marshaller for Device to nest embedded timeframes within pagination objects
+// This mirrors UnmarshalJSON, so a marshalled Device can be unmarshalled again without losing data.
+func (d Device) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Timeframes *embeddedList[*DeviceTimeframe] `json:"timeframes,omitempty"`
+		deviceFields
+	}{
+		Timeframes:   newEmbeddedList(d.Timeframes),
+		deviceFields: deviceFields(d),
+	})
+}
 
-	return nil
+// device returns the Device held by its JSON representation, taking its embedded collections out of their pagination objects.
+func (d *deviceJSON) device() *Device {
+	result := (*Device)(&d.deviceFields)
+	result.Timeframes = d.Timeframes.Data
+	return result
+}
+
+// String returns a compact, human-readable summary of the device, such as "Device jsmith|Work Email (EMAIL, ACTIVE)".
+func (d Device) String() string {
+	return formatSummary("Device", stringValue(d.TargetName), stringValue(d.DeviceType), stringValue(d.Status))
+}
+
+// PushParams returns the parameters that push the device as it is, so an update can change selected fields
+// without clearing the others.
+func (d Device) PushParams() PushDeviceParams {
+	params, _ := devicePushParams(&d, identity)
+	return params
 }
 
 // GetDevice retrieves a device in xMatters.
 // It requires the deviceId parameter to identify the specific device, and returns a Device object.
 // A URL parameter is added to the request URI to embed timeframes of the device in the response.
-func (xmatters *XMattersAPI) GetDevice(deviceId string) (Device, error) {
-	uri := buildURI(fmt.Sprintf("/devices/%s", deviceId), struct {
-		Embed string `url:"embed"`
-	}{Embed: "timeframes"})
+// Optional GetOption values, such as WithEmbed, override the embedded objects and fields of the response.
+func (xmatters *XMattersAPI) GetDevice(deviceId string, opts ...GetOption) (Device, error) {
+	if err := validateIdentifier("device ID or target name", deviceId); err != nil {
+		return Device{}, err
+	}
+
+	uri := buildURI(fmt.Sprintf("/devices/%s", pathSegment(deviceId)), parseGetOptions("timeframes", opts))
 
 	// Perform the API request
 	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
@@ -142,7 +179,7 @@ func (xmatters *XMattersAPI) GetDevice(deviceId string) (Device, error) {
 
 	// Unmarshal the response into a Device struct.
 	var result Device
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return Device{}, newUnmarshalError()
 	}
@@ -159,55 +196,48 @@ func (xmatters *XMattersAPI) GetDeviceList(params GetDevicesParams) ([]*Device,
 	// Use the GetDevicePaginationSet method to get all paginated results
 	deviceList, err := xmatters.GetDevicePaginationSet(uri)
 	if err != nil {
-		return []*Device{}, err
+		return deviceList, err
 	}
 
 	// Return the list of devices
 	return deviceList, nil
 }
 
-// GetDevicePaginationSet is a recursive helper function that handles a paginated list of devices.
-// It takes a URI as input and retrieves the paginated set from that URI.
-// It checks for additional pages and recursively fetches them until all pages are retrieved.
+// GetDevicePaginationSet retrieves a paginated list of devices.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
 func (xmatters *XMattersAPI) GetDevicePaginationSet(uri string) ([]*Device, error) {
-	// Perform the API request with provided URI
-	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
-	if err != nil {
-		return []*Device{}, err
-	}
+	return getConvertedPaginationSet(xmatters, uri, (*deviceJSON).device)
+}
 
-	// Unmarshal the response into a DevicePagination struct.
-	var devicePagination DevicePagination
-	err = json.Unmarshal(resp, &devicePagination)
-	if err != nil {
-		return []*Device{}, newUnmarshalError()
+// GetDeviceByExternalKey retrieves the device in xMatters with the given external key, with its timeframes embedded.
+// It returns a 404 XMattersError when no device has the key, and an error when more than one device has it.
+func (xmatters *XMattersAPI) GetDeviceByExternalKey(externalKey string) (Device, error) {
+	if err := validateIdentifier("external key", externalKey); err != nil {
+		return Device{}, err
 	}
-
-	// Assign devices to be returned
-	deviceList := devicePagination.Devices
-
-	// Check for additional paginated results
-	if devicePagination.Pagination.Links.Next != nil {
-		// Remove defaultBasePath (/api/xm/1) from the next URI
-		nextUri := strings.ReplaceAll(*devicePagination.Pagination.Links.Next, defaultBasePath, "")
-		// Use recursion to get the next set of results
-		nextSet, err := xmatters.GetDevicePaginationSet(nextUri)
-		if err != nil {
-			return []*Device{}, err
-		}
-		deviceList = append(deviceList, nextSet...)
+	devices, err := xmatters.GetDeviceList(GetDevicesParams{Embed: "timeframes", ExternalKey: externalKey})
+	if err != nil {
+		return Device{}, err
 	}
-
-	// Return the fully concatenated list of devices from all paginated results
-	return deviceList, nil
+	return findByExternalKey("device", "devices", externalKey, devices, func(device *Device) *string { return device.ExternalKey })
 }
 
 // PushDevice either creates a new device in xMatters or modifies an existing device.
 // It requires the PushDeviceParams struct containing the device details.
 // It returns the created or modified Device object.
 // If the params.ID is provided it updates the existing device; otherwise, it creates a new one.
-func (xmatters *XMattersAPI) PushDevice(params PushDeviceParams) (Device, error) {
+// WithExternalKey sets the external key of the device and marks it as externally owned.
+func (xmatters *XMattersAPI) PushDevice(params PushDeviceParams, opts ...PushOption) (Device, error) {
 	uri := buildURI("/devices", nil) // The URI for creating or modifying a Device in xMatters
+	options := parsePushOptions(opts)
+	if options.unchanged != nil {
+		return Device{}, newValidationError("IfUnchanged does not apply to devices")
+	}
+	if options.externalKey != "" {
+		params.ExternalKey = NewNullable(options.externalKey)
+		params.ExternallyOwned = NewNullable(true)
+	}
 
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
@@ -217,7 +247,7 @@ func (xmatters *XMattersAPI) PushDevice(params PushDeviceParams) (Device, error)
 
 	// Unmarshal the response into a Device struct.
 	var result Device
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return Device{}, newUnmarshalError()
 	}
@@ -230,7 +260,11 @@ func (xmatters *XMattersAPI) PushDevice(params PushDeviceParams) (Device, error)
 // It requires the deviceId parameter to identify the specific device to be deleted.
 // It returns an error if the deletion fails.
 func (xmatters *XMattersAPI) DeleteDevice(params string) error {
-	uri := buildURI(fmt.Sprintf("/devices/%s", params), nil) // The URI for Deleting a Device in xMatters
+	if err := validateIdentifier("device ID or target name", params); err != nil {
+		return err
+	}
+
+	uri := buildURI(fmt.Sprintf("/devices/%s", pathSegment(params)), nil) // The URI for Deleting a Device in xMatters
 
 	resp, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
 	if err != nil {
@@ -239,7 +273,7 @@ func (xmatters *XMattersAPI) DeleteDevice(params string) error {
 
 	// Unmarshal the response into a Device struct.
 	var result Device
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
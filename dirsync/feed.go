@@ -0,0 +1,221 @@
+// Package dirsync synchronizes the people of an xMatters instance with a user feed from a directory,
+// such as a SCIM export of an identity provider or a CSV file with a header row, and the rosters of
+// xMatters groups with the group memberships of the directory.
+//
+// Each user of the feed is read into a Record of attribute values, and a Mapping names the attributes
+// that become the fields, site, and devices of the matching person. People created by a sync carry the
+// key of their record in their external key, which is how later syncs recognize the people they manage
+// and decide which of them left the feed.
+//
+// Usage:
+//
+//	records, err := dirsync.ReadSCIM(file)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	syncer := dirsync.New(client, dirsync.Mapping{
+//	    TargetName: "userName",
+//	    FirstName:  "name.givenName",
+//	    LastName:   "name.familyName",
+//	    Active:     "active",
+//	    Site:       "addresses.work.locality",
+//	    Devices:    []dirsync.DeviceMapping{{Name: "Work Email", DeviceType: "EMAIL", Attribute: "emails.work"}},
+//	})
+//	syncer.KeyPrefix = "okta:"
+//	syncer.Missing = dirsync.Deactivate
+//	report, err := syncer.Sync(records)
+//
+//	groupSyncer := dirsync.NewGroupSyncer(client)
+//	groupSyncer.GroupNames = map[string]string{"cn=dba,ou=groups": "Database Admins"}
+//	report, err = groupSyncer.Sync(map[string][]string{"cn=dba,ou=groups": {"alice", "bob"}})
+package dirsync
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Feed Structs
+// -------------------------------------------------------------------------------------------------
+
+// Record is a user of a directory feed, holding the values of its attributes by name. The attributes of
+// a CSV feed are named by its header row, and those of a SCIM feed by their attribute paths.
+type Record map[string]string
+
+// -------------------------------------------------------------------------------------------------
+// Feed Methods
+// -------------------------------------------------------------------------------------------------
+
+// Get returns the value of the named attribute. Names are matched exactly first, and then ignoring case,
+// as SCIM attribute names are case-insensitive.
+func (r Record) Get(name string) string {
+	if value, ok := r[name]; ok {
+		return value
+	}
+	for key, value := range r {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+	return ""
+}
+
+// ReadCSV reads the records of a CSV feed. The first row names the attributes, and every following row
+// is a record. Values are trimmed of surrounding spaces.
+func ReadCSV(r io.Reader) ([]Record, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("reading CSV feed: missing header row")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV feed: %w", err)
+	}
+	columns := make(map[string]bool, len(header))
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		if header[i] == "" || columns[header[i]] {
+			return nil, fmt.Errorf("reading CSV feed: column %d of the header row is empty or repeated", i+1)
+		}
+		columns[header[i]] = true
+	}
+
+	records := []Record{}
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading CSV feed: %w", err)
+		}
+		record := make(Record, len(header))
+		for i, column := range header {
+			record[column] = strings.TrimSpace(row[i])
+		}
+		records = append(records, record)
+	}
+}
+
+// ReadSCIM reads the records of a SCIM feed: a SCIM ListResponse, a JSON array of SCIM User resources,
+// or a single User resource. Attributes are flattened into paths:
+//
+//   - complex attributes join their sub-attributes with dots, such as "name.givenName";
+//   - multi-valued attributes are named by type, such as "emails.work" or "addresses.work.locality",
+//     and the primary value, or else the first, is also available without a type, such as "emails";
+//   - extension attributes are prefixed with their schema URN and a colon, such as
+//     "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department".
+func ReadSCIM(r io.Reader) ([]Record, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	var feed interface{}
+	if err := decoder.Decode(&feed); err != nil {
+		return nil, fmt.Errorf("reading SCIM feed: %w", err)
+	}
+
+	var resources []interface{}
+	switch value := feed.(type) {
+	case []interface{}:
+		resources = value
+	case map[string]interface{}:
+		if list, ok := value["Resources"].([]interface{}); ok {
+			resources = list
+		} else if _, ok := value["Resources"]; ok || value["totalResults"] != nil {
+			resources = []interface{}{}
+		} else {
+			resources = []interface{}{value}
+		}
+	default:
+		return nil, errors.New("reading SCIM feed: expected a ListResponse, an array of resources, or a resource")
+	}
+
+	records := make([]Record, 0, len(resources))
+	for i, resource := range resources {
+		attributes, ok := resource.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("reading SCIM feed: resource %d is not an object", i+1)
+		}
+		record := make(Record)
+		flattenSCIM(record, "", attributes)
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// flattenSCIM adds the attributes of a SCIM object to the record, prefixing their names with the path of the object.
+func flattenSCIM(record Record, prefix string, attributes map[string]interface{}) {
+	for name, value := range attributes {
+		switch {
+		case prefix != "":
+			flattenSCIMValue(record, prefix+"."+name, value)
+		case strings.HasPrefix(name, "urn:"):
+			// Extension attributes are named by the schema URN and the attribute name, separated by a colon
+			extension, ok := value.(map[string]interface{})
+			if !ok {
+				flattenSCIMValue(record, name, value)
+				continue
+			}
+			for attribute, attributeValue := range extension {
+				flattenSCIMValue(record, name+":"+attribute, attributeValue)
+			}
+		default:
+			flattenSCIMValue(record, name, value)
+		}
+	}
+}
+
+// flattenSCIMValue adds a SCIM attribute value to the record under path.
+func flattenSCIMValue(record Record, path string, value interface{}) {
+	switch value := value.(type) {
+	case nil:
+	case map[string]interface{}:
+		flattenSCIM(record, path, value)
+	case []interface{}:
+		flattenSCIMMultiValued(record, path, value)
+	default:
+		record[path] = fmt.Sprint(value)
+	}
+}
+
+// flattenSCIMMultiValued adds the values of a multi-valued SCIM attribute to the record, under their types
+// and, for the primary or first value, under the path itself. Lists of simple values are joined with commas.
+func flattenSCIMMultiValued(record Record, path string, values []interface{}) {
+	var simple []string
+	primary := -1
+	for i, value := range values {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			if value != nil {
+				simple = append(simple, fmt.Sprint(value))
+			}
+			continue
+		}
+		if kind, ok := object["type"].(string); ok && kind != "" {
+			flattenSCIMEntry(record, path+"."+kind, object)
+		}
+		if isPrimary, _ := object["primary"].(bool); isPrimary || primary < 0 {
+			primary = i
+		}
+	}
+	if primary >= 0 {
+		flattenSCIMEntry(record, path, values[primary].(map[string]interface{}))
+	}
+	if len(simple) > 0 {
+		record[path] = strings.Join(simple, ",")
+	}
+}
+
+// flattenSCIMEntry adds an entry of a multi-valued attribute to the record: its value when it has one,
+// such as an email address, or else its sub-attributes, such as the fields of an address.
+func flattenSCIMEntry(record Record, path string, entry map[string]interface{}) {
+	if value, ok := entry["value"]; ok {
+		flattenSCIMValue(record, path, value)
+		return
+	}
+	flattenSCIM(record, path, entry)
+}
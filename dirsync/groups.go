@@ -0,0 +1,161 @@
+package dirsync
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/xmatters/xmatters-go"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Group Sync Structs
+// -------------------------------------------------------------------------------------------------
+
+// GroupSyncer reconciles the rosters of xMatters groups with the group memberships of a directory,
+// such as the members of LDAP or Azure AD groups.
+type GroupSyncer struct {
+	// GroupNames translates the names of directory groups into the target names of xMatters groups.
+	// Directory groups that are not in the map sync to the xMatters group with the same name, and
+	// directory groups that translate to the same xMatters group are merged.
+	GroupNames map[string]string
+	// CreateGroups creates the xMatters groups that do not exist. Otherwise their directory groups are
+	// reported as failed.
+	CreateGroups bool
+	// NewGroup holds the fields of the groups created by the sync, such as their type and supervisors.
+	// Its TargetName is replaced by the name of each group.
+	NewGroup xmatters.PushGroupParams
+	// DryRun computes the changes without making them.
+	DryRun bool
+
+	client *xmatters.XMattersAPI
+}
+
+// -------------------------------------------------------------------------------------------------
+// Group Sync Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewGroupSyncer creates a GroupSyncer that reconciles the groups of the client's instance.
+func NewGroupSyncer(client *xmatters.XMattersAPI) *GroupSyncer {
+	return &GroupSyncer{client: client}
+}
+
+// Sync makes the people in the roster of each xMatters group match the members of its directory group.
+// The memberships list the target names of the people in each directory group, keyed by the name of the group.
+// Members that are groups or devices are left in the rosters, and groups that are not in the memberships are
+// left unchanged. Group creations are reported as changes to groups, and added and removed members as changes
+// to rosters keyed by "group/member". Failures for individual groups and members are reported in their changes
+// and the sync continues; an error is only returned if the current groups cannot be read.
+func (s *GroupSyncer) Sync(memberships map[string][]string) (*Report, error) {
+	report := &Report{Changes: []*Change{}}
+
+	// Merge the directory groups into the xMatters groups they translate to
+	desired := make(map[string]map[string]string)
+	for directoryGroup, members := range memberships {
+		targetName := directoryGroup
+		if translated, ok := s.GroupNames[directoryGroup]; ok {
+			targetName = translated
+		}
+		if desired[targetName] == nil {
+			desired[targetName] = make(map[string]string)
+		}
+		for _, member := range members {
+			if member = strings.TrimSpace(member); member != "" {
+				desired[targetName][strings.ToLower(member)] = member
+			}
+		}
+	}
+
+	// Index the current groups by target name
+	groups, err := s.client.GetGroupList(xmatters.GetGroupsParams{})
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]string, len(groups))
+	for _, group := range groups {
+		existing[strings.ToLower(xmatters.Value(group.TargetName, ""))] = xmatters.Value(group.ID, "")
+	}
+
+	for _, targetName := range sortedKeys(desired) {
+		groupId, ok := existing[strings.ToLower(targetName)]
+		if !ok {
+			if groupId, ok = s.createGroup(report, targetName); !ok {
+				continue
+			}
+		}
+		s.syncRoster(report, targetName, groupId, desired[targetName])
+	}
+	return report, nil
+}
+
+// createGroup creates a missing group, if enabled, and returns its ID. The ID is empty for a group created by a dry run.
+func (s *GroupSyncer) createGroup(report *Report, targetName string) (string, bool) {
+	if !s.CreateGroups {
+		report.fail(xmatters.SnapshotGroups, targetName, "", errors.New("the group does not exist"))
+		return "", false
+	}
+	change := report.add(xmatters.SnapshotGroups, targetName, ActionCreate)
+	if s.DryRun {
+		return "", true
+	}
+	params := s.NewGroup
+	params.TargetName = targetName
+	group, err := s.client.PushGroup(params)
+	if err != nil {
+		change.Err = err
+		return "", false
+	}
+	return xmatters.Value(group.ID, ""), true
+}
+
+// syncRoster adds the desired people that are not members of the group and removes the people that are not desired.
+// The desired people are keyed by their lowercase target name.
+func (s *GroupSyncer) syncRoster(report *Report, targetName, groupId string, desired map[string]string) {
+	current := make(map[string]*xmatters.RecipientReference)
+	if groupId != "" {
+		memberships, err := s.client.GetGroupMemberships(groupId)
+		if err != nil {
+			report.fail(xmatters.SnapshotRosters, targetName, "", err)
+			return
+		}
+		for _, membership := range memberships {
+			member := membership.Member
+			if xmatters.Value(member.RecipientType, "") == string(xmatters.RecipientTypePerson) {
+				current[strings.ToLower(xmatters.Value(member.TargetName, ""))] = &member
+			}
+		}
+	}
+
+	// Add the desired people that are not members
+	for _, key := range sortedKeys(desired) {
+		if _, ok := current[key]; ok {
+			continue
+		}
+		change := report.add(xmatters.SnapshotRosters, targetName+"/"+desired[key], ActionAdd)
+		if !s.DryRun && groupId != "" {
+			_, change.Err = s.client.PushGroupMembership(groupId, xmatters.NewGroupMember(desired[key], xmatters.RecipientTypePerson))
+		}
+	}
+
+	// Remove the members that are not desired
+	for _, key := range sortedKeys(current) {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		member := current[key]
+		change := report.add(xmatters.SnapshotRosters, targetName+"/"+xmatters.Value(member.TargetName, ""), ActionRemove)
+		if !s.DryRun {
+			change.Err = s.client.DeleteGroupMembership(groupId, xmatters.Value(member.ID, ""))
+		}
+	}
+}
+
+// sortedKeys returns the keys of a map in sorted order, so changes are made and reported in a stable order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,508 @@
+package dirsync
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/xmatters/xmatters-go"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Sync Structs
+// -------------------------------------------------------------------------------------------------
+
+// Mapping names the attributes of the records that become the fields, site, and devices of people.
+// Attributes that are not named, or that are empty in a record, leave the field of an existing person unchanged.
+type Mapping struct {
+	// TargetName is the attribute holding the target name of the person, such as "userName". It is required.
+	TargetName string
+	// Key is the attribute that identifies the user in the directory, such as "id" or "employeeNumber".
+	// It is stored in the external key of the person, after the KeyPrefix of the Syncer, so a person keeps
+	// being matched when their target name changes. The target name is used when Key is empty.
+	Key       string
+	FirstName string
+	LastName  string
+	Language  string
+	Timezone  string
+	// Site is the attribute holding the site of the person. Its values are translated by Sites, such as
+	// from office codes to site names, and values that are not in Sites are used as the site name.
+	Site  string
+	Sites map[string]string
+	// Active is the attribute reporting whether the user is active, such as "active". Users whose value is
+	// false, 0, no, inactive, disabled, or suspended are inactive. Every user is active when Active is empty.
+	Active  string
+	Devices []DeviceMapping
+
+	// DefaultSite, DefaultLanguage, and DefaultTimezone are used when a record has no value for the attribute.
+	DefaultSite     string
+	DefaultLanguage string
+	DefaultTimezone string
+	// LicenseType and Roles are assigned to the people created by the sync. They default to a full access
+	// license and the Standard User role. The license and roles of existing people are left unchanged.
+	LicenseType xmatters.LicenseType
+	Roles       []string
+}
+
+// DeviceMapping names the attribute holding the address of a device of each person. EMAIL devices take
+// the value as their email address, and other device types, such as VOICE or TEXT_PHONE, as their phone number.
+// The device is removed when a record has no value for the attribute.
+type DeviceMapping struct {
+	Name       string
+	DeviceType string
+	Attribute  string
+}
+
+// Rule is what a sync does to the people it manages whose user is inactive or missing from the feed.
+type Rule string
+
+// Rules supported by a Syncer.
+const (
+	Keep       Rule = "KEEP"
+	Deactivate Rule = "DEACTIVATE"
+	Delete     Rule = "DELETE"
+)
+
+// Action is a change made, or planned, by a sync.
+type Action string
+
+// Actions reported by a sync.
+const (
+	ActionCreate     Action = "CREATE"
+	ActionUpdate     Action = "UPDATE"
+	ActionDeactivate Action = "DEACTIVATE"
+	ActionDelete     Action = "DELETE"
+	ActionAdd        Action = "ADD"
+	ActionRemove     Action = "REMOVE"
+)
+
+// Change is a change made or planned for a person, device, group, or roster. Key is the target name of the object,
+// or the position of the record when it has no target name. Err is set when the change failed or could not be computed,
+// in which case Action is the change that was attempted, or empty.
+type Change struct {
+	Resource xmatters.SnapshotResource
+	Key      string
+	Action   Action
+	Err      error
+}
+
+// Report lists the changes of a sync. People and devices that already match their records have no change.
+type Report struct {
+	Changes []*Change
+}
+
+// Syncer reconciles the people of an xMatters instance with the records of a directory feed.
+type Syncer struct {
+	Mapping Mapping
+	// KeyPrefix is prepended to the key of each record to form the external key of its person, such as "okta:".
+	// The people whose external key has the prefix are managed by the syncer: they are matched to records by
+	// external key, and are deactivated or deleted by the Missing rule. People without the prefix are matched
+	// by target name, and adopted by setting their external key. A prefix is required by the Deactivate and Delete
+	// Missing rules, as without one every person with an external key, even one set by another system, is managed.
+	KeyPrefix string
+	// Inactive is the rule for people whose user is inactive. It defaults to Deactivate.
+	// People are never created for inactive users.
+	Inactive Rule
+	// Missing is the rule for managed people whose user is missing from the feed. It defaults to Keep.
+	Missing Rule
+	// MaxMissing stops a sync before it makes any change when the Missing rule would deactivate or delete
+	// more people than this, guarding against a truncated feed. Zero means no limit.
+	MaxMissing int
+	// DryRun computes the changes without making them.
+	DryRun bool
+
+	client *xmatters.XMattersAPI
+	sites  *xmatters.NameResolver
+}
+
+// desiredPerson is a record of the feed with its mapped key and target name.
+type desiredPerson struct {
+	record     Record
+	key        string
+	targetName string
+	active     bool
+}
+
+// -------------------------------------------------------------------------------------------------
+// Sync Methods
+// -------------------------------------------------------------------------------------------------
+
+// New creates a Syncer that reconciles the people of the client's instance using the mapping.
+func New(client *xmatters.XMattersAPI, mapping Mapping) *Syncer {
+	return &Syncer{Mapping: mapping, client: client, sites: xmatters.NewNameResolver(client, 0)}
+}
+
+// String returns a one-line description of the change, such as `CREATE people "jsmith"`.
+func (c *Change) String() string {
+	action := string(c.Action)
+	if action == "" {
+		action = "FAIL"
+	}
+	if c.Err != nil {
+		return fmt.Sprintf("%s %s %q: %v", action, c.Resource, c.Key, c.Err)
+	}
+	return fmt.Sprintf("%s %s %q", action, c.Resource, c.Key)
+}
+
+// Failed reports whether any change failed.
+func (r *Report) Failed() bool {
+	for _, change := range r.Changes {
+		if change.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Sync creates, updates, and deactivates people and their devices to match the records. Failures for
+// individual people are reported in their changes and the sync continues; an error is only returned if
+// the mapping or Missing rule is invalid, the current people cannot be read, or the MaxMissing limit is exceeded.
+func (s *Syncer) Sync(records []Record) (*Report, error) {
+	if err := s.Mapping.validate(); err != nil {
+		return nil, err
+	}
+	if (s.Missing == Deactivate || s.Missing == Delete) && s.KeyPrefix == "" {
+		return nil, fmt.Errorf("the %s Missing rule requires a KeyPrefix", s.Missing)
+	}
+	report := &Report{Changes: []*Change{}}
+	desired, present := s.desired(report, records)
+
+	// Index the current people by external key and by target name
+	people, err := s.client.GetPersonList(xmatters.GetPeopleParams{Embed: "roles,supervisors"})
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*xmatters.Person)
+	byName := make(map[string]*xmatters.Person, len(people))
+	for _, person := range people {
+		if key, ok := s.managedKey(person); ok {
+			byKey[key] = person
+		}
+		byName[strings.ToLower(xmatters.Value(person.TargetName, ""))] = person
+	}
+
+	// Match each user by external key and then by target name, so a person matched by target name after
+	// their key changed is not also treated as missing
+	current := make([]*xmatters.Person, len(desired))
+	matched := make(map[*xmatters.Person]bool, len(desired))
+	for i, person := range desired {
+		match, ok := byKey[person.key]
+		if !ok {
+			match = byName[strings.ToLower(person.targetName)]
+		}
+		if match != nil {
+			current[i] = match
+			matched[match] = true
+		}
+	}
+
+	// Find the managed people whose user is missing, and check the limit before changing anything
+	var missing []*xmatters.Person
+	if rule := s.Missing; rule == Deactivate || rule == Delete {
+		for key, person := range byKey {
+			if present[key] || matched[person] || (rule == Deactivate && xmatters.Value(person.Status, "") == string(xmatters.StatusInactive)) {
+				continue
+			}
+			missing = append(missing, person)
+		}
+	}
+	if s.MaxMissing > 0 && len(missing) > s.MaxMissing {
+		return nil, fmt.Errorf("%d people are missing from the feed, more than the limit of %d", len(missing), s.MaxMissing)
+	}
+	sort.Slice(missing, func(a, b int) bool {
+		return xmatters.Value(missing[a].TargetName, "") < xmatters.Value(missing[b].TargetName, "")
+	})
+
+	// Reconcile each user with the person they matched
+	for i, person := range desired {
+		s.syncPerson(report, person, current[i])
+	}
+
+	// Apply the Missing rule
+	for _, person := range missing {
+		s.retire(report, person, s.Missing)
+	}
+	return report, nil
+}
+
+// desired maps the records into people, reporting records without a target name and repeated keys.
+// It also returns the keys of every record, including those that failed, so their people are not treated as missing.
+func (s *Syncer) desired(report *Report, records []Record) ([]*desiredPerson, map[string]bool) {
+	var desired []*desiredPerson
+	seen := make(map[string]bool, len(records))
+	for i, record := range records {
+		person := &desiredPerson{record: record, targetName: record.Get(s.Mapping.TargetName), active: true}
+		person.key = person.targetName
+		if s.Mapping.Key != "" {
+			person.key = record.Get(s.Mapping.Key)
+		}
+		if person.targetName == "" {
+			seen[person.key] = true
+			report.fail(xmatters.SnapshotPeople, fmt.Sprintf("record %d", i+1), "", errors.New("the record has no target name"))
+			continue
+		}
+		if person.key == "" || seen[person.key] {
+			report.fail(xmatters.SnapshotPeople, person.targetName, "", fmt.Errorf("the record has an empty or repeated key %q", person.key))
+			continue
+		}
+		seen[person.key] = true
+		if s.Mapping.Active != "" {
+			person.active = isActive(record.Get(s.Mapping.Active))
+		}
+		desired = append(desired, person)
+	}
+	return desired, seen
+}
+
+// syncPerson creates or updates the person of a record, or applies the Inactive rule, and then syncs their devices.
+func (s *Syncer) syncPerson(report *Report, person *desiredPerson, current *xmatters.Person) {
+	if !person.active {
+		if current != nil {
+			rule := s.Inactive
+			if rule == "" {
+				rule = Deactivate
+			}
+			s.retire(report, current, rule)
+		}
+		return
+	}
+
+	var params, original xmatters.PushPersonParams
+	action := ActionCreate
+	if current != nil {
+		params, original = current.PushParams(), current.PushParams()
+		action = ActionUpdate
+	} else {
+		params = s.Mapping.newPersonParams()
+	}
+	if err := s.apply(&params, person); err != nil {
+		report.fail(xmatters.SnapshotPeople, person.targetName, action, err)
+		return
+	}
+
+	personId := params.ID
+	if current == nil || !reflect.DeepEqual(params, original) {
+		change := report.add(xmatters.SnapshotPeople, person.targetName, action)
+		if !s.DryRun {
+			pushed, err := s.client.PushPerson(params)
+			if err != nil {
+				change.Err = err
+				return
+			}
+			personId = xmatters.Value(pushed.ID, "")
+		}
+	}
+	s.syncDevices(report, person, personId)
+}
+
+// apply sets the mapped fields of a record on the parameters of its person.
+func (s *Syncer) apply(params *xmatters.PushPersonParams, person *desiredPerson) error {
+	mapping, record := s.Mapping, person.record
+	params.TargetName = person.targetName
+	params.Status = xmatters.StatusActive
+	params.ExternalKey = xmatters.NewNullable(s.KeyPrefix + person.key)
+	setField(&params.FirstName, record, mapping.FirstName, "")
+	setField(&params.LastName, record, mapping.LastName, "")
+	setField(&params.Language, record, mapping.Language, mapping.DefaultLanguage)
+	setField(&params.Timezone, record, mapping.Timezone, mapping.DefaultTimezone)
+	if params.WebLogin == "" {
+		params.WebLogin = person.targetName
+	}
+
+	var site string
+	setField(&site, record, mapping.Site, mapping.DefaultSite)
+	if translated, ok := mapping.Sites[site]; ok {
+		site = translated
+	}
+	if site != "" {
+		siteId, err := s.sites.ID(xmatters.ResolveSites, site)
+		if err != nil {
+			return fmt.Errorf("resolving site %q: %w", site, err)
+		}
+		params.Site = siteId
+	}
+
+	if params.ID == "" {
+		switch {
+		case params.FirstName == "" || params.LastName == "":
+			return errors.New("a first and last name are required to create a person")
+		case params.Language == "" || params.Timezone == "":
+			return errors.New("a language and timezone are required to create a person, set them in the mapping or its defaults")
+		case params.Site == "":
+			return errors.New("a site is required to create a person, set it in the mapping or its defaults")
+		}
+	}
+	return nil
+}
+
+// syncDevices creates, updates, and removes the mapped devices of a person. The devices of a person that
+// would be created by a dry run are all reported as created.
+func (s *Syncer) syncDevices(report *Report, person *desiredPerson, personId string) {
+	if len(s.Mapping.Devices) == 0 {
+		return
+	}
+	existing := make(map[string]*xmatters.Device)
+	if personId != "" {
+		devices, err := s.client.GetPersonDevices(personId)
+		if err != nil {
+			report.fail(xmatters.SnapshotDevices, person.targetName, "", err)
+			return
+		}
+		for _, device := range devices {
+			existing[strings.ToLower(xmatters.Value(device.Name, ""))] = device
+		}
+	}
+
+	for _, mapping := range s.Mapping.Devices {
+		key := person.targetName + "|" + mapping.Name
+		address := person.record.Get(mapping.Attribute)
+		current, ok := existing[strings.ToLower(mapping.Name)]
+
+		switch {
+		case address == "" && ok:
+			change := report.add(xmatters.SnapshotDevices, key, ActionDelete)
+			if !s.DryRun {
+				change.Err = s.client.DeleteDevice(xmatters.Value(current.ID, ""))
+			}
+		case address == "":
+		case ok:
+			params := current.PushParams()
+			setAddress(&params, mapping, address)
+			if reflect.DeepEqual(params, current.PushParams()) {
+				continue
+			}
+			change := report.add(xmatters.SnapshotDevices, key, ActionUpdate)
+			if !s.DryRun {
+				_, change.Err = s.client.PushDevice(params)
+			}
+		default:
+			params := xmatters.PushDeviceParams{
+				DeviceType:        mapping.DeviceType,
+				Name:              mapping.Name,
+				Owner:             personId,
+				PriorityThreshold: "LOW",
+				TestStatus:        "UNTESTED",
+				Status:            "ACTIVE",
+			}
+			setAddress(&params, mapping, address)
+			change := report.add(xmatters.SnapshotDevices, key, ActionCreate)
+			if !s.DryRun && personId != "" {
+				_, change.Err = s.client.PushDevice(params)
+			}
+		}
+	}
+}
+
+// retire deactivates or deletes a person according to the rule.
+func (s *Syncer) retire(report *Report, person *xmatters.Person, rule Rule) {
+	targetName := xmatters.Value(person.TargetName, "")
+	switch rule {
+	case Deactivate:
+		if xmatters.Value(person.Status, "") == string(xmatters.StatusInactive) {
+			return
+		}
+		change := report.add(xmatters.SnapshotPeople, targetName, ActionDeactivate)
+		if !s.DryRun {
+			params := person.PushParams()
+			params.Status = xmatters.StatusInactive
+			_, change.Err = s.client.PushPerson(params)
+		}
+	case Delete:
+		change := report.add(xmatters.SnapshotPeople, targetName, ActionDelete)
+		if !s.DryRun {
+			change.Err = s.client.DeletePerson(person.ID)
+		}
+	}
+}
+
+// managedKey returns the key of the record a person was synced from, if the person is managed by the syncer.
+func (s *Syncer) managedKey(person *xmatters.Person) (string, bool) {
+	externalKey := xmatters.Value(person.ExternalKey, "")
+	if externalKey == "" || !strings.HasPrefix(externalKey, s.KeyPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(externalKey, s.KeyPrefix), true
+}
+
+// validate checks that the mapping names the attributes it requires.
+func (m *Mapping) validate() error {
+	var errs []error
+	if m.TargetName == "" {
+		errs = append(errs, errors.New("the mapping requires a TargetName attribute"))
+	}
+	for i, device := range m.Devices {
+		if device.Name == "" || device.DeviceType == "" || device.Attribute == "" {
+			errs = append(errs, fmt.Errorf("device mapping %d requires a Name, DeviceType, and Attribute", i+1))
+		}
+	}
+	if m.LicenseType != "" && !m.LicenseType.IsValid() {
+		errs = append(errs, fmt.Errorf("invalid license type %q", m.LicenseType))
+	}
+	return errors.Join(errs...)
+}
+
+// newPersonParams returns the parameters of a person created by the sync, before the fields of its record are set.
+func (m *Mapping) newPersonParams() xmatters.PushPersonParams {
+	params := xmatters.PushPersonParams{
+		LicenseType: m.LicenseType,
+		Roles:       []*string{},
+		Supervisors: []*string{},
+	}
+	if params.LicenseType == "" {
+		params.LicenseType = xmatters.LicenseTypeFullAccess
+	}
+	roles := m.Roles
+	if len(roles) == 0 {
+		roles = []string{"Standard User"}
+	}
+	for _, role := range roles {
+		params.Roles = append(params.Roles, xmatters.StringPtr(role))
+	}
+	return params
+}
+
+// add records a change and returns it, so its error can be set once the change is made.
+func (r *Report) add(resource xmatters.SnapshotResource, key string, action Action) *Change {
+	change := &Change{Resource: resource, Key: key, Action: action}
+	r.Changes = append(r.Changes, change)
+	return change
+}
+
+// fail records a change that failed.
+func (r *Report) fail(resource xmatters.SnapshotResource, key string, action Action, err error) {
+	r.add(resource, key, action).Err = err
+}
+
+// setField sets a field to the value of the attribute in the record, or to the fallback if the record has
+// no value. The field is left unchanged if both are empty.
+func setField(field *string, record Record, attribute, fallback string) {
+	value := fallback
+	if attribute != "" {
+		if recorded := record.Get(attribute); recorded != "" {
+			value = recorded
+		}
+	}
+	if value != "" {
+		*field = value
+	}
+}
+
+// setAddress sets the email address or phone number of a device.
+func setAddress(params *xmatters.PushDeviceParams, mapping DeviceMapping, address string) {
+	if strings.EqualFold(mapping.DeviceType, "EMAIL") {
+		params.EmailAddress = address
+		return
+	}
+	params.PhoneNumber = address
+}
+
+// isActive reports whether the value of the Active attribute describes an active user.
+func isActive(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "false", "0", "no", "n", "inactive", "disabled", "suspended":
+		return false
+	}
+	return true
+}
@@ -0,0 +1,105 @@
+package xmatters
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Dynamic Team Structs
+// -------------------------------------------------------------------------------------------------
+
+// DynamicTeam represents a dynamic team in xMatters.
+// The members of a dynamic team are the people matching its criteria when an event is triggered.
+type DynamicTeam struct {
+	ID                     *string                 `json:"id"`
+	TargetName             *string                 `json:"targetName"`
+	Description            *string                 `json:"description,omitempty"`
+	ResponseCount          *int64                  `json:"responseCount,omitempty"`
+	ResponseCountThreshold *string                 `json:"responseCountThreshold,omitempty"`
+	UseEmergencyDevice     *bool                   `json:"useEmergencyDevice,omitempty"`
+	ObservedByAll          *bool                   `json:"observedByAll,omitempty"`
+	Criteria               []*DynamicTeamCriterion `json:"criteria,omitempty"`
+}
+
+// DynamicTeamPagination contains a paginated list of dynamic teams.
+// It extends the Pagination struct containing links to additional pages.
+type DynamicTeamPagination struct {
+	*Pagination
+	DynamicTeams []*DynamicTeam `json:"data"`
+}
+
+// dynamicTeamJSON is the JSON representation of a DynamicTeam, with its embedded collections nested within pagination objects.
+type dynamicTeamJSON struct {
+	dynamicTeamFields
+	Criteria embeddedList[*DynamicTeamCriterion] `json:"criteria"`
+}
+
+// dynamicTeamFields has the fields of a DynamicTeam without its methods, so decoding it does not call UnmarshalJSON.
+type dynamicTeamFields DynamicTeam
+
+// DynamicTeamCriterion represents a single condition a person must match to be a member of a dynamic team.
+type DynamicTeamCriterion struct {
+	CriterionType *string `json:"criterionType"`
+	Field         *string `json:"field,omitempty"`
+	Operand       *string `json:"operand,omitempty"`
+	Value         *string `json:"value,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Dynamic Team Methods
+// -------------------------------------------------------------------------------------------------
+
+// Custom Unmarshaller for DynamicTeam to handle embedded criteria
+// This is necessary because the JSON structure for criteria is nested within a pagination object.
+func (t *DynamicTeam) UnmarshalJSON(data []byte) error {
+	var decoded dynamicTeamJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("failed to unmarshal DynamicTeam: %w", err)
+	}
+	*t = *decoded.dynamicTeam()
+	return nil
+}
+
+// Custom Marshaller for DynamicTeam to nest embedded criteria within pagination objects
+// This mirrors UnmarshalJSON, so a marshalled DynamicTeam can be unmarshalled again without losing data.
+func (t DynamicTeam) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Criteria *embeddedList[*DynamicTeamCriterion] `json:"criteria,omitempty"`
+		dynamicTeamFields
+	}{
+		Criteria:          newEmbeddedList(t.Criteria),
+		dynamicTeamFields: dynamicTeamFields(t),
+	})
+}
+
+// dynamicTeam returns the DynamicTeam held by its JSON representation, taking its embedded collections out of their pagination objects.
+func (t *dynamicTeamJSON) dynamicTeam() *DynamicTeam {
+	result := (*DynamicTeam)(&t.dynamicTeamFields)
+	result.Criteria = t.Criteria.Data
+	return result
+}
+
+// GetDynamicTeamList retrieves a list of dynamic teams in xMatters.
+// It returns a slice of DynamicTeam objects including their criteria.
+func (xmatters *XMattersAPI) GetDynamicTeamList() ([]*DynamicTeam, error) {
+	uri := buildURI("/dynamic-teams", struct {
+		Embed string `url:"embed"`
+	}{Embed: "criteria"})
+
+	// Use the GetDynamicTeamPaginationSet method to get all paginated results
+	teamList, err := xmatters.GetDynamicTeamPaginationSet(uri)
+	if err != nil {
+		return teamList, err
+	}
+
+	// Return the full list of Dynamic Teams.
+	return teamList, nil
+}
+
+// GetDynamicTeamPaginationSet retrieves a paginated list of dynamic teams.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetDynamicTeamPaginationSet(uri string) ([]*DynamicTeam, error) {
+	return getConvertedPaginationSet(xmatters, uri, (*dynamicTeamJSON).dynamicTeam)
+}
@@ -0,0 +1,220 @@
+package xmatters
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Ensure Structs
+// -------------------------------------------------------------------------------------------------
+
+// EnsureResult describes what an Ensure method did to make an object match the desired state.
+type EnsureResult string
+
+const (
+	EnsureCreated   EnsureResult = "CREATED"
+	EnsureUpdated   EnsureResult = "UPDATED"
+	EnsureUnchanged EnsureResult = "UNCHANGED"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Ensure Methods
+// -------------------------------------------------------------------------------------------------
+
+// EnsurePerson makes sure a person matching params exists in xMatters, so provisioning scripts can be run
+// repeatedly. The person is looked up by params.TargetName: a missing person is created, and an existing person
+// is updated only when a field set in params differs from its current value. Fields that are empty or null in
+// params keep their current values. The site and supervisors may be given by name or ID, as names are resolved
+// to IDs before comparing.
+// It returns the person, created, updated or as it was found, and what was done.
+// Example usage:
+//
+//	person, result, err := client.EnsurePerson(xmatters.PushPersonParams{
+//	    TargetName: "jsmith",
+//	    FirstName:  "John",
+//	    LastName:   "Smith",
+//	    Roles:      []*string{xmatters.StringPtr("Standard User")},
+//	})
+func (xmatters *XMattersAPI) EnsurePerson(params PushPersonParams) (Person, EnsureResult, error) {
+	if len(params.Roles) > 0 {
+		// Roles are compared as a set, in the order personPushParams lists them
+		roles := append([]*string(nil), params.Roles...)
+		sort.Slice(roles, func(a, b int) bool { return stringValue(roles[a]) < stringValue(roles[b]) })
+		params.Roles = roles
+	}
+
+	// The current site and supervisors are listed by ID, so names are resolved before comparing
+	resolver := NewNameResolver(xmatters, 0)
+	var err error
+	if params.Site != "" {
+		if params.Site, err = resolver.ID(ResolveSites, params.Site); err != nil {
+			return Person{}, "", err
+		}
+	}
+	if params.Supervisors != nil {
+		supervisors := make([]*string, 0, len(params.Supervisors))
+		for _, supervisor := range params.Supervisors {
+			id, err := resolver.ID(ResolvePeople, stringValue(supervisor))
+			if err != nil {
+				return Person{}, "", err
+			}
+			supervisors = append(supervisors, StringPtr(id))
+		}
+		sortIds(supervisors, func(id *string) string { return stringValue(id) })
+		params.Supervisors = supervisors
+	}
+
+	return ensure(params.TargetName, params, xmatters.GetPerson, func(person *Person) (PushPersonParams, error) {
+		current, err := personPushParams(person, identity, identity)
+		sortIds(current.Supervisors, func(id *string) string { return stringValue(id) })
+		return current, err
+	}, func(params PushPersonParams) (Person, error) {
+		return xmatters.PushPerson(params)
+	})
+}
+
+// EnsureGroup makes sure a group matching params exists in xMatters, looked up by params.TargetName.
+// A missing group is created, and an existing group is updated only when a field set in params differs from its
+// current value, and the site and supervisors may be given by name, as with EnsurePerson.
+// It returns the group and what was done.
+func (xmatters *XMattersAPI) EnsureGroup(params PushGroupParams) (Group, EnsureResult, error) {
+	// The current site and supervisors are listed by ID, so names are resolved before comparing
+	resolver := NewNameResolver(xmatters, 0)
+	var err error
+	if params.Site != "" {
+		if params.Site, err = resolver.ID(ResolveSites, params.Site); err != nil {
+			return Group{}, "", err
+		}
+	}
+	if params.Supervisors != nil {
+		supervisors := make([]*ReferenceById, 0, len(params.Supervisors))
+		for _, supervisor := range params.Supervisors {
+			id, err := resolver.ID(ResolvePeople, stringValue(supervisor.ID))
+			if err != nil {
+				return Group{}, "", err
+			}
+			supervisors = append(supervisors, NewReferenceById(id))
+		}
+		// groupPushParams lists the current supervisors sorted by ID
+		sortIds(supervisors, func(supervisor *ReferenceById) string { return stringValue(supervisor.ID) })
+		params.Supervisors = supervisors
+	}
+
+	return ensure(params.TargetName, params, xmatters.GetGroup, func(group *Group) (PushGroupParams, error) {
+		return groupPushParams(group, identity, identity)
+	}, func(params PushGroupParams) (Group, error) {
+		return xmatters.PushGroup(params)
+	})
+}
+
+// EnsureSite makes sure a site matching params exists in xMatters, looked up by an exact match of params.Name.
+// A missing site is created, and an existing site is updated only when a field set in params differs from its
+// current value, as with EnsurePerson. It returns the site and what was done.
+func (xmatters *XMattersAPI) EnsureSite(params PushSiteParams) (Site, EnsureResult, error) {
+	getSite := func(name string, _ ...GetOption) (Site, error) {
+		return xmatters.getSiteByName(name)
+	}
+	return ensure(params.Name, params, getSite, func(site *Site) (PushSiteParams, error) {
+		return sitePushParams(site), nil
+	}, xmatters.PushSite)
+}
+
+// EnsureService makes sure a service matching params exists in xMatters, looked up by params.TargetName.
+// A missing service is created, and an existing service is updated only when a field set in params differs from
+// its current value, as with EnsurePerson. It returns the service and what was done.
+func (xmatters *XMattersAPI) EnsureService(params PushServiceParams) (Service, EnsureResult, error) {
+	return ensure(params.TargetName, params, xmatters.GetService, func(service *Service) (PushServiceParams, error) {
+		return servicePushParams(service, identity)
+	}, func(params PushServiceParams) (Service, error) {
+		return xmatters.PushService(params)
+	})
+}
+
+// ensure looks up the object named by key, creating it from desired when it does not exist, or updating it with
+// the fields of desired that drifted from the parameters of the current object.
+func ensure[P any, T any](key string, desired P, get func(id string, opts ...GetOption) (T, error),
+	currentParams func(*T) (P, error), push func(P) (T, error)) (T, EnsureResult, error) {
+	var none T
+	current, err := get(key)
+	if isNotFound(err) {
+		created, err := push(desired)
+		if err != nil {
+			return none, "", err
+		}
+		return created, EnsureCreated, nil
+	}
+	if err != nil {
+		return none, "", err
+	}
+
+	params, err := currentParams(&current)
+	if err != nil {
+		return none, "", err
+	}
+	merged, drifted, err := mergeDrifted(params, desired)
+	if err != nil {
+		return none, "", err
+	}
+	if !drifted {
+		return current, EnsureUnchanged, nil
+	}
+	updated, err := push(merged)
+	if err != nil {
+		return none, "", err
+	}
+	return updated, EnsureUpdated, nil
+}
+
+// mergeDrifted overlays the fields set in desired onto the current parameters of an object, and reports whether
+// any of them differ. Empty and null fields of desired, and its ID, are not set, so the current values are kept.
+func mergeDrifted[P any](current, desired P) (P, bool, error) {
+	var merged P
+	currentFields, err := jsonFields(current)
+	if err != nil {
+		return merged, false, err
+	}
+	desiredFields, err := jsonFields(desired)
+	if err != nil {
+		return merged, false, err
+	}
+
+	drifted := false
+	for name, value := range desiredFields {
+		if name == "id" || bytes.Equal(value, []byte("null")) || bytes.Equal(value, []byte(`""`)) {
+			continue
+		}
+		if !bytes.Equal(currentFields[name], value) {
+			currentFields[name] = value
+			drifted = true
+		}
+	}
+
+	data, err := json.Marshal(currentFields)
+	if err != nil {
+		return merged, false, err
+	}
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return merged, false, err
+	}
+	return merged, drifted, nil
+}
+
+// jsonFields encodes a value as a JSON object and returns the encoding of each of its fields.
+func jsonFields(value interface{}) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// sortIds sorts references by their ID, so lists of references are compared as sets.
+func sortIds[T any](references []T, id func(T) string) {
+	sort.Slice(references, func(a, b int) bool { return id(references[a]) < id(references[b]) })
+}
@@ -27,6 +27,32 @@ var (
 		Message: "Missing Hostname",
 		Reason:  "Bad Request",
 	}
+	// ErrEventNotFound is a generic 404 Error output used when no event matches a lookup, such as a trigger request ID.
+	ErrEventNotFound = XMattersError{
+		Code:    404,
+		Message: "No event matches the provided identifier",
+		Reason:  "Not Found",
+	}
+	// ErrEventWaitTimeout is a generic 408 Error output used when a triggered event is not created before the wait timeout expires.
+	ErrEventWaitTimeout = XMattersError{
+		Code:    408,
+		Message: "Timed out waiting for the triggered event to be created",
+		Reason:  "Request Timeout",
+	}
+	// ErrTruncated is a generic Error output returned with the partial results of a list call that reached
+	// the MaxPages or MaxItems limit of the client before retrieving every page.
+	ErrTruncated = XMattersError{
+		Code:    0,
+		Message: "The list was truncated at the page or item limit of the client",
+		Reason:  "Truncated",
+	}
+	// ErrConflict is a generic 409 Error output returned by Push methods called with IfUnchanged when the object
+	// was changed or deleted in xMatters since it was read.
+	ErrConflict = XMattersError{
+		Code:    409,
+		Message: "The object was changed in xMatters since it was read",
+		Reason:  "Conflict",
+	}
 	// General error message content
 	errUnmarshalError     = "error unmarshalling the JSON response"
 	errUnmarshalErrorBody = "error unmarshalling the JSON response error body"
@@ -45,10 +71,24 @@ func (e XMattersError) Error() string {
 	return fmt.Sprintf("xMatters API Error: %d - %s. %s\nSubcode: %s", e.Code, e.Reason, e.Message, e.Subcode)
 }
 
+// String returns a compact, single-line summary of the error, such as "xMatters 404 Not Found: Could not find a person".
+func (e XMattersError) String() string {
+	summary := fmt.Sprintf("xMatters %d %s: %s", e.Code, e.Reason, e.Message)
+	if e.Subcode != "" {
+		summary += fmt.Sprintf(" (%s)", e.Subcode)
+	}
+	return summary
+}
+
 // getFunctionName retrieves the name of the function that called `newUnmarshalError`.
 // It uses runtime.Caller to get the program counter and function name.
 func getFunctionName() string {
-	pc, _, _, ok := runtime.Caller(2) // 2 means two levels up from this function (the function that called `newUnmarshalError`)
+	return getCallerName(3) // 3 means three levels up from getCallerName (the function that called `newUnmarshalError`)
+}
+
+// getCallerName retrieves the name of the function skip levels up the call stack from getCallerName.
+func getCallerName(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip)
 	if !ok {
 		return "unknown"
 	}
@@ -56,20 +96,54 @@ func getFunctionName() string {
 	if fn == nil {
 		return "unknown"
 	}
-	// Extract function name without package
-	fullName := fn.Name()
+	// Extract function name without package or the type parameters of generic functions
+	fullName := strings.TrimSuffix(fn.Name(), "[...]")
 	return filepath.Base(fullName[strings.LastIndex(fullName, ".")+1:])
 }
 
+// getExportedCallerName returns the name of the first exported function on the call stack, starting skip frames
+// above the caller, so errors raised within unexported helpers name the API method that was called.
+func getExportedCallerName(skip int) string {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(skip+1, pcs)])
+	for {
+		frame, more := frames.Next()
+		fullName := strings.TrimSuffix(frame.Function, "[...]")
+		name := filepath.Base(fullName[strings.LastIndex(fullName, ".")+1:])
+		if name != "" && strings.ToUpper(name[:1]) == name[:1] {
+			return name
+		}
+		if !more {
+			return getCallerName(skip + 1)
+		}
+	}
+}
+
 // newUnmarshalError creates a new XMattersError with a generic unmarshal error message.
 // It uses the getFunctionName function to include the name of the function that called it.
 // This is useful for debugging and understanding where the error occurred.
 // The error code is set to 0, indicating a generic error.
 func newUnmarshalError() error {
+	return newUnmarshalErrorIn(getFunctionName())
+}
+
+// newUnmarshalErrorIn creates a new XMattersError with a generic unmarshal error message for the named function.
+// It is used by helpers, such as getJSON, that report the function that called them.
+func newUnmarshalErrorIn(functionName string) error {
 	return XMattersError{
 		Code:    0,
 		Message: errUnmarshalError,
-		Reason:  fmt.Sprintf("Internal Server Error in %s", getFunctionName()),
+		Reason:  fmt.Sprintf("Internal Server Error in %s", functionName),
+	}
+}
+
+// newValidationError creates a new XMattersError for parameters that fail client-side validation.
+// The error code is set to 0, indicating the request was never sent to xMatters.
+func newValidationError(message string) error {
+	return XMattersError{
+		Code:    0,
+		Message: message,
+		Reason:  "Bad Request",
 	}
 }
 
@@ -0,0 +1,94 @@
+package xmatters
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// DedupKeyProperty is the default name of the form property used to store an event's deduplication key.
+// The messaging forms used with the deduplication helpers must define a text property with this name,
+// or with the name set by WithDedupKeyProperty.
+const DedupKeyProperty = "dedupKey"
+
+// -------------------------------------------------------------------------------------------------
+// Event Deduplication Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewDedupKey builds a deterministic deduplication key from the provided parts, such as a monitor name and host.
+// The parts are hashed so the key has a fixed length regardless of the input.
+func NewDedupKey(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x1f")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// SetDedupKey stores the deduplication key in the event properties under the property name of the client,
+// which is DedupKeyProperty unless the client was created WithDedupKeyProperty, so events triggered with
+// TriggerEvent can be found by GetOpenEventByDedupKey.
+func (xmatters *XMattersAPI) SetDedupKey(params *TriggerEventParams, key string) {
+	if params.Properties == nil {
+		params.Properties = make(map[string]interface{})
+	}
+	params.Properties[xmatters.dedupKeyPropertyName()] = key
+}
+
+// GetOpenEventByDedupKey retrieves the active event in xMatters with the given deduplication key.
+// It returns ErrEventNotFound if no active event matches the key.
+func (xmatters *XMattersAPI) GetOpenEventByDedupKey(key string) (Event, error) {
+	eventList, err := xmatters.GetEventList(xmatters.dedupEventsParams(key))
+	if err != nil {
+		return Event{}, err
+	}
+	if len(eventList) == 0 {
+		return Event{}, ErrEventNotFound
+	}
+
+	// Return the matching Event object.
+	return *eventList[0], nil
+}
+
+// TriggerEventDeduplicated triggers an event only if no active event with the same deduplication key exists.
+// The key is added to the event properties before triggering. When a matching event is already active,
+// its request ID is returned and created is false, so monitors can update the existing alert instead.
+func (xmatters *XMattersAPI) TriggerEventDeduplicated(params TriggerEventParams, key string) (trigger EventTrigger, created bool, err error) {
+	// Return the existing event if one is still active
+	existing, err := xmatters.GetOpenEventByDedupKey(key)
+	if err == nil {
+		return EventTrigger{RequestID: existing.RequestID}, false, nil
+	}
+	if !errors.Is(err, ErrEventNotFound) {
+		return EventTrigger{}, false, err
+	}
+
+	// Trigger a new event carrying the key
+	xmatters.SetDedupKey(&params, key)
+	trigger, err = xmatters.TriggerEvent(params)
+	if err != nil {
+		return EventTrigger{}, false, err
+	}
+	return trigger, true, nil
+}
+
+// TerminateEventsByDedupKey terminates every active event in xMatters with the given deduplication key,
+// so monitors can close their alerts when the underlying condition clears. It returns the terminated events.
+func (xmatters *XMattersAPI) TerminateEventsByDedupKey(key string) ([]*Event, error) {
+	return xmatters.TerminateEvents(xmatters.dedupEventsParams(key))
+}
+
+// dedupEventsParams returns the query parameters used to find active events with a deduplication key.
+func (xmatters *XMattersAPI) dedupEventsParams(key string) GetEventsParams {
+	return GetEventsParams{
+		Status:        string(EventStatusActive),
+		PropertyName:  xmatters.dedupKeyPropertyName(),
+		PropertyValue: key,
+	}
+}
+
+// dedupKeyPropertyName returns the name of the property deduplication keys are stored in by the client.
+func (xmatters *XMattersAPI) dedupKeyPropertyName() string {
+	if xmatters.dedupKeyProperty == "" {
+		return DedupKeyProperty
+	}
+	return xmatters.dedupKeyProperty
+}
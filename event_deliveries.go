@@ -0,0 +1,128 @@
+package xmatters
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// -------------------------------------------------------------------------------------------------
+// User Delivery Structs
+// -------------------------------------------------------------------------------------------------
+
+// UserDelivery represents the delivery of an event's notifications to a single user in xMatters.
+// It includes the overall delivery status for the user, each notification sent to their devices,
+// and the response the user selected, if any.
+type UserDelivery struct {
+	Event          *EventReference         `json:"event,omitempty"`
+	Person         *PersonReference        `json:"person"`
+	DeliveryStatus *string                 `json:"deliveryStatus,omitempty"`
+	At             *Timestamp              `json:"at,omitempty"`
+	Response       *UserDeliveryResponse   `json:"response,omitempty"`
+	Notifications  []*DeliveryNotification `json:"notifications,omitempty"`
+}
+
+// UserDeliveryPagination contains a paginated list of user deliveries.
+// It extends the Pagination struct containing links to additional pages.
+type UserDeliveryPagination struct {
+	*Pagination
+	Deliveries []*UserDelivery `json:"data"`
+}
+
+// userDeliveryJSON is the JSON representation of a UserDelivery, with its embedded collections nested within pagination objects.
+type userDeliveryJSON struct {
+	userDeliveryFields
+	Notifications embeddedList[*DeliveryNotification] `json:"notifications"`
+}
+
+// userDeliveryFields has the fields of a UserDelivery without its methods, so decoding it does not call UnmarshalJSON.
+type userDeliveryFields UserDelivery
+
+// UserDeliveryResponse represents the response a user selected for an event notification.
+type UserDeliveryResponse struct {
+	Text         *string          `json:"text,omitempty"`
+	Contribution *string          `json:"contribution,omitempty"`
+	Comment      *string          `json:"comment,omitempty"`
+	Received     *Timestamp       `json:"received,omitempty"`
+	Device       *DeviceReference `json:"device,omitempty"`
+}
+
+// DeliveryNotification represents a single notification sent to one of a user's devices.
+type DeliveryNotification struct {
+	ID             *string          `json:"id,omitempty"`
+	Device         *DeviceReference `json:"device,omitempty"`
+	DeliveryStatus *string          `json:"deliveryStatus,omitempty"`
+	Created        *Timestamp       `json:"created,omitempty"`
+	Delivered      *Timestamp       `json:"delivered,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// GetUserDeliveriesParams contains available API query parameters for the GetEventUserDeliveries method.
+type GetUserDeliveriesParams struct {
+	Embed          string     `url:"embed,omitempty"`
+	DeliveryStatus string     `url:"deliveryStatus,omitempty"`
+	Responded      *bool      `url:"responded,omitempty"`
+	At             *Timestamp `url:"at,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// User Delivery Methods
+// -------------------------------------------------------------------------------------------------
+
+// Custom Unmarshaller for UserDelivery to handle embedded notifications
+// This is necessary because the JSON structure for notifications is nested within a pagination object.
+func (d *UserDelivery) UnmarshalJSON(data []byte) error {
+	var decoded userDeliveryJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("failed to unmarshal UserDelivery: %w", err)
+	}
+	*d = *decoded.userDelivery()
+	return nil
+}
+
+// Custom Marshaller for UserDelivery to nest embedded notifications within pagination objects
+// This mirrors UnmarshalJSON, so a marshalled UserDelivery can be unmarshalled again without losing data.
+func (d UserDelivery) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Notifications *embeddedList[*DeliveryNotification] `json:"notifications,omitempty"`
+		userDeliveryFields
+	}{
+		Notifications:      newEmbeddedList(d.Notifications),
+		userDeliveryFields: userDeliveryFields(d),
+	})
+}
+
+// userDelivery returns the UserDelivery held by its JSON representation, taking its embedded collections out of their pagination objects.
+func (d *userDeliveryJSON) userDelivery() *UserDelivery {
+	result := (*UserDelivery)(&d.userDeliveryFields)
+	result.Notifications = d.Notifications.Data
+	return result
+}
+
+// GetEventUserDeliveries retrieves the user deliveries of an event in xMatters.
+// It requires the eventId parameter to identify the specific event and accepts optional query parameters
+// to filter the results by delivery or response status. It returns a slice of UserDelivery objects.
+func (xmatters *XMattersAPI) GetEventUserDeliveries(eventId string, params GetUserDeliveriesParams) ([]*UserDelivery, error) {
+	if params.Embed == "" {
+		params.Embed = "response,notifications"
+	}
+	uri := buildURI(fmt.Sprintf("/events/%s/user-deliveries", pathSegment(eventId)), params)
+
+	// Use the GetUserDeliveryPaginationSet method to get all paginated results
+	deliveryList, err := xmatters.GetUserDeliveryPaginationSet(uri)
+	if err != nil {
+		return deliveryList, err
+	}
+
+	// Return the full list of User Deliveries.
+	return deliveryList, nil
+}
+
+// GetUserDeliveryPaginationSet retrieves a paginated list of user deliveries.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetUserDeliveryPaginationSet(uri string) ([]*UserDelivery, error) {
+	return getConvertedPaginationSet(xmatters, uri, (*userDeliveryJSON).userDelivery)
+}
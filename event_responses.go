@@ -0,0 +1,91 @@
+package xmatters
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Event Response Structs
+// -------------------------------------------------------------------------------------------------
+
+// EventResponse represents a response recorded against an event in xMatters.
+type EventResponse struct {
+	ID       *string          `json:"id,omitempty"`
+	Event    *EventReference  `json:"event,omitempty"`
+	Person   *PersonReference `json:"recipient,omitempty"`
+	Response *ResponseOption  `json:"response,omitempty"`
+	Comment  *string          `json:"comment,omitempty"`
+	Device   *DeviceReference `json:"device,omitempty"`
+	Received *Timestamp       `json:"received,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// RespondToEventParams contains the fields used to record a response on behalf of a user with the RespondToEvent method.
+// Response may be either the ID of one of the event's response options or the option's text, such as "Acknowledge".
+type RespondToEventParams struct {
+	EventID   string  `json:"-"`                 // Required: ID of the event being responded to
+	Recipient string  `json:"recipient"`         // Required: ID or targetName of the person responding
+	Response  string  `json:"response"`          // Required: ID or text of the selected response option
+	Device    string  `json:"device,omitempty"`  // Optional: ID of the device the response is attributed to
+	Comment   *string `json:"comment,omitempty"` // Optional: Comment included with the response
+}
+
+// -------------------------------------------------------------------------------------------------
+// Event Response Methods
+// -------------------------------------------------------------------------------------------------
+
+// RespondToEvent records a response to an event's notification on behalf of a user in xMatters.
+// This allows external acknowledgement systems, such as a ChatOps "ack" button, to close the loop in xMatters.
+// If params.Response is not a UUID, it is matched case-insensitively against the text of the event's response options.
+// It returns the recorded EventResponse object.
+func (xmatters *XMattersAPI) RespondToEvent(params RespondToEventParams) (EventResponse, error) {
+	if params.EventID == "" || params.Recipient == "" || params.Response == "" {
+		return EventResponse{}, newValidationError("an event ID, recipient, and response are required to respond to an event")
+	}
+
+	// Resolve the response option ID from its text
+	if !IsUUID(params.Response) {
+		optionId, err := xmatters.findResponseOptionId(params.EventID, params.Response)
+		if err != nil {
+			return EventResponse{}, err
+		}
+		params.Response = optionId
+	}
+
+	uri := buildURI(fmt.Sprintf("/events/%s/responses", pathSegment(params.EventID)), nil)
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
+	if err != nil {
+		return EventResponse{}, err
+	}
+
+	// Unmarshal the response into an EventResponse struct.
+	var result EventResponse
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return EventResponse{}, newUnmarshalError()
+	}
+
+	// Return the recorded EventResponse object.
+	return result, nil
+}
+
+// findResponseOptionId returns the ID of the event response option whose text matches the provided value.
+func (xmatters *XMattersAPI) findResponseOptionId(eventId, text string) (string, error) {
+	event, err := xmatters.GetEvent(eventId)
+	if err != nil {
+		return "", err
+	}
+	for _, option := range event.ResponseOptions {
+		if option.ID != nil && strings.EqualFold(stringValue(option.Text), text) {
+			return *option.ID, nil
+		}
+	}
+	return "", newValidationError(fmt.Sprintf("event %s has no response option %q", eventId, text))
+}
@@ -0,0 +1,126 @@
+package xmatters
+
+import (
+	"sort"
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Event Suppression Structs
+// -------------------------------------------------------------------------------------------------
+
+// EventSuppression represents an event that was suppressed by flood control in xMatters.
+// Event is the suppressed event and Match is the earlier event it was identified as a duplicate of.
+// Integration is the integration that triggered the suppressed event.
+type EventSuppression struct {
+	Event       *EventReference       `json:"event"`
+	Match       *EventReference       `json:"match"`
+	Integration *IntegrationReference `json:"integration,omitempty"`
+	At          *Timestamp            `json:"at,omitempty"`
+}
+
+// EventSuppressionPagination contains a paginated list of event suppressions.
+// It extends the Pagination struct containing links to additional pages.
+type EventSuppressionPagination struct {
+	*Pagination
+	Suppressions []*EventSuppression `json:"data"`
+}
+
+// EventSuppressionCount is the number of events of an integration that flood control suppressed on a single day.
+// Integration is the name of the integration, or its ID if the name is unknown, and Day is the UTC date
+// formatted as "2006-01-02".
+type EventSuppressionCount struct {
+	Integration string `json:"integration"`
+	Day         string `json:"day"`
+	Count       int    `json:"count"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// GetEventSuppressionsParams contains available API query parameters for the GetEventSuppressions method.
+// Integration limits the results to the events triggered by an integration, identified by its ID.
+type GetEventSuppressionsParams struct {
+	From        *Timestamp `url:"from,omitempty"`
+	To          *Timestamp `url:"to,omitempty"`
+	Integration string     `url:"integration,omitempty"`
+	SortOrder   string     `url:"sortOrder,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Event Suppression Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetEventSuppressions retrieves a list of event suppressions in xMatters.
+// It accepts optional query parameters to filter the results and returns a slice of EventSuppression objects.
+func (xmatters *XMattersAPI) GetEventSuppressions(params GetEventSuppressionsParams) ([]*EventSuppression, error) {
+	uri := buildURI("/event-suppressions", params) // The URI including any Query Parameters
+
+	// Use the GetEventSuppressionPaginationSet method to get all paginated results
+	suppressionList, err := xmatters.GetEventSuppressionPaginationSet(uri)
+	if err != nil {
+		return suppressionList, err
+	}
+
+	// Return the full list of Event Suppressions.
+	return suppressionList, nil
+}
+
+// GetEventSuppressionCounts retrieves the event suppressions matching params and counts them per integration
+// and per UTC day, so the duplicate alerts suppressed by flood control can be reported.
+// The counts are sorted by day and then by integration.
+// Example usage:
+//
+//	from, to := xmatters.LastRange(7 * 24 * time.Hour)
+//	counts, err := client.GetEventSuppressionCounts(xmatters.GetEventSuppressionsParams{From: from, To: to})
+func (xmatters *XMattersAPI) GetEventSuppressionCounts(params GetEventSuppressionsParams) ([]*EventSuppressionCount, error) {
+	suppressions, err := xmatters.GetEventSuppressions(params)
+	if err != nil {
+		return []*EventSuppressionCount{}, err
+	}
+	return CountEventSuppressions(suppressions), nil
+}
+
+// CountEventSuppressions counts event suppressions per integration and per UTC day, sorted by day and then by
+// integration. Suppressions without a time are counted under an empty day.
+func CountEventSuppressions(suppressions []*EventSuppression) []*EventSuppressionCount {
+	counts := make(map[[2]string]*EventSuppressionCount)
+	for _, suppression := range suppressions {
+		integration := ""
+		if suppression.Integration != nil {
+			integration = stringValue(suppression.Integration.Name)
+			if integration == "" {
+				integration = stringValue(suppression.Integration.ID)
+			}
+		}
+		day := ""
+		if at := timeValue(suppression.At); !at.IsZero() {
+			day = at.UTC().Format(time.DateOnly)
+		}
+		key := [2]string{day, integration}
+		if counts[key] == nil {
+			counts[key] = &EventSuppressionCount{Integration: integration, Day: day}
+		}
+		counts[key].Count++
+	}
+
+	result := make([]*EventSuppressionCount, 0, len(counts))
+	for _, count := range counts {
+		result = append(result, count)
+	}
+	sort.Slice(result, func(a, b int) bool {
+		if result[a].Day != result[b].Day {
+			return result[a].Day < result[b].Day
+		}
+		return result[a].Integration < result[b].Integration
+	})
+	return result
+}
+
+// GetEventSuppressionPaginationSet retrieves a paginated list of event suppressions.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetEventSuppressionPaginationSet(uri string) ([]*EventSuppression, error) {
+	return getPaginationSet[EventSuppression](xmatters, uri)
+}
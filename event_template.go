@@ -0,0 +1,250 @@
+package xmatters
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Event Template Structs
+// -------------------------------------------------------------------------------------------------
+
+// EventTemplate describes an event whose properties and recipients are rendered from Go templates
+// (text/template) and a data value, so the content of alerts can be kept in configuration files.
+// Templates fail on missing map keys, and may use the join, default, lower, upper, and trim functions:
+//
+//	formId: 0a1b2c3d-...
+//	priority: HIGH
+//	properties:
+//	  Summary: "{{.service}} is {{.status | upper}}"
+//	  Runbook: '{{default "https://wiki.example.com/oncall" .runbook}}'
+//	recipients:
+//	  - "{{.team}}"
+//	  - '{{join .escalation ","}}'
+type EventTemplate struct {
+	FormID   string        `json:"formId" yaml:"formId"`
+	Priority EventPriority `json:"priority,omitempty" yaml:"priority,omitempty"`
+	// Properties maps the names of form properties to the templates of their values.
+	// Properties whose template renders an empty value are omitted from the event.
+	Properties map[string]string `json:"properties,omitempty" yaml:"properties,omitempty"`
+	// Recipients are the templates of recipient target names. A template may render several recipients
+	// separated by commas or newlines, and renders that are empty add no recipient.
+	Recipients []string `json:"recipients,omitempty" yaml:"recipients,omitempty"`
+}
+
+// eventTemplateFuncs are the functions available to the templates of an EventTemplate.
+var eventTemplateFuncs = template.FuncMap{
+	"join": func(values interface{}, sep string) (string, error) {
+		list := reflect.ValueOf(values)
+		if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+			return "", fmt.Errorf("join expects a list, got %T", values)
+		}
+		parts := make([]string, list.Len())
+		for i := range parts {
+			parts[i] = fmt.Sprint(list.Index(i).Interface())
+		}
+		return strings.Join(parts, sep), nil
+	},
+	"default": func(fallback string, value interface{}) string {
+		if value == nil || fmt.Sprint(value) == "" {
+			return fallback
+		}
+		return fmt.Sprint(value)
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"trim":  strings.TrimSpace,
+}
+
+// -------------------------------------------------------------------------------------------------
+// Event Template Methods
+// -------------------------------------------------------------------------------------------------
+
+// Render renders the properties and recipients of the template with the data, and returns the parameters
+// to trigger the event. Property values are left as strings; use RenderEvent to convert them to the types
+// defined by the form.
+func (t *EventTemplate) Render(data interface{}) (TriggerEventParams, error) {
+	params := TriggerEventParams{FormID: t.FormID, Priority: t.Priority}
+
+	// Render the properties in name order, so errors are reported consistently
+	for _, name := range t.propertyNames() {
+		value, err := renderEventTemplate("properties."+name, t.Properties[name], data)
+		if err != nil {
+			return TriggerEventParams{}, err
+		}
+		if value == "" {
+			continue
+		}
+		if params.Properties == nil {
+			params.Properties = make(map[string]interface{})
+		}
+		params.Properties[name] = value
+	}
+
+	// Render the recipients, splitting each render into target names
+	for i, text := range t.Recipients {
+		value, err := renderEventTemplate(fmt.Sprintf("recipients[%d]", i), text, data)
+		if err != nil {
+			return TriggerEventParams{}, err
+		}
+		for _, targetName := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+			if targetName = strings.TrimSpace(targetName); targetName != "" {
+				params.Recipients = append(params.Recipients, &EventRecipient{TargetName: targetName})
+			}
+		}
+	}
+	return params, nil
+}
+
+// RenderEvent renders the template with the data, as in EventTemplate.Render, and validates the rendered
+// properties against the property definitions of the template's form. Values of BOOLEAN and NUMBER properties
+// are converted to booleans and numbers, and the lengths of TEXT and PASSWORD values are checked.
+// It returns every problem found, joined into a single error.
+func (xmatters *XMattersAPI) RenderEvent(t *EventTemplate, data interface{}) (TriggerEventParams, error) {
+	params, err := t.Render(data)
+	if err != nil {
+		return TriggerEventParams{}, err
+	}
+	definitions, err := xmatters.eventTemplateProperties(t)
+	if err != nil {
+		return TriggerEventParams{}, err
+	}
+
+	var errs []error
+	for _, name := range t.propertyNames() {
+		if _, rendered := params.Properties[name]; !rendered {
+			continue
+		}
+		definition, ok := definitions[name]
+		if !ok {
+			errs = append(errs, newValidationError(fmt.Sprintf("form %s has no property %q", t.FormID, name)))
+			continue
+		}
+		value, err := convertEventProperty(definition, params.Properties[name].(string))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		params.Properties[name] = value
+	}
+	if len(errs) > 0 {
+		return TriggerEventParams{}, errors.Join(errs...)
+	}
+	return params, nil
+}
+
+// ValidateEventTemplate checks that the templates of t parse and that its properties are defined by its form,
+// so a configuration file can be checked when it is loaded rather than when the first alert is sent.
+// It returns every problem found, joined into a single error.
+func (xmatters *XMattersAPI) ValidateEventTemplate(t *EventTemplate) error {
+	if t.FormID == "" {
+		return newValidationError("a form ID is required to trigger an event")
+	}
+
+	var errs []error
+	for _, name := range t.propertyNames() {
+		if _, err := parseEventTemplate("properties."+name, t.Properties[name]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for i, text := range t.Recipients {
+		if _, err := parseEventTemplate(fmt.Sprintf("recipients[%d]", i), text); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	definitions, err := xmatters.eventTemplateProperties(t)
+	if err != nil {
+		return err
+	}
+	for _, name := range t.propertyNames() {
+		if _, ok := definitions[name]; !ok {
+			errs = append(errs, newValidationError(fmt.Sprintf("form %s has no property %q", t.FormID, name)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// eventTemplateProperties retrieves the property definitions of the template's form, keyed by name.
+func (xmatters *XMattersAPI) eventTemplateProperties(t *EventTemplate) (map[string]*FormProperty, error) {
+	propertyList, err := xmatters.GetFormProperties(t.FormID)
+	if err != nil {
+		return nil, err
+	}
+	definitions := make(map[string]*FormProperty, len(propertyList))
+	for _, property := range propertyList {
+		definitions[stringValue(property.Name)] = property
+	}
+	return definitions, nil
+}
+
+// propertyNames returns the names of the templated properties in sorted order.
+func (t *EventTemplate) propertyNames() []string {
+	names := make([]string, 0, len(t.Properties))
+	for name := range t.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseEventTemplate parses the text of a template, naming it after the field it renders.
+func parseEventTemplate(name, text string) (*template.Template, error) {
+	parsed, err := template.New(name).Funcs(eventTemplateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, newValidationError(fmt.Sprintf("invalid template: %v", err))
+	}
+	return parsed, nil
+}
+
+// renderEventTemplate parses and executes the text of a template with the data.
+func renderEventTemplate(name, text string, data interface{}) (string, error) {
+	parsed, err := parseEventTemplate(name, text)
+	if err != nil {
+		return "", err
+	}
+	var rendered bytes.Buffer
+	if err := parsed.Execute(&rendered, data); err != nil {
+		return "", newValidationError(fmt.Sprintf("rendering template: %v", err))
+	}
+	return rendered.String(), nil
+}
+
+// convertEventProperty converts a rendered value to the type of the form property, checking its length.
+func convertEventProperty(definition *FormProperty, value string) (interface{}, error) {
+	name := stringValue(definition.Name)
+	switch stringValue(definition.PropertyType) {
+	case "BOOLEAN":
+		converted, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return nil, newValidationError(fmt.Sprintf("property %q must be a boolean, got %q", name, value))
+		}
+		return converted, nil
+	case "NUMBER":
+		trimmed := strings.TrimSpace(value)
+		if converted, err := strconv.ParseInt(trimmed, 10, 64); err == nil {
+			return converted, nil
+		}
+		converted, err := strconv.ParseFloat(trimmed, 64)
+		if err != nil {
+			return nil, newValidationError(fmt.Sprintf("property %q must be a number, got %q", name, value))
+		}
+		return converted, nil
+	case "TEXT", "PASSWORD":
+		length := int64(utf8.RuneCountInString(value))
+		if definition.MinLength != nil && length < *definition.MinLength {
+			return nil, newValidationError(fmt.Sprintf("property %q must be at least %d characters long", name, *definition.MinLength))
+		}
+		if definition.MaxLength != nil && *definition.MaxLength > 0 && length > *definition.MaxLength {
+			return nil, newValidationError(fmt.Sprintf("property %q must be at most %d characters long", name, *definition.MaxLength))
+		}
+	}
+	return value, nil
+}
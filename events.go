@@ -0,0 +1,569 @@
+package xmatters
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Event Structs
+// -------------------------------------------------------------------------------------------------
+
+// Event represents an event in xMatters.
+type Event struct {
+	ID                         *string                `json:"id"`
+	EventID                    *string                `json:"eventId,omitempty"`
+	Name                       *string                `json:"name,omitempty"`
+	Status                     *string                `json:"status,omitempty"`
+	Priority                   *string                `json:"priority,omitempty"`
+	Incident                   *string                `json:"incident,omitempty"`
+	RequestID                  *string                `json:"requestId,omitempty"`
+	Created                    *Timestamp             `json:"created,omitempty"`
+	Terminated                 *Timestamp             `json:"terminated,omitempty"`
+	Submitter                  *PersonReference       `json:"submitter,omitempty"`
+	Plan                       *PlanReference         `json:"plan,omitempty"`
+	Form                       *FormReference         `json:"form,omitempty"`
+	BypassPhoneIntro           *bool                  `json:"bypassPhoneIntro,omitempty"`
+	ExpirationInMinutes        *int64                 `json:"expirationInMinutes,omitempty"`
+	OverrideDeviceRestrictions *bool                  `json:"overrideDeviceRestrictions,omitempty"`
+	RequirePhonePassword       *bool                  `json:"requirePhonePassword,omitempty"`
+	Conference                 *Conference            `json:"conference,omitempty"`
+	Properties                 map[string]interface{} `json:"properties,omitempty"`
+	Annotations                []*EventAnnotation     `json:"annotations,omitempty"`
+	ResponseOptions            []*ResponseOption      `json:"responseOptions,omitempty"`
+	Recipients                 []*RecipientReference  `json:"recipients,omitempty"`
+	TargetedRecipients         []*RecipientReference  `json:"targetedRecipients,omitempty"`
+}
+
+// EventPriority represents the priority of an event in xMatters.
+type EventPriority string
+
+const (
+	// EventPriorityHigh is the highest event priority.
+	EventPriorityHigh EventPriority = "HIGH"
+	// EventPriorityMedium is the default event priority.
+	EventPriorityMedium EventPriority = "MEDIUM"
+	// EventPriorityLow is the lowest event priority.
+	EventPriorityLow EventPriority = "LOW"
+)
+
+// EventStatus represents the status of an event in xMatters.
+type EventStatus string
+
+const (
+	// EventStatusActive indicates the event is notifying recipients.
+	EventStatusActive EventStatus = "ACTIVE"
+	// EventStatusSuspended indicates notifications for the event are paused.
+	EventStatusSuspended EventStatus = "SUSPENDED"
+	// EventStatusTerminated indicates the event has ended.
+	EventStatusTerminated EventStatus = "TERMINATED"
+	// EventStatusTerminatedExternal indicates the event was ended by an external system, such as a failed integration.
+	EventStatusTerminatedExternal EventStatus = "TERMINATED_EXTERNAL"
+	// EventStatusFailed indicates xMatters could not process the event, such as when its recipients could not be resolved.
+	EventStatusFailed EventStatus = "FAILED"
+)
+
+// EventFailedError is returned by WaitForEvent when the triggered event reaches a terminal failure status.
+// Event is the event as it was last retrieved.
+type EventFailedError struct {
+	RequestID string
+	Status    EventStatus
+	Event     Event
+}
+
+// EventPagination contains a paginated list of events.
+// It extends the Pagination struct containing links to additional pages.
+type EventPagination struct {
+	*Pagination
+	Events []*Event `json:"data"`
+}
+
+// eventJSON is the JSON representation of an Event, with its embedded collections nested within pagination objects.
+type eventJSON struct {
+	eventFields
+	Annotations        embeddedList[*EventAnnotation]    `json:"annotations"`
+	ResponseOptions    embeddedList[*ResponseOption]     `json:"responseOptions"`
+	Recipients         embeddedList[*RecipientReference] `json:"recipients"`
+	TargetedRecipients embeddedList[*RecipientReference] `json:"targetedRecipients"`
+}
+
+// eventFields has the fields of an Event without its methods, so decoding it does not call UnmarshalJSON.
+type eventFields Event
+
+// EventAnnotation represents a comment added to an event in xMatters.
+type EventAnnotation struct {
+	ID      *string          `json:"id"`
+	Author  *PersonReference `json:"author,omitempty"`
+	Comment *string          `json:"comment,omitempty"`
+	Created *Timestamp       `json:"created,omitempty"`
+}
+
+// ResponseOption represents a response that recipients can choose when replying to a notification.
+type ResponseOption struct {
+	ID             *string                     `json:"id,omitempty"`
+	Number         *int64                      `json:"number,omitempty"`
+	Text           *string                     `json:"text,omitempty"`
+	Description    *string                     `json:"description,omitempty"`
+	Prompt         *string                     `json:"prompt,omitempty"`
+	Action         *ResponseOptionAction       `json:"action,omitempty"`
+	Contribution   *ResponseOptionContribution `json:"contribution,omitempty"`
+	JoinConference *bool                       `json:"joinConference,omitempty"`
+	AllowComments  *bool                       `json:"allowComments,omitempty"`
+	RedirectURL    *string                     `json:"redirectUrl,omitempty"`
+}
+
+// ResponseOptionAction represents the action xMatters takes when a recipient selects a response option.
+type ResponseOptionAction string
+
+const (
+	// ResponseActionRespond records the response without changing notification behavior.
+	ResponseActionRespond ResponseOptionAction = "RESPOND"
+	// ResponseActionEscalate escalates the event to the next recipient in the escalation path.
+	ResponseActionEscalate ResponseOptionAction = "ESCALATE"
+	// ResponseActionAssignToUser assigns the event to the responding user.
+	ResponseActionAssignToUser ResponseOptionAction = "ASSIGN_TO_USER"
+	// ResponseActionStopNotifyingUser stops notifying the responding user.
+	ResponseActionStopNotifyingUser ResponseOptionAction = "STOP_NOTIFYING_USER"
+	// ResponseActionStopNotifyingTarget stops notifying the group or recipient the user was notified through.
+	ResponseActionStopNotifyingTarget ResponseOptionAction = "STOP_NOTIFYING_TARGET"
+	// ResponseActionEnd terminates the event.
+	ResponseActionEnd ResponseOptionAction = "END"
+)
+
+// IsValid reports whether the ResponseOptionAction is one of the actions supported by xMatters.
+func (a ResponseOptionAction) IsValid() bool {
+	switch a {
+	case ResponseActionRespond, ResponseActionEscalate, ResponseActionAssignToUser,
+		ResponseActionStopNotifyingUser, ResponseActionStopNotifyingTarget, ResponseActionEnd:
+		return true
+	}
+	return false
+}
+
+// ResponseOptionContribution represents how a response counts towards the outcome of an event.
+type ResponseOptionContribution string
+
+const (
+	// ResponseContributionPositive counts the response as an acknowledgement.
+	ResponseContributionPositive ResponseOptionContribution = "POSITIVE"
+	// ResponseContributionNegative counts the response as a decline.
+	ResponseContributionNegative ResponseOptionContribution = "NEGATIVE"
+	// ResponseContributionNeutral does not count the response either way.
+	ResponseContributionNeutral ResponseOptionContribution = "NEUTRAL"
+)
+
+// IsValid reports whether the ResponseOptionContribution is one of the contributions supported by xMatters.
+func (c ResponseOptionContribution) IsValid() bool {
+	switch c {
+	case ResponseContributionPositive, ResponseContributionNegative, ResponseContributionNeutral:
+		return true
+	}
+	return false
+}
+
+// Conference represents the conference bridge recipients are invited to join for an event.
+type Conference struct {
+	Type         ConferenceType `json:"type"`
+	BridgeID     *string        `json:"bridgeId,omitempty"`
+	BridgeNumber *string        `json:"bridgeNumber,omitempty"`
+}
+
+// ConferenceType represents the kind of conference bridge used by an event.
+type ConferenceType string
+
+const (
+	// ConferenceTypeHosted is a conference bridge hosted by xMatters.
+	ConferenceTypeHosted ConferenceType = "BRIDGE"
+	// ConferenceTypeExternal is a third-party conference bridge configured in xMatters.
+	ConferenceTypeExternal ConferenceType = "EXTERNAL"
+)
+
+// EventReference represents a shorthand version of an event in xMatters.
+type EventReference struct {
+	ID      *string `json:"id"`
+	EventID *string `json:"eventId,omitempty"`
+	Name    *string `json:"name,omitempty"`
+}
+
+// EventTrigger represents the response returned by xMatters after an event is triggered.
+// The event is created asynchronously, so only the request ID is known at trigger time.
+type EventTrigger struct {
+	RequestID *string `json:"requestId"`
+}
+
+// EventRecipient identifies a recipient targeted by a triggered event.
+// Either ID or TargetName must be provided; RecipientType is optional when the target name is unambiguous.
+type EventRecipient struct {
+	ID            string `json:"id,omitempty"`
+	TargetName    string `json:"targetName,omitempty"`
+	RecipientType string `json:"recipientType,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// GetEventsParams contains available API query parameters for the GetEventList method.
+type GetEventsParams struct {
+	Embed              string     `url:"embed,omitempty"`
+	Search             string     `url:"search,omitempty"`
+	Status             string     `url:"status,omitempty"`
+	Priority           string     `url:"priority,omitempty"`
+	From               *Timestamp `url:"from,omitempty"`
+	To                 *Timestamp `url:"to,omitempty"`
+	RequestID          string     `url:"requestId,omitempty"`
+	Submitter          string     `url:"submitter,omitempty"`
+	Plan               string     `url:"plan,omitempty"`
+	Form               string     `url:"form,omitempty"`
+	TargetedRecipients string     `url:"targetedRecipients,omitempty"`
+	PropertyName       string     `url:"propertyName,omitempty"`
+	PropertyValue      string     `url:"propertyValue,omitempty"`
+	SortBy             string     `url:"sortBy,omitempty"`
+	SortOrder          string     `url:"sortOrder,omitempty"`
+}
+
+// TriggerEventParams contains available API body parameters for the TriggerEvent method.
+type TriggerEventParams struct {
+	// Required Fields
+	FormID     string            `json:"-"`
+	Recipients []*EventRecipient `json:"recipients"`
+	// Optional Fields
+	Priority                   EventPriority          `json:"priority,omitempty"`
+	Properties                 map[string]interface{} `json:"properties,omitempty"`
+	Conference                 *Conference            `json:"conference,omitempty"`
+	ResponseOptions            []*ResponseOption      `json:"responseOptions,omitempty"`
+	Attachments                []*ReferenceById       `json:"attachments,omitempty"`
+	Schedule                   *EventSchedule         `json:"schedule,omitempty"`
+	ExpirationInMinutes        *int64                 `json:"expirationInMinutes,omitempty"`
+	BypassPhoneIntro           *bool                  `json:"bypassPhoneIntro,omitempty"`
+	OverrideDeviceRestrictions *bool                  `json:"overrideDeviceRestrictions,omitempty"`
+	RequirePhonePassword       *bool                  `json:"requirePhonePassword,omitempty"`
+}
+
+// ChangeEventStatusParams contains available API body parameters for the ChangeEventStatus method.
+type ChangeEventStatusParams struct {
+	ID     string      `json:"id"`
+	Status EventStatus `json:"status"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Event Methods
+// -------------------------------------------------------------------------------------------------
+
+// Custom Unmarshaller for Event to handle embedded annotations, response options, and recipients
+// This is necessary because the JSON structure for these fields are nested within pagination objects.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var decoded eventJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("failed to unmarshal Event: %w", err)
+	}
+	*e = *decoded.event()
+	return nil
+}
+
+// Custom Marshaller for Event to nest embedded annotations, response options, and recipients within pagination objects
+// This mirrors UnmarshalJSON, so a marshalled Event can be unmarshalled again without losing data.
+func (e Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Annotations        *embeddedList[*EventAnnotation]    `json:"annotations,omitempty"`
+		ResponseOptions    *embeddedList[*ResponseOption]     `json:"responseOptions,omitempty"`
+		Recipients         *embeddedList[*RecipientReference] `json:"recipients,omitempty"`
+		TargetedRecipients *embeddedList[*RecipientReference] `json:"targetedRecipients,omitempty"`
+		eventFields
+	}{
+		Annotations:        newEmbeddedList(e.Annotations),
+		ResponseOptions:    newEmbeddedList(e.ResponseOptions),
+		Recipients:         newEmbeddedList(e.Recipients),
+		TargetedRecipients: newEmbeddedList(e.TargetedRecipients),
+		eventFields:        eventFields(e),
+	})
+}
+
+// event returns the Event held by its JSON representation, taking its embedded collections out of their pagination objects.
+func (e *eventJSON) event() *Event {
+	result := (*Event)(&e.eventFields)
+	result.Annotations = e.Annotations.Data
+	result.ResponseOptions = e.ResponseOptions.Data
+	result.Recipients = e.Recipients.Data
+	result.TargetedRecipients = e.TargetedRecipients.Data
+	return result
+}
+
+// GetEvent retrieves an event in xMatters.
+// It requires the eventId parameter to identify the specific event, and returns an Event object.
+// A URL parameter is added to the request URI to embed the annotations, response options, and recipients.
+func (xmatters *XMattersAPI) GetEvent(eventId string) (Event, error) {
+	if err := validateIdentifier("event ID", eventId); err != nil {
+		return Event{}, err
+	}
+
+	uri := buildURI(fmt.Sprintf("/events/%s", pathSegment(eventId)), struct {
+		Embed string `url:"embed"`
+	}{Embed: "annotations,responseOptions,recipients,targetedRecipients"})
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
+	if err != nil {
+		return Event{}, err
+	}
+
+	// Unmarshal the response into an Event struct.
+	var result Event
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Event{}, newUnmarshalError()
+	}
+
+	// Return the returned Event object.
+	return result, nil
+}
+
+// CountEvents returns the number of events in xMatters matching the query parameters,
+// without retrieving the events themselves.
+func (xmatters *XMattersAPI) CountEvents(params GetEventsParams) (int64, error) {
+	return xmatters.countResults("/events", params)
+}
+
+// GetEventList retrieves a list of events in xMatters.
+// It accepts optional query parameters to filter the results and returns a slice of Event objects.
+func (xmatters *XMattersAPI) GetEventList(params GetEventsParams) ([]*Event, error) {
+	uri := buildURI("/events", params) // The URI including any Query Parameters
+
+	// Use the GetEventPaginationSet method to get all paginated results
+	eventList, err := xmatters.GetEventPaginationSet(uri)
+	if err != nil {
+		return eventList, err
+	}
+
+	// Return the full list of Events.
+	return eventList, nil
+}
+
+// GetEventPaginationSet retrieves a paginated list of events.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetEventPaginationSet(uri string) ([]*Event, error) {
+	return getConvertedPaginationSet(xmatters, uri, (*eventJSON).event)
+}
+
+// GetEventByRequestId retrieves the event created by a trigger request in xMatters.
+// It requires the requestId returned by TriggerEvent, and returns ErrEventNotFound if the event does not exist yet.
+func (xmatters *XMattersAPI) GetEventByRequestId(requestId string) (Event, error) {
+	eventList, err := xmatters.GetEventList(GetEventsParams{RequestID: requestId})
+	if err != nil {
+		return Event{}, err
+	}
+	if len(eventList) == 0 {
+		return Event{}, ErrEventNotFound
+	}
+
+	// Return the matching Event object.
+	return *eventList[0], nil
+}
+
+// Error implements the error interface.
+func (e *EventFailedError) Error() string {
+	return fmt.Sprintf("event %s of request %s failed with status %s", stringValue(e.Event.ID), e.RequestID, e.Status)
+}
+
+// WaitForEvent waits for the event created by a trigger request to appear in xMatters.
+// It polls for the event using the requestId returned by TriggerEvent, backing off exponentially between attempts.
+// It returns the Event once it exists, an *EventFailedError if the event reached EventStatusFailed or
+// EventStatusTerminatedExternal, ErrEventWaitTimeout if the timeout expires first, or any other request error.
+func (xmatters *XMattersAPI) WaitForEvent(requestId string, timeout time.Duration) (Event, error) {
+	const (
+		initialDelay = 500 * time.Millisecond
+		maxDelay     = 5 * time.Second
+	)
+	deadline := time.Now().Add(timeout)
+	delay := initialDelay
+
+	for {
+		// Check whether the event has been created
+		event, err := xmatters.GetEventByRequestId(requestId)
+		if err == nil {
+			switch status := EventStatus(stringValue(event.Status)); status {
+			case EventStatusFailed, EventStatusTerminatedExternal:
+				return event, &EventFailedError{RequestID: requestId, Status: status, Event: event}
+			}
+			return event, nil
+		}
+		if !errors.Is(err, ErrEventNotFound) {
+			return Event{}, err
+		}
+
+		// Wait before polling again, without sleeping past the deadline
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return Event{}, ErrEventWaitTimeout
+		}
+		if delay > remaining {
+			delay = remaining
+		}
+		time.Sleep(delay)
+		delay *= 2
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
+
+// TriggerEvent triggers a new event in xMatters through a messaging form.
+// It requires the TriggerEventParams struct containing the form ID, recipients, and event details.
+// Events are created asynchronously, so the returned EventTrigger only contains the request ID;
+// use GetEventByRequestId to retrieve the event once it has been created.
+// The response options and conference details are validated before the request is sent.
+func (xmatters *XMattersAPI) TriggerEvent(params TriggerEventParams) (EventTrigger, error) {
+	if err := params.validate(); err != nil {
+		return EventTrigger{}, err
+	}
+
+	uri := buildURI(fmt.Sprintf("/forms/%s/triggers", pathSegment(params.FormID)), nil)
+
+	// Perform the API request.
+	// Triggering an event is sent ahead of background requests waiting for the rate limiter.
+	resp, err := xmatters.interactive().Request(http.MethodPost, uri, ContentJSON, params)
+	if err != nil {
+		return EventTrigger{}, err
+	}
+
+	// Unmarshal the response into an EventTrigger struct.
+	var result EventTrigger
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return EventTrigger{}, newUnmarshalError()
+	}
+
+	// Return the EventTrigger containing the request ID.
+	return result, nil
+}
+
+// ChangeEventStatus changes the status of an event in xMatters.
+// It requires the eventId parameter to identify the specific event and the desired EventStatus.
+// It returns the modified Event object.
+func (xmatters *XMattersAPI) ChangeEventStatus(eventId string, status EventStatus) (Event, error) {
+	uri := buildURI("/events", nil) // The URI for changing the status of an Event in xMatters
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, ChangeEventStatusParams{ID: eventId, Status: status})
+	if err != nil {
+		return Event{}, err
+	}
+
+	// Unmarshal the response into an Event struct.
+	var result Event
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Event{}, newUnmarshalError()
+	}
+
+	// Return the modified Event object.
+	return result, nil
+}
+
+// TerminateEvent terminates an event in xMatters, stopping all further notifications.
+// It requires the eventId parameter to identify the specific event, and returns the modified Event object.
+func (xmatters *XMattersAPI) TerminateEvent(eventId string) (Event, error) {
+	return xmatters.ChangeEventStatus(eventId, EventStatusTerminated)
+}
+
+// SuspendEvent suspends an event in xMatters, pausing notifications until it is resumed.
+// It requires the eventId parameter to identify the specific event, and returns the modified Event object.
+func (xmatters *XMattersAPI) SuspendEvent(eventId string) (Event, error) {
+	return xmatters.ChangeEventStatus(eventId, EventStatusSuspended)
+}
+
+// ResumeEvent resumes a suspended event in xMatters.
+// It requires the eventId parameter to identify the specific event, and returns the modified Event object.
+func (xmatters *XMattersAPI) ResumeEvent(eventId string) (Event, error) {
+	return xmatters.ChangeEventStatus(eventId, EventStatusActive)
+}
+
+// TerminateEvents terminates every event in xMatters matching the provided filter.
+// Events that are already terminated are skipped. If no status filter is provided, only active events are terminated.
+// It returns the terminated Event objects, along with an error if any termination fails.
+func (xmatters *XMattersAPI) TerminateEvents(params GetEventsParams) ([]*Event, error) {
+	if params.Status == "" {
+		params.Status = string(EventStatusActive)
+	}
+
+	// Retrieve the events matching the filter
+	eventList, err := xmatters.GetEventList(params)
+	if err != nil {
+		return []*Event{}, err
+	}
+
+	// Terminate each event that has not already ended
+	terminated := []*Event{}
+	for _, event := range eventList {
+		if stringValue(event.Status) == string(EventStatusTerminated) {
+			continue
+		}
+		result, err := xmatters.TerminateEvent(stringValue(event.ID))
+		if err != nil {
+			return terminated, err
+		}
+		terminated = append(terminated, &result)
+	}
+
+	// Return the terminated events
+	return terminated, nil
+}
+
+// validate checks the TriggerEventParams for values that xMatters would reject.
+func (params TriggerEventParams) validate() error {
+	if params.FormID == "" {
+		return newValidationError("a form ID is required to trigger an event")
+	}
+	if params.Conference != nil {
+		if err := params.Conference.validate(); err != nil {
+			return err
+		}
+	}
+	for _, option := range params.ResponseOptions {
+		if err := option.validate(); err != nil {
+			return err
+		}
+		if option.JoinConference != nil && *option.JoinConference && params.Conference == nil {
+			return newValidationError(fmt.Sprintf("response option %q joins a conference, but the event has no conference", stringValue(option.Text)))
+		}
+	}
+	return nil
+}
+
+// validate checks that a response option either references an existing option or fully defines a new one.
+func (option *ResponseOption) validate() error {
+	if option == nil {
+		return newValidationError("response option must not be nil")
+	}
+	if option.ID != nil {
+		return nil
+	}
+	if option.Text == nil || strings.TrimSpace(*option.Text) == "" {
+		return newValidationError("response option text must not be empty")
+	}
+	if option.Action == nil || !option.Action.IsValid() {
+		return newValidationError(fmt.Sprintf("response option %q has an invalid action", *option.Text))
+	}
+	if option.Contribution != nil && !option.Contribution.IsValid() {
+		return newValidationError(fmt.Sprintf("response option %q has an invalid contribution %q", *option.Text, *option.Contribution))
+	}
+	return nil
+}
+
+// validate checks that a conference has a supported type and identifies its bridge.
+func (conference *Conference) validate() error {
+	switch conference.Type {
+	case ConferenceTypeHosted, ConferenceTypeExternal:
+	default:
+		return newValidationError(fmt.Sprintf("invalid conference type %q: must be %s or %s", conference.Type, ConferenceTypeHosted, ConferenceTypeExternal))
+	}
+	if conference.BridgeID == nil || strings.TrimSpace(*conference.BridgeID) == "" {
+		return newValidationError("a conference bridge ID is required")
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package xmatters
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Flow Trigger Structs
+// -------------------------------------------------------------------------------------------------
+
+// FlowTrigger contains the response returned when a Flow Designer HTTP trigger accepts a request.
+// Flows run asynchronously, so the request ID is the only detail available when the trigger is called.
+type FlowTrigger struct {
+	RequestID *string `json:"requestId"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Flow Trigger Methods
+// -------------------------------------------------------------------------------------------------
+
+// TriggerFlow posts a JSON payload to a Flow Designer HTTP trigger URL, authenticating with the client's credentials.
+// The triggerURL is the full URL of the inbound integration, as shown in Flow Designer, and the payload
+// can be any value that can be marshalled to JSON. The trigger URL must be on the instance of the client, and
+// a validation error is returned otherwise, as the request carries the client's credentials.
+// The request shares the rate limit and in-flight limit of the client, and failed requests are retried
+// using the client's HTTP client. It returns the FlowTrigger containing the request ID.
+func (xmatters *XMattersAPI) TriggerFlow(triggerURL string, payload interface{}) (FlowTrigger, error) {
+	// Only send the client's credentials to its own instance
+	parsed, base, err := xmatters.parseInstanceURL(triggerURL)
+	if err != nil {
+		return FlowTrigger{}, err
+	}
+	respBody, err := xmatters.doURL(http.MethodPost, base.ResolveReference(parsed).String(), ContentJSON, payload)
+	if errors.Is(err, ErrNoContent) {
+		return FlowTrigger{}, nil
+	}
+	if err != nil {
+		return FlowTrigger{}, err
+	}
+	defer putBuffer(respBody)
+	return parseFlowTrigger(respBody.Bytes())
+}
+
+// TriggerFlowWithAPIKey posts a JSON payload to a Flow Designer HTTP trigger URL using URL authentication.
+// It does not require an XMattersAPI client, which makes it suitable for alerting tools that only hold
+// the trigger URL and its API key. Failed requests are retried with exponential backoff.
+// It returns the FlowTrigger containing the request ID.
+func TriggerFlowWithAPIKey(triggerURL, apiKey string, payload interface{}) (FlowTrigger, error) {
+	// Add the API key to the trigger URL query
+	u, err := url.Parse(triggerURL)
+	if err != nil {
+		return FlowTrigger{}, fmt.Errorf("invalid trigger URL: %w", err)
+	}
+	query := u.Query()
+	query.Set("apiKey", apiKey)
+	u.RawQuery = query.Encode()
+
+	// Use a retrying HTTP client without request logging
+	retryClient := retryablehttp.NewClient()
+	retryClient.Logger = nil
+
+	return postFlowTrigger(retryClient.StandardClient(), u.String(), payload)
+}
+
+// postFlowTrigger sends the payload to the trigger URL and parses the response.
+// HTTP triggers respond with 202 Accepted, so any 2xx status is treated as success.
+func postFlowTrigger(client *http.Client, triggerURL string, payload interface{}) (FlowTrigger, error) {
+	// Marshal the payload to JSON
+	jsonBody, err := json.Marshal(payload)
+	if err != nil {
+		return FlowTrigger{}, fmt.Errorf("error marshalling body to JSON: %w", err)
+	}
+
+	// Create the HTTP request
+	request, err := http.NewRequest(http.MethodPost, triggerURL, bytes.NewReader(jsonBody))
+	if err != nil {
+		return FlowTrigger{}, fmt.Errorf("HTTP request creation failed: %w", err)
+	}
+	request.Header.Set("Content-Type", ContentJSON)
+
+	// Perform the request.
+	response, err := client.Do(request)
+	if err != nil {
+		return FlowTrigger{}, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer response.Body.Close()
+
+	// Read the response body.
+	respBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return FlowTrigger{}, fmt.Errorf("unable to read request body: %w", err)
+	}
+
+	// Return an error for unauthorized or unsuccessful responses.
+	if response.StatusCode == StatusUnauthorized {
+		return FlowTrigger{}, ErrInavlidCredentials
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return FlowTrigger{}, newXMattersError(respBody)
+	}
+
+	return parseFlowTrigger(respBody)
+}
+
+// parseFlowTrigger unmarshals the response of an HTTP trigger into a FlowTrigger, if a body was returned.
+func parseFlowTrigger(respBody []byte) (FlowTrigger, error) {
+	var result FlowTrigger
+	if len(bytes.TrimSpace(respBody)) > 0 {
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return FlowTrigger{}, newUnmarshalError()
+		}
+	}
+
+	// Return the FlowTrigger containing the request ID.
+	return result, nil
+}
@@ -0,0 +1,467 @@
+package xmatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Form Structs
+// -------------------------------------------------------------------------------------------------
+
+// Form represents a messaging form within a communication plan in xMatters.
+// WebEnabled and APIEnabled indicate whether the form can be sent from the web user interface
+// and triggered through the REST API, respectively.
+type Form struct {
+	ID              *string               `json:"id"`
+	Name            *string               `json:"name"`
+	Description     *string               `json:"description,omitempty"`
+	Plan            *PlanReference        `json:"plan,omitempty"`
+	Enabled         *bool                 `json:"enabled,omitempty"`
+	WebEnabled      *bool                 `json:"webEnabled,omitempty"`
+	APIEnabled      *bool                 `json:"apiEnabled,omitempty"`
+	MobileEnabled   *bool                 `json:"mobileEnabled,omitempty"`
+	Recipients      []*RecipientReference `json:"recipients,omitempty"`
+	ResponseOptions []*ResponseOption     `json:"responseOptions,omitempty"`
+}
+
+// FormPagination contains a paginated list of forms.
+// It extends the Pagination struct containing links to additional pages.
+type FormPagination struct {
+	*Pagination
+	Forms []*Form `json:"data"`
+}
+
+// formJSON is the JSON representation of a Form, with its embedded collections nested within pagination objects.
+type formJSON struct {
+	formFields
+	Recipients      embeddedList[*RecipientReference] `json:"recipients"`
+	ResponseOptions embeddedList[*ResponseOption]     `json:"responseOptions"`
+}
+
+// formFields has the fields of a Form without its methods, so decoding it does not call UnmarshalJSON.
+type formFields Form
+
+// FormSection is a section of the layout of a messaging form in xMatters.
+// Custom sections hold the properties that are set when an event is triggered through the form.
+type FormSection struct {
+	ID         *string         `json:"id"`
+	Type       *string         `json:"type"`
+	Title      *string         `json:"title,omitempty"`
+	Properties []*FormProperty `json:"properties,omitempty"`
+}
+
+// formSectionJSON is the JSON representation of a FormSection, with its properties nested within a pagination object.
+type formSectionJSON struct {
+	formSectionFields
+	Properties embeddedList[*FormProperty] `json:"properties"`
+}
+
+// formSectionFields has the fields of a FormSection without its methods, so decoding it does not call UnmarshalJSON.
+type formSectionFields FormSection
+
+// FormProperty defines a property of a messaging form. PropertyType is one of BOOLEAN, HIERARCHY, LIST,
+// NUMBER, PASSWORD, or TEXT, and MinLength and MaxLength bound the length of TEXT and PASSWORD values.
+type FormProperty struct {
+	ID           *string `json:"id"`
+	Name         *string `json:"name"`
+	Description  *string `json:"description,omitempty"`
+	PropertyType *string `json:"propertyType"`
+	MinLength    *int64  `json:"minLength,omitempty"`
+	MaxLength    *int64  `json:"maxLength,omitempty"`
+}
+
+// SenderPermission grants a person, group, or role permission to send a form in xMatters.
+type SenderPermission struct {
+	ID        *string             `json:"id,omitempty"`
+	Recipient *RecipientReference `json:"recipient"`
+}
+
+// SenderPermissionPagination contains a paginated list of form sender permissions.
+// It extends the Pagination struct containing links to additional pages.
+type SenderPermissionPagination struct {
+	*Pagination
+	Permissions []*SenderPermission `json:"data"`
+}
+
+// ResponseOptionPagination contains a paginated list of response options.
+// It extends the Pagination struct containing links to additional pages.
+type ResponseOptionPagination struct {
+	*Pagination
+	ResponseOptions []*ResponseOption `json:"data"`
+}
+
+// FormReference represents a shorthand version of a form in xMatters.
+type FormReference struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// GetFormsParams contains available API query parameters for the GetFormList method.
+type GetFormsParams struct {
+	Embed   string `url:"embed,omitempty"`
+	Search  string `url:"search,omitempty"`
+	Enabled *bool  `url:"enabled,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Form Methods
+// -------------------------------------------------------------------------------------------------
+
+// Custom Unmarshaller for Form to handle embedded recipients and response options
+// This is necessary because the JSON structure for these fields are nested within pagination objects.
+func (f *Form) UnmarshalJSON(data []byte) error {
+	var decoded formJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("failed to unmarshal Form: %w", err)
+	}
+	*f = *decoded.form()
+	return nil
+}
+
+// Custom Marshaller for Form to nest embedded recipients and response options within pagination objects
+// This mirrors UnmarshalJSON, so a marshalled Form can be unmarshalled again without losing data.
+func (f Form) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Recipients      *embeddedList[*RecipientReference] `json:"recipients,omitempty"`
+		ResponseOptions *embeddedList[*ResponseOption]     `json:"responseOptions,omitempty"`
+		formFields
+	}{
+		Recipients:      newEmbeddedList(f.Recipients),
+		ResponseOptions: newEmbeddedList(f.ResponseOptions),
+		formFields:      formFields(f),
+	})
+}
+
+// form returns the Form held by its JSON representation, taking its embedded collections out of their pagination objects.
+func (f *formJSON) form() *Form {
+	result := (*Form)(&f.formFields)
+	result.Recipients = f.Recipients.Data
+	result.ResponseOptions = f.ResponseOptions.Data
+	return result
+}
+
+// Custom Unmarshaller for FormSection to handle embedded properties
+// This is necessary because the JSON structure for the properties is nested within a pagination object.
+func (s *FormSection) UnmarshalJSON(data []byte) error {
+	var decoded formSectionJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("failed to unmarshal FormSection: %w", err)
+	}
+	*s = *decoded.section()
+	return nil
+}
+
+// Custom Marshaller for FormSection to nest embedded properties within a pagination object
+// This mirrors UnmarshalJSON, so a marshalled FormSection can be unmarshalled again without losing data.
+func (s FormSection) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Properties *embeddedList[*FormProperty] `json:"properties,omitempty"`
+		formSectionFields
+	}{
+		Properties:        newEmbeddedList(s.Properties),
+		formSectionFields: formSectionFields(s),
+	})
+}
+
+// section returns the FormSection held by its JSON representation, taking its properties out of their pagination object.
+func (s *formSectionJSON) section() *FormSection {
+	result := (*FormSection)(&s.formSectionFields)
+	result.Properties = s.Properties.Data
+	return result
+}
+
+// GetForm retrieves a form in xMatters.
+// It requires the formId parameter to identify the specific form, and returns a Form object.
+// A URL parameter is added to the request URI to embed the recipients and response options.
+func (xmatters *XMattersAPI) GetForm(formId string) (Form, error) {
+	uri := buildURI(fmt.Sprintf("/forms/%s", pathSegment(formId)), struct {
+		Embed string `url:"embed"`
+	}{Embed: "recipients,responseOptions"})
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
+	if err != nil {
+		return Form{}, err
+	}
+
+	// Unmarshal the response into a Form struct.
+	var result Form
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Form{}, newUnmarshalError()
+	}
+
+	// Return the returned Form object.
+	return result, nil
+}
+
+// GetForms retrieves the forms of a communication plan in xMatters.
+// It requires the planId parameter to identify the specific plan, and returns a slice of Form objects
+// including their recipients and response options.
+func (xmatters *XMattersAPI) GetForms(planId string) ([]*Form, error) {
+	uri := buildURI(fmt.Sprintf("/plans/%s/forms", pathSegment(planId)), GetFormsParams{Embed: "recipients,responseOptions"})
+
+	// Use the GetFormPaginationSet method to get all paginated results
+	formList, err := xmatters.GetFormPaginationSet(uri)
+	if err != nil {
+		return formList, err
+	}
+
+	// Return the full list of Forms.
+	return formList, nil
+}
+
+// GetFormList retrieves a list of forms across all communication plans in xMatters.
+// It accepts optional query parameters to filter the results and returns a slice of Form objects.
+func (xmatters *XMattersAPI) GetFormList(params GetFormsParams) ([]*Form, error) {
+	uri := buildURI("/forms", params) // The URI including any Query Parameters
+
+	// Use the GetFormPaginationSet method to get all paginated results
+	formList, err := xmatters.GetFormPaginationSet(uri)
+	if err != nil {
+		return formList, err
+	}
+
+	// Return the full list of Forms.
+	return formList, nil
+}
+
+// GetFormPaginationSet retrieves a paginated list of forms.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetFormPaginationSet(uri string) ([]*Form, error) {
+	return getConvertedPaginationSet(xmatters, uri, (*formJSON).form)
+}
+
+// GetFormSections retrieves the sections of the layout of a form in xMatters.
+// It requires the formId parameter to identify the specific form, and returns a slice of FormSection objects
+// including the properties of custom sections.
+// The email, SMS, and voice messages of a form are rendered from these properties by message templates that are
+// edited in the xMatters web user interface and are not exposed by the REST API. Notification content is managed
+// programmatically through the property values of the events triggered on the form, such as with an EventTemplate.
+func (xmatters *XMattersAPI) GetFormSections(formId string) ([]*FormSection, error) {
+	uri := buildURI(fmt.Sprintf("/forms/%s/sections", pathSegment(formId)), struct {
+		Embed string `url:"embed"`
+	}{Embed: "properties"})
+
+	// Use the GetFormSectionPaginationSet method to get all paginated results
+	sectionList, err := xmatters.GetFormSectionPaginationSet(uri)
+	if err != nil {
+		return sectionList, err
+	}
+
+	// Return the full list of Form Sections.
+	return sectionList, nil
+}
+
+// GetFormProperties retrieves the property definitions of a form in xMatters, collected from its custom sections.
+// It requires the formId parameter to identify the specific form, and returns a slice of FormProperty objects.
+func (xmatters *XMattersAPI) GetFormProperties(formId string) ([]*FormProperty, error) {
+	sectionList, err := xmatters.GetFormSections(formId)
+	if err != nil {
+		return []*FormProperty{}, err
+	}
+
+	// Collect the properties of every section
+	propertyList := []*FormProperty{}
+	for _, section := range sectionList {
+		propertyList = append(propertyList, section.Properties...)
+	}
+	return propertyList, nil
+}
+
+// GetFormSectionPaginationSet retrieves a paginated list of form sections.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetFormSectionPaginationSet(uri string) ([]*FormSection, error) {
+	return getConvertedPaginationSet(xmatters, uri, (*formSectionJSON).section)
+}
+
+// GetFormSenderPermissions retrieves the people, groups, and roles that may send a form in xMatters.
+// It requires the formId parameter to identify the specific form, and returns a slice of SenderPermission objects.
+func (xmatters *XMattersAPI) GetFormSenderPermissions(formId string) ([]*SenderPermission, error) {
+	uri := buildURI(fmt.Sprintf("/forms/%s/sender-permissions", pathSegment(formId)), nil)
+
+	// Use the GetSenderPermissionPaginationSet method to get all paginated results
+	permissionList, err := xmatters.GetSenderPermissionPaginationSet(uri)
+	if err != nil {
+		return permissionList, err
+	}
+
+	// Return the full list of Sender Permissions.
+	return permissionList, nil
+}
+
+// AddFormSenderPermission grants a person, group, or role permission to send a form in xMatters.
+// It requires the formId parameter and the recipientId of the person, group, or role being granted permission.
+// It returns the created SenderPermission object.
+func (xmatters *XMattersAPI) AddFormSenderPermission(formId, recipientId string) (SenderPermission, error) {
+	uri := buildURI(fmt.Sprintf("/forms/%s/sender-permissions", pathSegment(formId)), nil)
+	body := struct {
+		Recipient ReferenceById `json:"recipient"`
+	}{Recipient: ReferenceById{ID: &recipientId}}
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, body)
+	if err != nil {
+		return SenderPermission{}, err
+	}
+
+	// Unmarshal the response into a SenderPermission struct.
+	var result SenderPermission
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return SenderPermission{}, newUnmarshalError()
+	}
+
+	// Return the created SenderPermission object.
+	return result, nil
+}
+
+// RemoveFormSenderPermission revokes a person, group, or role's permission to send a form in xMatters.
+// It requires the formId parameter and the recipientId of the person, group, or role losing permission.
+func (xmatters *XMattersAPI) RemoveFormSenderPermission(formId, recipientId string) error {
+	uri := buildURI(fmt.Sprintf("/forms/%s/sender-permissions/%s", pathSegment(formId), pathSegment(recipientId)), nil)
+
+	// Perform the API request.
+	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
+	if err != nil {
+		return err
+	}
+
+	// Return
+	return nil
+}
+
+// GetSenderPermissionPaginationSet retrieves a paginated list of sender permissions.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetSenderPermissionPaginationSet(uri string) ([]*SenderPermission, error) {
+	return getPaginationSet[SenderPermission](xmatters, uri)
+}
+
+// GetFormResponseOptions retrieves the response options of a form in xMatters.
+// It requires the formId parameter to identify the specific form, and returns a slice of ResponseOption objects.
+func (xmatters *XMattersAPI) GetFormResponseOptions(formId string) ([]*ResponseOption, error) {
+	uri := buildURI(fmt.Sprintf("/forms/%s/response-options", pathSegment(formId)), nil)
+
+	// Use the GetResponseOptionPaginationSet method to get all paginated results
+	optionList, err := xmatters.GetResponseOptionPaginationSet(uri)
+	if err != nil {
+		return optionList, err
+	}
+
+	// Return the full list of Response Options.
+	return optionList, nil
+}
+
+// PushFormResponseOption either creates a new response option on a form in xMatters or modifies an existing one.
+// It requires the formId parameter and the ResponseOption to push; if option.ID is provided it updates the
+// existing response option, otherwise it creates a new one. It returns the created or modified ResponseOption object.
+func (xmatters *XMattersAPI) PushFormResponseOption(formId string, option *ResponseOption) (ResponseOption, error) {
+	if option != nil && option.ID == nil {
+		if err := option.validate(); err != nil {
+			return ResponseOption{}, err
+		}
+	}
+
+	uri := buildURI(fmt.Sprintf("/forms/%s/response-options", pathSegment(formId)), nil)
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, option)
+	if err != nil {
+		return ResponseOption{}, err
+	}
+
+	// Unmarshal the response into a ResponseOption struct.
+	var result ResponseOption
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return ResponseOption{}, newUnmarshalError()
+	}
+
+	// Return the returned ResponseOption object.
+	return result, nil
+}
+
+// DeleteFormResponseOption deletes a response option from a form in xMatters.
+// It requires the formId and optionId parameters to identify the specific response option to be deleted.
+func (xmatters *XMattersAPI) DeleteFormResponseOption(formId, optionId string) error {
+	uri := buildURI(fmt.Sprintf("/forms/%s/response-options/%s", pathSegment(formId), pathSegment(optionId)), nil)
+
+	// Perform the API request.
+	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
+	if err != nil {
+		return err
+	}
+
+	// Return
+	return nil
+}
+
+// SyncFormResponseOptions makes the response options of a form in xMatters match the provided list,
+// so response choices can be standardized across forms from a single source of truth.
+// Options are matched by text: matching options are updated, new options are created, and options
+// that are not in the list are deleted. It returns the resulting response options of the form.
+func (xmatters *XMattersAPI) SyncFormResponseOptions(formId string, options []*ResponseOption) ([]*ResponseOption, error) {
+	// Validate the desired options before changing anything
+	for _, option := range options {
+		if err := option.validate(); err != nil {
+			return []*ResponseOption{}, err
+		}
+	}
+
+	// Index the current options by text
+	current, err := xmatters.GetFormResponseOptions(formId)
+	if err != nil {
+		return []*ResponseOption{}, err
+	}
+	existing := make(map[string]*ResponseOption, len(current))
+	for _, option := range current {
+		existing[strings.ToLower(stringValue(option.Text))] = option
+	}
+
+	// Create or update each desired option
+	result := []*ResponseOption{}
+	wanted := make(map[string]bool, len(options))
+	for _, option := range options {
+		key := strings.ToLower(stringValue(option.Text))
+		wanted[key] = true
+		desired := *option
+		if match, ok := existing[key]; ok {
+			desired.ID = match.ID
+		}
+		pushed, err := xmatters.PushFormResponseOption(formId, &desired)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, &pushed)
+	}
+
+	// Delete options that are not in the desired list
+	for _, option := range current {
+		if wanted[strings.ToLower(stringValue(option.Text))] {
+			continue
+		}
+		if err := xmatters.DeleteFormResponseOption(formId, stringValue(option.ID)); err != nil {
+			return result, err
+		}
+	}
+
+	// Return the resulting response options
+	return result, nil
+}
+
+// GetResponseOptionPaginationSet retrieves a paginated list of response options.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetResponseOptionPaginationSet(uri string) ([]*ResponseOption, error) {
+	return getPaginationSet[ResponseOption](xmatters, uri)
+}
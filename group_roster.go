@@ -1,10 +1,8 @@
 package xmatters
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 )
 
 // -------------------------------------------------------------------------------------------------
@@ -27,10 +25,10 @@ type GroupMember struct {
 
 // GroupReference represents a shorthand version of a group in xMatters.
 type GroupReference struct {
-	ID            *string `json:"id,omitempty"`
-	TargetName    *string `json:"targetName,omitempty"`
-	RecipientType *string `json:"recipientType,omitempty"`
-	GroupType     *string `json:"groupType,omitempty"`
+	ID            *string `json:"id,omitempty" tfsdk:"id"`
+	TargetName    *string `json:"targetName,omitempty" tfsdk:"target_name"`
+	RecipientType *string `json:"recipientType,omitempty" tfsdk:"recipient_type"`
+	GroupType     *string `json:"groupType,omitempty" tfsdk:"group_type"`
 }
 
 // RecipientReference represents a group member in xMatters.
@@ -66,7 +64,7 @@ type RecipientReference struct {
 	Properties  *map[string]string `json:"properties,omitempty"`
 	Roles       *RolePagination    `json:"roles,omitempty"`
 	Timezone    *string            `json:"timezone,omitempty"`
-	LastLogin   *string            `json:"lastLogin,omitempty"`
+	LastLogin   *Timestamp         `json:"lastLogin,omitempty"`
 	WebLogin    *string            `json:"webLogin,omitempty"`
 
 	// Opional Device Fields
@@ -108,69 +106,69 @@ type GroupMembershipPagination struct {
 // GetGroupRoster retrieves the member roster of a group in xMatters.
 // It requires the groupId parameter to identify the specific group, and returns a GroupRoster object.
 func (xmatters *XMattersAPI) GetGroupRoster(groupId string) (GroupRoster, error) {
-	uri := buildURI(fmt.Sprintf("/groups/%s/members", groupId), nil)
+	uri := buildURI(fmt.Sprintf("/groups/%s/members", pathSegment(groupId)), nil)
 
 	// Use the GetGroupRosterPaginationSet method to get all members of the group
 	groupRoster, err := xmatters.GetGroupRosterPaginationSet(uri)
 	if err != nil {
-		return GroupRoster{}, err
+		return groupRoster, err
 	}
 
 	// Return the fully filled out group roster
 	return groupRoster, nil
 }
 
-// GetGroupRosterPaginationSet is a recursive helper function that handles a paginated list of group rosters.
-// It takes a URI as input and retrieves the paginated set from that URI.
-// It checks for additional pages and recursively fetches them until all pages are retrieved.
-func (xmatters *XMattersAPI) GetGroupRosterPaginationSet(uri string) (GroupRoster, error) {
-	// Perform the API request.
-	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
-	if err != nil {
-		return GroupRoster{}, err
-	}
+// GetGroupMemberships retrieves the memberships of a group in xMatters.
+// It requires the groupId parameter to identify the specific group, and returns a slice of GroupMembership objects.
+// Unlike GetGroupRoster, each membership keeps the target name and other details of the member.
+func (xmatters *XMattersAPI) GetGroupMemberships(groupId string) ([]*GroupMembership, error) {
+	uri := buildURI(fmt.Sprintf("/groups/%s/members", pathSegment(groupId)), nil)
 
-	// Unmarshal the response body into the GroupMembershipPagination struct.
-	var memberPagination GroupMembershipPagination
-	err = json.Unmarshal(resp, &memberPagination)
+	// Use the GetGroupMembershipPaginationSet method to get all paginated results
+	membershipList, err := xmatters.GetGroupMembershipPaginationSet(uri)
 	if err != nil {
-		return GroupRoster{}, newUnmarshalError()
+		return membershipList, err
 	}
 
-	if len(memberPagination.Memberships) == 0 {
-		return GroupRoster{}, nil
+	// Return the full list of Group Memberships.
+	return membershipList, nil
+}
+
+// GetGroupMembershipPaginationSet retrieves a paginated list of group memberships.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetGroupMembershipPaginationSet(uri string) ([]*GroupMembership, error) {
+	return getPaginationSet[GroupMembership](xmatters, uri)
+}
+
+// GetGroupRosterPaginationSet retrieves a paginated list of group rosters.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetGroupRosterPaginationSet(uri string) (GroupRoster, error) {
+	// Retrieve the memberships from every page
+	memberships, err := getPaginationSet[GroupMembership](xmatters, uri)
+	if len(memberships) == 0 {
+		return GroupRoster{}, err
 	}
 
 	// Assign members to be returned
-	var memberList []*GroupMember
-	for _, member := range memberPagination.Memberships {
+	memberList := make([]*GroupMember, 0, len(memberships))
+	for _, member := range memberships {
 		memberList = append(memberList, &GroupMember{
 			ID:         member.Member.ID,
 			MemberType: member.Member.RecipientType,
 		})
 	}
 
-	// Check for additional paginated results
-	if memberPagination.Pagination.Links.Next != nil {
-		nextUri := strings.ReplaceAll(*memberPagination.Pagination.Links.Next, defaultBasePath, "")
-		// Use recursion to get the next set of results
-		nextSet, err := xmatters.GetGroupRosterPaginationSet(nextUri)
-		if err != nil {
-			return GroupRoster{}, err
-		}
-		// Append the next set of results to the current list
-		memberList = append(memberList, nextSet.Members...)
-	}
-
 	// Assign group information from the first membership entry
 	groupRoster := GroupRoster{
-		ID:      memberPagination.Memberships[0].Group.ID,
-		Group:   &memberPagination.Memberships[0].Group,
+		ID:      memberships[0].Group.ID,
+		Group:   &memberships[0].Group,
 		Members: memberList,
 	}
 
-	// Return the fully concatenated list of members from all paginated results
-	return groupRoster, nil
+	// Return the roster with ErrTruncated if the client limits were reached
+	return groupRoster, err
 }
 
 // PushGroupRoster updates the members of a group in xMatters to match the desired list of members.
@@ -181,22 +179,33 @@ func (xmatters *XMattersAPI) PushGroupRoster(groupId string, params []*GroupMemb
 	if err != nil {
 		return GroupRoster{}, err
 	}
-	// Iterate over current members and remove them from the group if they are not in the desired list
+	// Find the current members that are not in the desired list, and the desired members that are not already members
+	var removed, added []*GroupMember
 	for _, member := range currentRoster.Members {
 		if !ContainsMember(*member, params) {
-			if err := xmatters.DeleteGroupMembership(groupId, *member.ID); err != nil {
-				return GroupRoster{}, err
-			}
-
+			removed = append(removed, member)
 		}
 	}
-	// Iterate over desired members and add them to the group if they are not already members
 	for _, member := range params {
 		if !ContainsMember(*member, currentRoster.Members) {
-			if _, err := xmatters.PushGroupMembership(groupId, member); err != nil {
-				return GroupRoster{}, err
-			}
+			added = append(added, member)
+		}
+	}
+	progress := xmatters.startProgress("PushGroupRoster", len(removed)+len(added))
+
+	// Remove the members that are not in the desired list
+	for _, member := range removed {
+		if err := xmatters.DeleteGroupMembership(groupId, *member.ID); err != nil {
+			return GroupRoster{}, err
 		}
+		progress.advance(1)
+	}
+	// Add the desired members that are not already members
+	for _, member := range added {
+		if _, err := xmatters.PushGroupMembership(groupId, member); err != nil {
+			return GroupRoster{}, err
+		}
+		progress.advance(1)
 	}
 	// Get the updated roster and return
 	newRoster, err := xmatters.GetGroupRoster(groupId)
@@ -227,7 +236,12 @@ func (xmatters *XMattersAPI) DeleteGroupRoster(groupId string) error {
 // The method returns the updated GroupMember object.
 // It is used internally by the PushGroupRoster method to add members to a group.
 func (xmatters *XMattersAPI) PushGroupMembership(groupId string, params *GroupMember) (GroupMember, error) {
-	uri := buildURI(fmt.Sprintf("/groups/%s/members", groupId), nil)
+	uri := buildURI(fmt.Sprintf("/groups/%s/members", pathSegment(groupId)), nil)
+
+	// Validate the member type before sending the request
+	if params.MemberType != nil && !RecipientType(*params.MemberType).IsValid() {
+		return GroupMember{}, newValidationError(fmt.Sprintf("invalid recipient type %q", *params.MemberType))
+	}
 
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
@@ -237,7 +251,7 @@ func (xmatters *XMattersAPI) PushGroupMembership(groupId string, params *GroupMe
 
 	// Unmarshal the response body into the Recipient struct.
 	var result GroupMember
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return GroupMember{}, newUnmarshalError()
 	}
@@ -251,7 +265,7 @@ func (xmatters *XMattersAPI) PushGroupMembership(groupId string, params *GroupMe
 // The method returns an error if any issues occur.
 // It is used internally by the PushGroupRoster method to remove members from a group.
 func (xmatters *XMattersAPI) DeleteGroupMembership(groupId, memberId string) error {
-	uri := buildURI(fmt.Sprintf("/groups/%s/members/%s", groupId, memberId), nil) // The URI for creating or modifying a Group Member in xMatters
+	uri := buildURI(fmt.Sprintf("/groups/%s/members/%s", pathSegment(groupId), pathSegment(memberId)), nil) // The URI for creating or modifying a Group Member in xMatters
 
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
@@ -261,7 +275,7 @@ func (xmatters *XMattersAPI) DeleteGroupMembership(groupId, memberId string) err
 
 	// Unmarshal the response into a Group struct.
 	var result GroupMember
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 )
 
 // -------------------------------------------------------------------------------------------------
@@ -13,21 +12,21 @@ import (
 
 // Group represents a group in xMatters.
 type Group struct {
-	ID                *string            `json:"id"`
-	TargetName        *string            `json:"targetName"`
-	Status            *string            `json:"status"`
-	Description       *string            `json:"description,omitempty"`
-	GroupType         *string            `json:"groupType,omitempty"`
-	AllowDuplicates   *bool              `json:"allowDuplicates,omitempty"`
-	Timezone          *string            `json:"timezone,omitempty"`
-	Site              *ReferenceById     `json:"site,omitempty"`
-	ObservedByAll     *bool              `json:"observedByAll,omitempty"`
-	Observers         []*ReferenceByName `json:"observers,omitempty"`
-	UseDefaultDevices *bool              `json:"useDefaultDevices,omitempty"`
-	Supervisors       []*ReferenceById   `json:"supervisors,omitempty"`
-	Services          []*Service         `json:"services,omitempty"`
-	ExternalKey       *string            `json:"externalKey,omitempty"`
-	ExternallyOwned   *bool              `json:"externallyOwned,omitempty"`
+	ID                *string            `json:"id" tfsdk:"id"`
+	TargetName        *string            `json:"targetName" tfsdk:"target_name"`
+	Status            *string            `json:"status" tfsdk:"status"`
+	Description       *string            `json:"description,omitempty" tfsdk:"description"`
+	GroupType         *string            `json:"groupType,omitempty" tfsdk:"group_type"`
+	AllowDuplicates   *bool              `json:"allowDuplicates,omitempty" tfsdk:"allow_duplicates"`
+	Timezone          *string            `json:"timezone,omitempty" tfsdk:"timezone"`
+	Site              *ReferenceById     `json:"site,omitempty" tfsdk:"site"`
+	ObservedByAll     *bool              `json:"observedByAll,omitempty" tfsdk:"observed_by_all"`
+	Observers         []*ReferenceByName `json:"observers,omitempty" tfsdk:"observers"`
+	UseDefaultDevices *bool              `json:"useDefaultDevices,omitempty" tfsdk:"use_default_devices"`
+	Supervisors       []*ReferenceById   `json:"supervisors,omitempty" tfsdk:"supervisors"`
+	Services          []*Service         `json:"services,omitempty" tfsdk:"services"`
+	ExternalKey       *string            `json:"externalKey,omitempty" tfsdk:"external_key"`
+	ExternallyOwned   *bool              `json:"externallyOwned,omitempty" tfsdk:"externally_owned"`
 }
 
 // GroupPagination contains a paginated list of groups.
@@ -37,6 +36,17 @@ type GroupPagination struct {
 	Groups []*Group `json:"data,omitempty"`
 }
 
+// groupJSON is the JSON representation of a Group, with its embedded collections nested within pagination objects.
+type groupJSON struct {
+	groupFields
+	Observers   embeddedList[*ReferenceByName] `json:"observers"`
+	Supervisors embeddedList[*ReferenceById]   `json:"supervisors"`
+	Services    embeddedList[*serviceJSON]     `json:"services"`
+}
+
+// groupFields has the fields of a Group without its methods, so decoding it does not call UnmarshalJSON.
+type groupFields Group
+
 // -------------------------------------------------------------------------------------------------
 // Method Parameter Structs
 // -------------------------------------------------------------------------------------------------
@@ -45,9 +55,7 @@ type GroupPagination struct {
 type GetGroupsParams struct {
 	Embed string `url:"embed,omitempty"`
 	// Provider Search Object
-	Terms   string `url:"search,omitempty"`
-	Fields  string `url:"fields,omitempty"`
-	Operand string `url:"operand,omitempty"`
+	SearchQuery `url:"search,omitempty"`
 	// Provider Filters Object
 	GroupType    string `url:"groupType,omitempty"`
 	MemberExists string `url:"member.exists,omitempty"`
@@ -66,13 +74,13 @@ type PushGroupParams struct {
 	TargetName        string             `json:"targetName"`
 	AllowDuplicates   *bool              `json:"allowDuplicates,omitempty"`
 	Description       string             `json:"description,omitempty"`
-	ExternalKey       string             `json:"externalKey,omitempty"`
-	ExternallyOwned   *bool              `json:"externallyOwned,omitempty"`
-	GroupType         string             `json:"groupType,omitempty"`
+	ExternalKey       Nullable[string]   `json:"externalKey,omitempty"`
+	ExternallyOwned   Nullable[bool]     `json:"externallyOwned,omitempty"`
+	GroupType         GroupType          `json:"groupType,omitempty"`
 	ObservedByAll     *bool              `json:"observedByAll,omitempty"`
 	Observers         []*ReferenceByName `json:"observers,omitempty"`
 	Site              string             `json:"site,omitempty"`
-	Status            string             `json:"status,omitempty"`
+	Status            Status             `json:"status,omitempty"`
 	UseDefaultDevices *bool              `json:"useDefaultDevices,omitempty"`
 	Supervisors       []*ReferenceById   `json:"supervisors,omitempty"`
 }
@@ -84,42 +92,55 @@ type PushGroupParams struct {
 // Custom Unmarshaller for Group to handle embedded observers, supervisors, and services
 // This is necessary because the JSON structure for these fields are nested within pagination objects.
 func (g *Group) UnmarshalJSON(data []byte) error {
-	type Alias Group
-	aux := &struct {
-		Observers struct {
-			Observers []*ReferenceByName `json:"data"`
-		} `json:"observers"`
-		Supervisors struct {
-			Supervisors []*ReferenceById `json:"data"`
-		} `json:"supervisors"`
-		Services struct {
-			Services []*Service `json:"data"`
-		} `json:"services"`
-		*Alias
-	}{
-		Alias: (*Alias)(g),
-	}
-
-	// Unmarshal the JSON into the auxiliary struct
-	if err := json.Unmarshal(data, aux); err != nil {
+	var decoded groupJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
 		return fmt.Errorf("failed to unmarshal Group: %w", err)
 	}
+	*g = *decoded.group()
+	return nil
+}
+
+// Custom Marshaller for Group to nest embedded observers, supervisors, and services within pagination objects
+// This mirrors UnmarshalJSON, so a marshalled Group can be unmarshalled again without losing data.
+func (g Group) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Observers   *embeddedList[*ReferenceByName] `json:"observers,omitempty"`
+		Supervisors *embeddedList[*ReferenceById]   `json:"supervisors,omitempty"`
+		Services    *embeddedList[*Service]         `json:"services,omitempty"`
+		groupFields
+	}{
+		Observers:   newEmbeddedList(g.Observers),
+		Supervisors: newEmbeddedList(g.Supervisors),
+		Services:    newEmbeddedList(g.Services),
+		groupFields: groupFields(g),
+	})
+}
 
-	// Assign the extracted attributes
-	g.Observers = aux.Observers.Observers
-	g.Supervisors = aux.Supervisors.Supervisors
-	g.Services = aux.Services.Services
+// group returns the Group held by its JSON representation, taking its embedded collections out of their pagination objects.
+func (g *groupJSON) group() *Group {
+	result := (*Group)(&g.groupFields)
+	result.Observers = g.Observers.Data
+	result.Supervisors = g.Supervisors.Data
+	result.Services = convertAll(g.Services.Data, (*serviceJSON).service)
+	return result
+}
 
-	return nil
+// String returns a compact, human-readable summary of the group, such as "Group Database Team (ON_CALL, ACTIVE)".
+func (g Group) String() string {
+	return formatSummary("Group", stringValue(g.TargetName), stringValue(g.GroupType), stringValue(g.Status))
 }
 
 // GetGroup retrieves a group in xMatters.
 // It requires the groupId parameter to identify the specific group, and returns a Group object.
-// A URL parameter is added to the request URI to embed the supervisors, observers, and services.
-func (xmatters XMattersAPI) GetGroup(groupId string) (Group, error) {
-	uri := buildURI(fmt.Sprintf("/groups/%s", groupId), struct {
-		Embed string `url:"embed"`
-	}{Embed: "supervisors,observers,services"})
+// A URL parameter is added to the request URI to embed the supervisors, observers, and services,
+// and the remaining pages of each embedded list are retrieved so that they are complete.
+// Optional GetOption values, such as WithEmbed, override the embedded objects and fields of the response.
+func (xmatters XMattersAPI) GetGroup(groupId string, opts ...GetOption) (Group, error) {
+	if err := validateIdentifier("group ID or target name", groupId); err != nil {
+		return Group{}, err
+	}
+
+	uri := buildURI(fmt.Sprintf("/groups/%s", pathSegment(groupId)), parseGetOptions("supervisors,observers,services", opts))
 
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
@@ -127,74 +148,95 @@ func (xmatters XMattersAPI) GetGroup(groupId string) (Group, error) {
 		return Group{}, err
 	}
 
-	// Unmarshal the response into an Group struct.
-	var result Group
-	err = json.Unmarshal(resp, &result)
+	// Unmarshal the response into the JSON representation of a Group, which keeps the embedded pagination links.
+	var decoded groupJSON
+	err = xmatters.decode(resp, &decoded)
 	if err != nil {
 		return Group{}, newUnmarshalError()
 	}
+	result := decoded.group()
+
+	// Fetch the remaining pages of embedded observers, supervisors, and services
+	if result.Observers, err = getEmbeddedPages(&xmatters, result.Observers, decoded.Observers.Links); err != nil {
+		return Group{}, err
+	}
+	if result.Supervisors, err = getEmbeddedPages(&xmatters, result.Supervisors, decoded.Supervisors.Links); err != nil {
+		return Group{}, err
+	}
+	if result.Services, err = getEmbeddedPages(&xmatters, result.Services, decoded.Services.Links); err != nil {
+		return Group{}, err
+	}
 
 	// Return the returned Group object.
-	return result, nil
+	return *result, nil
+}
+
+// GetGroupServices retrieves every service owned by a group in xMatters, following all pages of the list,
+// so ownership reports are complete however many services the group owns.
+// The groupId parameter may be a group ID or target name.
+func (xmatters *XMattersAPI) GetGroupServices(groupId string) ([]*Service, error) {
+	if err := validateIdentifier("group ID or target name", groupId); err != nil {
+		return []*Service{}, err
+	}
+	return xmatters.GetServiceList(GetServicesParams{OwnedBy: groupId})
+}
+
+// CountGroups returns the number of groups in xMatters matching the query parameters,
+// without retrieving the groups themselves.
+func (xmatters *XMattersAPI) CountGroups(params GetGroupsParams) (int64, error) {
+	return xmatters.countResults("/groups", params)
 }
 
 // GetGroupList retrieves a list of groups in xMatters.
 // It accepts optional query parameters to filter the results and returns a slice of Group objects.
+// Collections embedded with params.Embed, such as services, only hold their first page for each group;
+// use GetGroup or GetGroupServices for the complete lists.
 func (xmatters *XMattersAPI) GetGroupList(params GetGroupsParams) ([]*Group, error) {
 	uri := buildURI("/groups", params)
 
 	// Use the GetGroupPaginationSet method to retrieve all paginated results
 	groupList, err := xmatters.GetGroupPaginationSet(uri)
 	if err != nil {
-		return []*Group{}, err
+		return groupList, err
 	}
 
 	// Return the full list of Groups.
 	return groupList, nil
 }
 
-// GetGroupPaginationSet is a recursive helper function that handles a paginated list of groups.
-// It takes a URI as input and retrieves the paginated set from that URI.
-// It checks for additional pages and recursively fetches them until all pages are retrieved.
+// GetGroupPaginationSet retrieves a paginated list of groups.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
 func (xmatters *XMattersAPI) GetGroupPaginationSet(uri string) ([]*Group, error) {
-	// Perform the API request with provided URI
-	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
-	if err != nil {
-		return []*Group{}, err
-	}
-
-	// Unmarshal the response into a GroupPagination struct.
-	var groupPagination GroupPagination
-	err = json.Unmarshal(resp, &groupPagination)
-	if err != nil {
-		return []*Group{}, newUnmarshalError()
-	}
-
-	// Assign groups to be returned
-	groupList := groupPagination.Groups
-
-	// Check for additional paginated results
-	if groupPagination.Pagination.Links.Next != nil {
-		// Remove defaultBasePath (/api/xm/1) from the next URI
-		nextUri := strings.ReplaceAll(*groupPagination.Pagination.Links.Next, defaultBasePath, "")
-		// Use recursion to get the next set of results
-		nextSet, err := xmatters.GetGroupPaginationSet(nextUri)
-		if err != nil {
-			return []*Group{}, err
-		}
-		groupList = append(groupList, nextSet...)
-	}
-
-	// Return the fully concatenated list of groups from all paginated results
-	return groupList, nil
+	return getConvertedPaginationSet(xmatters, uri, (*groupJSON).group)
 }
 
 // PushGroup either creates a new group in xMatters or modifies an existing group.
 // It requires the PushGroupParams struct to specify the group details.
 // It returns the created or modified Group object.
 // If the params.ID is provided it updates the existing group; otherwise, it creates a new one.
-func (xmatters *XMattersAPI) PushGroup(params PushGroupParams) (Group, error) {
+// With IfUnchanged, an update fails with ErrConflict when the group was changed since it was read.
+// WithExternalKey sets the external key of the group and marks it as externally owned.
+func (xmatters *XMattersAPI) PushGroup(params PushGroupParams, opts ...PushOption) (Group, error) {
 	uri := buildURI("/groups", nil) // The URI for creating or modifying a Group in xMatters
+	options := parsePushOptions(opts)
+	if options.externalKey != "" {
+		params.ExternalKey = NewNullable(options.externalKey)
+		params.ExternallyOwned = NewNullable(true)
+	}
+
+	// Validate the enumerated fields before sending the request
+	if params.GroupType != "" && !params.GroupType.IsValid() {
+		return Group{}, newValidationError(fmt.Sprintf("invalid group type %q", params.GroupType))
+	}
+	if params.Status != "" && !params.Status.IsValid() {
+		return Group{}, newValidationError(fmt.Sprintf("invalid group status %q", params.Status))
+	}
+
+	// Check that the group is unchanged since it was read
+	if err := checkUnchanged(params.ID, options, xmatters.GetGroup, unchangedGroup); err != nil {
+		return Group{}, err
+	}
 
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
@@ -204,7 +246,7 @@ func (xmatters *XMattersAPI) PushGroup(params PushGroupParams) (Group, error) {
 
 	// Unmarshal the response into a Group struct.
 	var result Group
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return Group{}, newUnmarshalError()
 	}
@@ -217,7 +259,11 @@ func (xmatters *XMattersAPI) PushGroup(params PushGroupParams) (Group, error) {
 // It requires the groupId parameter to identify the specific group to be deleted.
 // It returns an error if the deletion fails.
 func (xmatters *XMattersAPI) DeleteGroup(groupId string) error {
-	uri := buildURI(fmt.Sprintf("/groups/%s", groupId), nil) // The URI for Deleting a Group in xMatters
+	if err := validateIdentifier("group ID or target name", groupId); err != nil {
+		return err
+	}
+
+	uri := buildURI(fmt.Sprintf("/groups/%s", pathSegment(groupId)), nil) // The URI for Deleting a Group in xMatters
 
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
@@ -227,7 +273,7 @@ func (xmatters *XMattersAPI) DeleteGroup(groupId string) error {
 
 	// Unmarshal the response into a Group struct.
 	var result Group
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
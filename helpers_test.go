@@ -0,0 +1,21 @@
+package xmatters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestClient returns a client for a test server serving the handler, which is closed when the test ends.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *XMattersAPI {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	hostname, token := "test.invalid", "token"
+	client, err := NewWithToken(&hostname, &token, WithBaseURL(server.URL), WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
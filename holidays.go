@@ -0,0 +1,166 @@
+package xmatters
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// holidayDateFormat is the layout of the dates of holidays.
+const holidayDateFormat = "2006-01-02"
+
+// timeframeDays maps the day codes of device timeframes to weekdays.
+var timeframeDays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// -------------------------------------------------------------------------------------------------
+// Holiday Structs
+// -------------------------------------------------------------------------------------------------
+
+// Holiday is a day on which device timeframes that exclude holidays are inactive.
+// Date is the calendar day, as YYYY-MM-DD, and Sites lists the IDs or names of the sites that observe
+// a site holiday. A holiday without sites is observed company-wide.
+type Holiday struct {
+	Name  string   `json:"name" yaml:"name"`
+	Date  string   `json:"date" yaml:"date"`
+	Sites []string `json:"sites,omitempty" yaml:"sites,omitempty"`
+}
+
+// HolidayCalendar holds the holidays of an instance. The xMatters REST API does not expose the holidays
+// configured in the instance, so they are provided to the client with WithHolidays, such as loaded from a
+// configuration file. The dates of the holidays are days in TimeZone, an IANA time zone name such as
+// "America/New_York", or in UTC when it is empty.
+type HolidayCalendar struct {
+	TimeZone string     `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Holidays []*Holiday `json:"holidays" yaml:"holidays"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Holiday Methods
+// -------------------------------------------------------------------------------------------------
+
+// Validate checks that the time zone of the calendar exists and that every holiday has a valid date.
+func (c *HolidayCalendar) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if _, err := time.LoadLocation(c.TimeZone); err != nil {
+		return newValidationError(fmt.Sprintf("invalid holiday time zone %q", c.TimeZone))
+	}
+	for _, holiday := range c.Holidays {
+		if _, err := time.Parse(holidayDateFormat, holiday.Date); err != nil {
+			return newValidationError(fmt.Sprintf("holiday %q has an invalid date %q, expected YYYY-MM-DD", holiday.Name, holiday.Date))
+		}
+	}
+	return nil
+}
+
+// IsHoliday reports whether the day of t, in the location of the calendar, is a holiday observed by the site.
+// The site is a site ID or name; an empty site only matches company-wide holidays.
+func (c *HolidayCalendar) IsHoliday(t time.Time, site string) bool {
+	if c == nil {
+		return false
+	}
+	date := t.In(c.location()).Format(holidayDateFormat)
+	for _, holiday := range c.Holidays {
+		if holiday.Date == date && holiday.observedBy(site) {
+			return true
+		}
+	}
+	return false
+}
+
+// companyHolidays returns the company-wide holidays that overlap the time from from to to, as periods
+// clipped to that time, in chronological order. Consecutive holidays are merged into a single period.
+func (c *HolidayCalendar) companyHolidays(from, to time.Time) []*CoveragePeriod {
+	if c == nil {
+		return nil
+	}
+	var periods []*CoveragePeriod
+	for _, holiday := range c.Holidays {
+		if len(holiday.Sites) > 0 {
+			continue
+		}
+		day, err := time.ParseInLocation(holidayDateFormat, holiday.Date, c.location())
+		if err != nil {
+			continue
+		}
+		start, end := maxTime(day, from), minTime(day.AddDate(0, 0, 1), to)
+		if end.After(start) {
+			periods = append(periods, &CoveragePeriod{Start: start, End: end})
+		}
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].Start.Before(periods[j].Start) })
+
+	merged := []*CoveragePeriod{}
+	for _, period := range periods {
+		if last := len(merged) - 1; last >= 0 && !period.Start.After(merged[last].End) {
+			merged[last].End = maxTime(merged[last].End, period.End)
+			continue
+		}
+		merged = append(merged, period)
+	}
+	return merged
+}
+
+// location returns the location of the holiday dates, or UTC when the time zone of the calendar is empty or invalid.
+func (c *HolidayCalendar) location() *time.Location {
+	location, err := time.LoadLocation(c.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// observedBy reports whether the holiday is observed by the site.
+func (h *Holiday) observedBy(site string) bool {
+	if len(h.Sites) == 0 {
+		return true
+	}
+	for _, observer := range h.Sites {
+		if site != "" && strings.EqualFold(observer, site) {
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveAt reports whether the timeframe is active at t. The timeframe starts at its StartTime, as HH:MM, on each
+// of its Days, and lasts DurationInMinutes, so it may extend past midnight. t must be in the time zone of the
+// device owner, as timeframes are defined in it. When the timeframe excludes holidays, it does not start on days
+// for which isHoliday returns true; isHoliday may be nil when there are no holidays, or be the IsHoliday method
+// of a HolidayCalendar bound to the owner's site:
+//
+//	active := timeframe.ActiveAt(now.In(location), func(day time.Time) bool { return holidays.IsHoliday(day, site) })
+func (tf *DeviceTimeframe) ActiveAt(t time.Time, isHoliday func(day time.Time) bool) bool {
+	start, err := time.Parse("15:04", stringValue(tf.StartTime))
+	if err != nil || tf.DurationInMinutes == nil {
+		return false
+	}
+	duration := time.Duration(*tf.DurationInMinutes) * time.Minute
+	days := make(map[time.Weekday]bool, len(tf.Days))
+	for _, day := range tf.Days {
+		if weekday, ok := timeframeDays[strings.ToUpper(stringValue(day))]; ok {
+			days[weekday] = true
+		}
+	}
+
+	// Check the occurrences that started on the day of t and on the days before it that could still be running
+	for back := 0; back <= int(duration/(24*time.Hour))+1; back++ {
+		day := t.AddDate(0, 0, -back)
+		if !days[day.Weekday()] {
+			continue
+		}
+		if Value(tf.ExcludeHolidays, false) && isHoliday != nil && isHoliday(day) {
+			continue
+		}
+		occurrence := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, t.Location())
+		if !t.Before(occurrence) && t.Before(occurrence.Add(duration)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package xmatters
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// nonProductionSuffix is appended to the instance name in the hostname of a non-production instance.
+const nonProductionSuffix = "-np"
+
+// instanceNamePattern matches the name of an instance, which is a single DNS label.
+var instanceNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// -------------------------------------------------------------------------------------------------
+// Hostname Structs
+// -------------------------------------------------------------------------------------------------
+
+// Environment is the environment of an xMatters instance. Each production instance can have a
+// non-production instance, used for staging and testing, whose hostname adds "-np" to the instance name.
+type Environment string
+
+const (
+	EnvironmentProduction    Environment = "prod"
+	EnvironmentNonProduction Environment = "np"
+)
+
+// Region is the domain that hosts an xMatters instance, such as "xmatters.com".
+// Instances hosted in a regional data center use the domain they were provisioned in.
+type Region string
+
+const (
+	// RegionDefault is the domain of most xMatters instances.
+	RegionDefault Region = "xmatters.com"
+	// RegionHosted is the domain of instances provisioned before xmatters.com hostnames.
+	RegionHosted Region = "hosted.xmatters.com"
+)
+
+// defaultRegions lists the domains that ValidateHostname and ParseHostname always accept.
+var defaultRegions = []Region{RegionDefault, RegionHosted}
+
+// InstanceHostname describes the hostname of an xMatters instance, as returned by ParseHostname.
+type InstanceHostname struct {
+	Instance    string
+	Environment Environment
+	Region      Region
+}
+
+// -------------------------------------------------------------------------------------------------
+// Hostname Methods
+// -------------------------------------------------------------------------------------------------
+
+// Hostname builds the hostname of an instance from its name, environment and region, so pipelines that
+// deploy to several environments can configure the instance name once.
+// An empty environment is production and an empty region is RegionDefault.
+// Example usage:
+//
+//	hostname, err := xmatters.Hostname("company", xmatters.EnvironmentNonProduction, xmatters.RegionDefault)
+//	// hostname is "company-np.xmatters.com"
+func Hostname(instance string, env Environment, region Region) (string, error) {
+	instance = strings.ToLower(strings.TrimSpace(instance))
+	if !instanceNamePattern.MatchString(instance) {
+		return "", newValidationError(fmt.Sprintf("invalid instance name %q, expected letters, digits and hyphens", instance))
+	}
+	if region == "" {
+		region = RegionDefault
+	}
+	switch env {
+	case "", EnvironmentProduction:
+		if strings.HasSuffix(instance, nonProductionSuffix) {
+			return "", newValidationError(fmt.Sprintf("instance name %q is a non-production instance; use its name without %q", instance, nonProductionSuffix))
+		}
+	case EnvironmentNonProduction:
+		if !strings.HasSuffix(instance, nonProductionSuffix) {
+			instance += nonProductionSuffix
+		}
+	default:
+		return "", newValidationError(fmt.Sprintf("unknown environment %q, expected %q or %q", env, EnvironmentProduction, EnvironmentNonProduction))
+	}
+	hostname := instance + "." + strings.ToLower(string(region))
+	if err := ValidateHostname(hostname, region); err != nil {
+		return "", err
+	}
+	return hostname, nil
+}
+
+// NewInstanceWithToken creates a new instance of XMattersAPI for the named instance, environment and region,
+// authenticating with an API token. See Hostname for how the hostname is built.
+func NewInstanceWithToken(instance string, env Environment, region Region, token string, opts ...Option) (*XMattersAPI, error) {
+	hostname, err := Hostname(instance, env, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithToken(&hostname, &token, opts...)
+}
+
+// NewInstanceWithBasicAuth creates a new instance of XMattersAPI for the named instance, environment and region,
+// authenticating with a username and password. See Hostname for how the hostname is built.
+func NewInstanceWithBasicAuth(instance string, env Environment, region Region, username, password string, opts ...Option) (*XMattersAPI, error) {
+	hostname, err := Hostname(instance, env, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithBasicAuth(&hostname, &username, &password, opts...)
+}
+
+// ValidateHostname checks that a hostname looks like the hostname of an xMatters instance: an instance name
+// followed by a region, without a scheme, port or path. It catches common misconfigurations, such as a URL
+// copied from a browser or a hostname of the wrong domain, before any request is made.
+// RegionDefault and RegionHosted are always accepted; instances in other domains can be validated by passing
+// their domain in regions.
+func ValidateHostname(hostname string, regions ...Region) error {
+	_, err := ParseHostname(hostname, regions...)
+	return err
+}
+
+// ParseHostname splits the hostname of an xMatters instance into its instance name, environment and region.
+// It returns a validation error when the hostname does not look like the hostname of an xMatters instance.
+// As with ValidateHostname, regions lists the domains accepted besides RegionDefault and RegionHosted.
+func ParseHostname(hostname string, regions ...Region) (InstanceHostname, error) {
+	switch {
+	case hostname == "":
+		return InstanceHostname{}, ErrNoHostname
+	case strings.Contains(hostname, "://"):
+		return InstanceHostname{}, newValidationError(fmt.Sprintf("hostname %q must not include a scheme", hostname))
+	case strings.ContainsAny(hostname, "/?#"):
+		return InstanceHostname{}, newValidationError(fmt.Sprintf("hostname %q must not include a path", hostname))
+	case strings.Contains(hostname, ":"):
+		return InstanceHostname{}, newValidationError(fmt.Sprintf("hostname %q must not include a port", hostname))
+	}
+
+	// Match the longest region, so an instance of hosted.xmatters.com is not read as one of xmatters.com
+	lower := strings.ToLower(hostname)
+	var region Region
+	for _, candidate := range append(append([]Region(nil), defaultRegions...), regions...) {
+		if strings.HasSuffix(lower, "."+strings.ToLower(string(candidate))) && len(candidate) > len(region) {
+			region = Region(strings.ToLower(string(candidate)))
+		}
+	}
+	if region == "" {
+		return InstanceHostname{}, newValidationError(fmt.Sprintf("hostname %q is not an xMatters domain, expected a hostname such as company.%s", hostname, RegionDefault))
+	}
+	instance := strings.TrimSuffix(lower, "."+string(region))
+	if !instanceNamePattern.MatchString(instance) {
+		return InstanceHostname{}, newValidationError(fmt.Sprintf("hostname %q does not start with a valid instance name", hostname))
+	}
+
+	parsed := InstanceHostname{Instance: instance, Environment: EnvironmentProduction, Region: region}
+	if name := strings.TrimSuffix(instance, nonProductionSuffix); name != instance && name != "" {
+		parsed.Instance, parsed.Environment = name, EnvironmentNonProduction
+	}
+	return parsed, nil
+}
+
+// String returns the hostname.
+func (h InstanceHostname) String() string {
+	hostname, err := Hostname(h.Instance, h.Environment, h.Region)
+	if err != nil {
+		return ""
+	}
+	return hostname
+}
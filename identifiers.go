@@ -0,0 +1,76 @@
+package xmatters
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Identifier Structs
+// -------------------------------------------------------------------------------------------------
+
+// IdentifierKind describes which kind of identifier was passed to a method that accepts either an ID or a name.
+type IdentifierKind string
+
+const (
+	// IdentifierUUID is the UUID of a resource.
+	IdentifierUUID IdentifierKind = "UUID"
+	// IdentifierName is the target name, or name, of a resource.
+	IdentifierName IdentifierKind = "NAME"
+	// IdentifierInvalid is an empty identifier, or one that resembles a UUID but is malformed.
+	IdentifierInvalid IdentifierKind = "INVALID"
+)
+
+// malformedUUIDPattern matches values made of hexadecimal digits and hyphens in the rough shape of a UUID,
+// which are almost certainly mistyped IDs rather than target names.
+var malformedUUIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F-]{20,}$`)
+
+// -------------------------------------------------------------------------------------------------
+// Identifier Methods
+// -------------------------------------------------------------------------------------------------
+
+// IsUUID reports whether an identifier is a UUID rather than a target name.
+func IsUUID(value string) bool {
+	return uuidPattern.MatchString(value)
+}
+
+// DetectIdentifier reports whether an identifier is a UUID, a name, or invalid.
+// Endpoints such as /people/{personId} accept either a UUID or a target name in the path.
+func DetectIdentifier(value string) IdentifierKind {
+	switch {
+	case IsUUID(value):
+		return IdentifierUUID
+	case value == "" || malformedUUIDPattern.MatchString(value):
+		return IdentifierInvalid
+	default:
+		return IdentifierName
+	}
+}
+
+// ValidateUUID returns a validation error if value is not a UUID.
+// The name describes the value in the error message, such as "service dependency ID".
+func ValidateUUID(name, value string) error {
+	if !IsUUID(value) {
+		return newValidationError(fmt.Sprintf("invalid %s %q: must be a UUID", name, value))
+	}
+	return nil
+}
+
+// validateIdentifier returns a validation error for identifiers that cannot identify a resource.
+// Without it an empty identifier would request the list endpoint instead of a single resource.
+func validateIdentifier(name, value string) error {
+	if DetectIdentifier(value) != IdentifierInvalid {
+		return nil
+	}
+	if value == "" {
+		return newValidationError(fmt.Sprintf("a %s is required", name))
+	}
+	return newValidationError(fmt.Sprintf("invalid %s %q: resembles a UUID but is malformed", name, value))
+}
+
+// pathSegment escapes an identifier for use as a single segment of a request path,
+// so target names containing spaces, slashes, or other special characters are sent intact.
+func pathSegment(value string) string {
+	return url.PathEscape(value)
+}
@@ -0,0 +1,222 @@
+package xmatters
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Import Structs
+// -------------------------------------------------------------------------------------------------
+
+// ImportType identifies a type of resource that can be fetched with ImportResource.
+type ImportType string
+
+// Resource types supported by ImportResource.
+const (
+	ImportSite              ImportType = "site"
+	ImportPerson            ImportType = "person"
+	ImportDevice            ImportType = "device"
+	ImportGroup             ImportType = "group"
+	ImportGroupMember       ImportType = "group_member"
+	ImportShift             ImportType = "shift"
+	ImportService           ImportType = "service"
+	ImportServiceDependency ImportType = "service_dependency"
+)
+
+// ImportedResource is the normalized state of a resource fetched for import, such as by a Terraform provider.
+type ImportedResource struct {
+	Type ImportType
+	// ID is the canonical import ID of the resource. It is made of UUIDs even when the resource was imported
+	// by name, and memberships, shifts, and dependencies use composite IDs such as "groupId/memberId".
+	ID string
+	// Object is a pointer to the fetched resource, such as a *Person, *GroupMember, or *ServiceDependency.
+	Object interface{}
+}
+
+// -------------------------------------------------------------------------------------------------
+// Import Methods
+// -------------------------------------------------------------------------------------------------
+
+// FormatImportID joins the parts of a composite import ID with slashes.
+// Parts are escaped, so names containing slashes survive ParseImportID.
+func FormatImportID(parts ...string) string {
+	escaped := make([]string, len(parts))
+	for i, part := range parts {
+		escaped[i] = pathSegment(part)
+	}
+	return strings.Join(escaped, "/")
+}
+
+// ParseImportID splits a composite import ID, such as "groupId/memberId", into the expected number of parts.
+// Each part may be an ID or a name.
+func ParseImportID(id string, parts int) ([]string, error) {
+	values := strings.Split(id, "/")
+	if len(values) != parts {
+		return nil, newValidationError(fmt.Sprintf("invalid import ID %q: expected %d parts separated by /", id, parts))
+	}
+	for i, value := range values {
+		unescaped, err := url.PathUnescape(value)
+		if err != nil || unescaped == "" {
+			return nil, newValidationError(fmt.Sprintf("invalid import ID %q: part %d is empty or malformed", id, i+1))
+		}
+		values[i] = unescaped
+	}
+	return values, nil
+}
+
+// ImportResource fetches the resource of the given type identified by id and returns its normalized state.
+// Single resources are identified by ID or by name: people, groups, and services by target name,
+// sites by name, and devices by target name, such as "jsmith|Work Email". Composite resources are
+// identified by the IDs or names of their parts:
+//
+//	group_member        group/member
+//	shift               group/shift
+//	service_dependency  service/dependentService, or the ID of the dependency
+func (xmatters *XMattersAPI) ImportResource(resourceType ImportType, id string) (*ImportedResource, error) {
+	switch resourceType {
+	case ImportSite:
+		siteId, err := NewNameResolver(xmatters, 0).ID(ResolveSites, id)
+		if err != nil {
+			return nil, err
+		}
+		site, err := xmatters.GetSite(siteId)
+		if err != nil {
+			return nil, err
+		}
+		return &ImportedResource{Type: resourceType, ID: stringValue(site.ID), Object: &site}, nil
+
+	case ImportPerson:
+		person, err := xmatters.GetPerson(id)
+		if err != nil {
+			return nil, err
+		}
+		return &ImportedResource{Type: resourceType, ID: stringValue(person.ID), Object: &person}, nil
+
+	case ImportDevice:
+		device, err := xmatters.GetDevice(id)
+		if err != nil {
+			return nil, err
+		}
+		return &ImportedResource{Type: resourceType, ID: stringValue(device.ID), Object: &device}, nil
+
+	case ImportGroup:
+		group, err := xmatters.GetGroup(id)
+		if err != nil {
+			return nil, err
+		}
+		return &ImportedResource{Type: resourceType, ID: stringValue(group.ID), Object: &group}, nil
+
+	case ImportService:
+		service, err := xmatters.GetService(id)
+		if err != nil {
+			return nil, err
+		}
+		return &ImportedResource{Type: resourceType, ID: stringValue(service.ID), Object: &service}, nil
+
+	case ImportGroupMember:
+		return xmatters.importGroupMember(id)
+	case ImportShift:
+		return xmatters.importShift(id)
+	case ImportServiceDependency:
+		return xmatters.importServiceDependency(id)
+	}
+	return nil, newValidationError(fmt.Sprintf("unsupported import type %q", resourceType))
+}
+
+// importGroupMember fetches a group membership identified by "group/member".
+func (xmatters *XMattersAPI) importGroupMember(id string) (*ImportedResource, error) {
+	parts, err := ParseImportID(id, 2)
+	if err != nil {
+		return nil, err
+	}
+	group, err := xmatters.GetGroup(parts[0], WithEmbed())
+	if err != nil {
+		return nil, err
+	}
+	groupId := stringValue(group.ID)
+
+	// Memberships are retrieved rather than the roster, as the roster does not keep the target names of members
+	memberships, err := xmatters.GetGroupMemberships(groupId)
+	if err != nil {
+		return nil, err
+	}
+	for _, membership := range memberships {
+		member := membership.Member
+		if stringValue(member.ID) == parts[1] || stringValue(member.TargetName) == parts[1] {
+			return &ImportedResource{
+				Type:   ImportGroupMember,
+				ID:     FormatImportID(groupId, stringValue(member.ID)),
+				Object: &GroupMember{ID: member.ID, MemberType: member.RecipientType},
+			}, nil
+		}
+	}
+	return nil, XMattersError{Code: 404, Message: fmt.Sprintf("Could not find member %s in group %s", parts[1], parts[0]), Reason: "Not Found"}
+}
+
+// importShift fetches a shift identified by "group/shift", where the shift is identified by ID or name.
+func (xmatters *XMattersAPI) importShift(id string) (*ImportedResource, error) {
+	parts, err := ParseImportID(id, 2)
+	if err != nil {
+		return nil, err
+	}
+	group, err := xmatters.GetGroup(parts[0], WithEmbed())
+	if err != nil {
+		return nil, err
+	}
+	groupId := stringValue(group.ID)
+
+	shifts, err := xmatters.GetGroupShifts(groupId)
+	if err != nil {
+		return nil, err
+	}
+	for _, shift := range shifts {
+		if stringValue(shift.ID) == parts[1] || stringValue(shift.Name) == parts[1] {
+			return &ImportedResource{Type: ImportShift, ID: FormatImportID(groupId, stringValue(shift.ID)), Object: shift}, nil
+		}
+	}
+	return nil, XMattersError{Code: 404, Message: fmt.Sprintf("Could not find shift %s in group %s", parts[1], parts[0]), Reason: "Not Found"}
+}
+
+// importServiceDependency fetches a service dependency identified by its ID, or by "service/dependentService".
+func (xmatters *XMattersAPI) importServiceDependency(id string) (*ImportedResource, error) {
+	if IsUUID(id) {
+		dependency, err := xmatters.GetServiceDependency(id)
+		if err != nil {
+			return nil, err
+		}
+		if dependency.Service == nil || dependency.DependentService == nil {
+			return &ImportedResource{Type: ImportServiceDependency, ID: stringValue(dependency.ID), Object: &dependency}, nil
+		}
+		importId := FormatImportID(stringValue(dependency.Service.ID), stringValue(dependency.DependentService.ID))
+		return &ImportedResource{Type: ImportServiceDependency, ID: importId, Object: &dependency}, nil
+	}
+
+	parts, err := ParseImportID(id, 2)
+	if err != nil {
+		return nil, err
+	}
+	serviceIds := make([]string, len(parts))
+	for i, part := range parts {
+		service, err := xmatters.GetService(part, WithEmbed())
+		if err != nil {
+			return nil, err
+		}
+		serviceIds[i] = stringValue(service.ID)
+	}
+
+	dependencies, err := xmatters.GetServiceDependencyList(GetServiceDependenciesParams{Services: serviceIds[0]})
+	if err != nil {
+		return nil, err
+	}
+	for _, dependency := range dependencies {
+		if dependency.Service == nil || dependency.DependentService == nil {
+			continue
+		}
+		if stringValue(dependency.Service.ID) == serviceIds[0] && stringValue(dependency.DependentService.ID) == serviceIds[1] {
+			return &ImportedResource{Type: ImportServiceDependency, ID: FormatImportID(serviceIds...), Object: dependency}, nil
+		}
+	}
+	return nil, XMattersError{Code: 404, Message: fmt.Sprintf("Could not find a dependency between services %s and %s", parts[0], parts[1]), Reason: "Not Found"}
+}
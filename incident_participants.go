@@ -0,0 +1,171 @@
+package xmatters
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Incident Participant Structs
+// -------------------------------------------------------------------------------------------------
+
+// IncidentResolver represents a person working to resolve an incident in xMatters.
+// Role is only set when the resolver has been assigned an incident role, such as commander or scribe.
+type IncidentResolver struct {
+	Person  *PersonReference `json:"person"`
+	Role    *IncidentRole    `json:"role,omitempty"`
+	AddedAt *Timestamp       `json:"addedAt,omitempty"`
+}
+
+// IncidentResolverPagination contains a paginated list of incident resolvers.
+// It extends the Pagination struct containing links to additional pages.
+type IncidentResolverPagination struct {
+	*Pagination
+	Resolvers []*IncidentResolver `json:"data"`
+}
+
+// IncidentRole represents a role that a resolver can hold on an incident.
+type IncidentRole string
+
+const (
+	// IncidentRoleCommander coordinates the response to the incident.
+	IncidentRoleCommander IncidentRole = "COMMANDER"
+	// IncidentRoleScribe records the timeline and decisions made during the incident.
+	IncidentRoleScribe IncidentRole = "SCRIBE"
+)
+
+// IncidentEngagement represents a request to engage additional recipients in an incident.
+type IncidentEngagement struct {
+	RequestID *string `json:"requestId,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// EngageIncidentParams contains available API body parameters for the EngageIncidentRecipients method.
+type EngageIncidentParams struct {
+	// Required Fields
+	Recipients []*EventRecipient `json:"recipients"`
+	// Optional Fields
+	Message  *string       `json:"message,omitempty"`
+	Priority EventPriority `json:"priority,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Incident Participant Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetIncidentResolvers retrieves the resolvers of an incident in xMatters.
+// It requires the incidentId parameter to identify the specific incident, and returns a slice of IncidentResolver objects.
+func (xmatters *XMattersAPI) GetIncidentResolvers(incidentId string) ([]*IncidentResolver, error) {
+	uri := buildURI(fmt.Sprintf("/incidents/%s/resolvers", pathSegment(incidentId)), nil)
+
+	// Use the GetIncidentResolverPaginationSet method to get all paginated results
+	resolverList, err := xmatters.GetIncidentResolverPaginationSet(uri)
+	if err != nil {
+		return resolverList, err
+	}
+
+	// Return the full list of Incident Resolvers.
+	return resolverList, nil
+}
+
+// AddIncidentResolver adds a person as a resolver of an incident in xMatters.
+// It requires the incidentId and personId parameters, and returns the added IncidentResolver object.
+func (xmatters *XMattersAPI) AddIncidentResolver(incidentId, personId string) (IncidentResolver, error) {
+	uri := buildURI(fmt.Sprintf("/incidents/%s/resolvers", pathSegment(incidentId)), nil)
+	body := struct {
+		Person ReferenceById `json:"person"`
+	}{Person: ReferenceById{ID: &personId}}
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, body)
+	if err != nil {
+		return IncidentResolver{}, err
+	}
+
+	// Unmarshal the response into an IncidentResolver struct.
+	var result IncidentResolver
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return IncidentResolver{}, newUnmarshalError()
+	}
+
+	// Return the added IncidentResolver object.
+	return result, nil
+}
+
+// RemoveIncidentResolver removes a person from the resolvers of an incident in xMatters.
+// It requires the incidentId and personId parameters.
+func (xmatters *XMattersAPI) RemoveIncidentResolver(incidentId, personId string) error {
+	uri := buildURI(fmt.Sprintf("/incidents/%s/resolvers/%s", pathSegment(incidentId), pathSegment(personId)), nil)
+
+	// Perform the API request.
+	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
+	if err != nil {
+		return err
+	}
+
+	// Return
+	return nil
+}
+
+// AssignIncidentRole assigns an incident role, such as commander or scribe, to a resolver of an incident in xMatters.
+// The person is added as a resolver if they are not one already. It returns the modified IncidentResolver object.
+func (xmatters *XMattersAPI) AssignIncidentRole(incidentId, personId string, role IncidentRole) (IncidentResolver, error) {
+	uri := buildURI(fmt.Sprintf("/incidents/%s/resolvers", pathSegment(incidentId)), nil)
+	body := struct {
+		Person ReferenceById `json:"person"`
+		Role   IncidentRole  `json:"role"`
+	}{Person: ReferenceById{ID: &personId}, Role: role}
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, body)
+	if err != nil {
+		return IncidentResolver{}, err
+	}
+
+	// Unmarshal the response into an IncidentResolver struct.
+	var result IncidentResolver
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return IncidentResolver{}, newUnmarshalError()
+	}
+
+	// Return the modified IncidentResolver object.
+	return result, nil
+}
+
+// EngageIncidentRecipients notifies additional groups or people to join the response to an incident in xMatters.
+// It requires the incidentId parameter and the EngageIncidentParams struct containing the recipients to engage.
+func (xmatters *XMattersAPI) EngageIncidentRecipients(incidentId string, params EngageIncidentParams) (IncidentEngagement, error) {
+	if len(params.Recipients) == 0 {
+		return IncidentEngagement{}, newValidationError("at least one recipient is required to engage an incident")
+	}
+
+	uri := buildURI(fmt.Sprintf("/incidents/%s/engagements", pathSegment(incidentId)), nil)
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
+	if err != nil {
+		return IncidentEngagement{}, err
+	}
+
+	// Unmarshal the response into an IncidentEngagement struct.
+	var result IncidentEngagement
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return IncidentEngagement{}, newUnmarshalError()
+	}
+
+	// Return the IncidentEngagement object.
+	return result, nil
+}
+
+// GetIncidentResolverPaginationSet retrieves a paginated list of incident resolvers.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetIncidentResolverPaginationSet(uri string) ([]*IncidentResolver, error) {
+	return getPaginationSet[IncidentResolver](xmatters, uri)
+}
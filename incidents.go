@@ -0,0 +1,342 @@
+package xmatters
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Incident Structs
+// -------------------------------------------------------------------------------------------------
+
+// Incident represents an incident in xMatters.
+type Incident struct {
+	ID                 *string             `json:"id"`
+	IncidentIdentifier *string             `json:"incidentIdentifier,omitempty"`
+	Summary            *string             `json:"summary,omitempty"`
+	Description        *string             `json:"description,omitempty"`
+	Severity           *IncidentSeverity   `json:"severity,omitempty"`
+	Status             *IncidentStatus     `json:"status,omitempty"`
+	Created            *Timestamp          `json:"created,omitempty"`
+	Updated            *Timestamp          `json:"updated,omitempty"`
+	ImpactedServices   []*ServiceReference `json:"impactedServices,omitempty"`
+}
+
+// IncidentSeverity represents the severity of an incident in xMatters.
+type IncidentSeverity string
+
+const (
+	// IncidentSeverityCritical is the highest incident severity.
+	IncidentSeverityCritical IncidentSeverity = "CRITICAL"
+	// IncidentSeverityMajor indicates a significant impact to services.
+	IncidentSeverityMajor IncidentSeverity = "MAJOR"
+	// IncidentSeverityModerate indicates a partial impact to services.
+	IncidentSeverityModerate IncidentSeverity = "MODERATE"
+	// IncidentSeverityMinor indicates a limited impact to services.
+	IncidentSeverityMinor IncidentSeverity = "MINOR"
+	// IncidentSeverityMinimal is the lowest incident severity.
+	IncidentSeverityMinimal IncidentSeverity = "MINIMAL"
+)
+
+// IsValid reports whether the IncidentSeverity is one of the severities supported by xMatters.
+func (s IncidentSeverity) IsValid() bool {
+	switch s {
+	case IncidentSeverityCritical, IncidentSeverityMajor, IncidentSeverityModerate, IncidentSeverityMinor, IncidentSeverityMinimal:
+		return true
+	}
+	return false
+}
+
+// IncidentStatus represents the status of an incident in xMatters.
+type IncidentStatus string
+
+const (
+	// IncidentStatusOpen indicates the incident has been declared but work has not started.
+	IncidentStatusOpen IncidentStatus = "OPEN"
+	// IncidentStatusInProgress indicates resolvers are working on the incident.
+	IncidentStatusInProgress IncidentStatus = "IN_PROGRESS"
+	// IncidentStatusResolved indicates the incident has been resolved.
+	IncidentStatusResolved IncidentStatus = "RESOLVED"
+)
+
+// incidentStatusTransitions lists the statuses each incident status can legally move to.
+// A resolved incident can only be reopened.
+var incidentStatusTransitions = map[IncidentStatus][]IncidentStatus{
+	IncidentStatusOpen:       {IncidentStatusInProgress, IncidentStatusResolved},
+	IncidentStatusInProgress: {IncidentStatusOpen, IncidentStatusResolved},
+	IncidentStatusResolved:   {IncidentStatusOpen},
+}
+
+// IsValid reports whether the IncidentStatus is one of the statuses supported by xMatters.
+func (s IncidentStatus) IsValid() bool {
+	_, ok := incidentStatusTransitions[s]
+	return ok
+}
+
+// CanTransitionTo reports whether an incident can move from this status to the next status.
+func (s IncidentStatus) CanTransitionTo(next IncidentStatus) bool {
+	for _, allowed := range incidentStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateIncidentStatusTransition checks that an incident can move from one status to another.
+// It returns a descriptive error for illegal transitions, such as resolving an incident that is already resolved.
+func ValidateIncidentStatusTransition(from, to IncidentStatus) error {
+	if !to.IsValid() {
+		return newValidationError(fmt.Sprintf("invalid incident status %q", to))
+	}
+	if from == to {
+		return newValidationError(fmt.Sprintf("incident is already %s", to))
+	}
+	if !from.CanTransitionTo(to) {
+		return newValidationError(fmt.Sprintf("incident status cannot change from %s to %s", from, to))
+	}
+	return nil
+}
+
+// IncidentPagination contains a paginated list of incidents.
+// It extends the Pagination struct containing links to additional pages.
+type IncidentPagination struct {
+	*Pagination
+	Incidents []*Incident `json:"data"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// GetIncidentsParams contains available API query parameters for the GetIncidentList method.
+type GetIncidentsParams struct {
+	Search           string     `url:"search,omitempty"`
+	Status           string     `url:"status,omitempty"`
+	Severity         string     `url:"severity,omitempty"`
+	ImpactedServices string     `url:"impactedServices,omitempty"`
+	From             *Timestamp `url:"from,omitempty"`
+	To               *Timestamp `url:"to,omitempty"`
+	SortBy           string     `url:"sortBy,omitempty"`
+	SortOrder        string     `url:"sortOrder,omitempty"`
+}
+
+// CreateIncidentParams contains available API body parameters for the CreateIncident method.
+type CreateIncidentParams struct {
+	// Required Fields
+	Summary string `json:"summary"`
+	// Optional Fields
+	Description      *string           `json:"description,omitempty"`
+	Severity         *IncidentSeverity `json:"severity,omitempty"`
+	Status           *IncidentStatus   `json:"status,omitempty"`
+	RequestID        *string           `json:"requestId,omitempty"`
+	ImpactedServices []*ReferenceById  `json:"impactedServices,omitempty"`
+}
+
+// UpdateIncidentParams contains available API body parameters for the UpdateIncident method.
+// Only non-nil fields are sent, so fields that are not set remain unchanged in xMatters.
+type UpdateIncidentParams struct {
+	Summary     *string           `json:"summary,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Severity    *IncidentSeverity `json:"severity,omitempty"`
+	Status      *IncidentStatus   `json:"status,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Incident Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetIncident retrieves an incident in xMatters.
+// It requires the incidentId parameter, which may be the incident's UUID or its incident identifier, and returns an Incident object.
+func (xmatters *XMattersAPI) GetIncident(incidentId string) (Incident, error) {
+	if err := validateIdentifier("incident ID", incidentId); err != nil {
+		return Incident{}, err
+	}
+
+	uri := buildURI(fmt.Sprintf("/incidents/%s", pathSegment(incidentId)), nil)
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
+	if err != nil {
+		return Incident{}, err
+	}
+
+	// Unmarshal the response into an Incident struct.
+	var result Incident
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Incident{}, newUnmarshalError()
+	}
+
+	// Return the returned Incident object.
+	return result, nil
+}
+
+// GetIncidentList retrieves a list of incidents in xMatters.
+// It accepts optional query parameters to filter the results and returns a slice of Incident objects.
+func (xmatters *XMattersAPI) GetIncidentList(params GetIncidentsParams) ([]*Incident, error) {
+	uri := buildURI("/incidents", params) // The URI including any Query Parameters
+
+	// Use the GetIncidentPaginationSet method to get all paginated results
+	incidentList, err := xmatters.GetIncidentPaginationSet(uri)
+	if err != nil {
+		return incidentList, err
+	}
+
+	// Return the full list of Incidents.
+	return incidentList, nil
+}
+
+// CreateIncident creates a new incident in xMatters.
+// It requires the CreateIncidentParams struct containing the incident summary and any optional details,
+// and returns the created Incident object.
+func (xmatters *XMattersAPI) CreateIncident(params CreateIncidentParams) (Incident, error) {
+	if params.Summary == "" {
+		return Incident{}, newValidationError("a summary is required to create an incident")
+	}
+	if params.Severity != nil && !params.Severity.IsValid() {
+		return Incident{}, newValidationError(fmt.Sprintf("invalid incident severity %q", *params.Severity))
+	}
+	if params.Status != nil && !params.Status.IsValid() {
+		return Incident{}, newValidationError(fmt.Sprintf("invalid incident status %q", *params.Status))
+	}
+
+	uri := buildURI("/incidents", nil) // The URI for creating an Incident in xMatters
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
+	if err != nil {
+		return Incident{}, err
+	}
+
+	// Unmarshal the response into an Incident struct.
+	var result Incident
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Incident{}, newUnmarshalError()
+	}
+
+	// Return the created Incident object.
+	return result, nil
+}
+
+// UpdateIncident modifies an existing incident in xMatters.
+// It requires the incidentId parameter to identify the specific incident and the UpdateIncidentParams struct
+// containing the fields to change. It returns the modified Incident object.
+// When the status is changed, the current incident is retrieved first so illegal status transitions
+// are rejected with a descriptive error before the update is sent. The transition is not checked when the
+// current status is missing or not one of the known statuses, and xMatters validates the change instead.
+func (xmatters *XMattersAPI) UpdateIncident(incidentId string, params UpdateIncidentParams) (Incident, error) {
+	if params.Severity != nil && !params.Severity.IsValid() {
+		return Incident{}, newValidationError(fmt.Sprintf("invalid incident severity %q", *params.Severity))
+	}
+	if params.Status != nil {
+		if !params.Status.IsValid() {
+			return Incident{}, newValidationError(fmt.Sprintf("invalid incident status %q", *params.Status))
+		}
+		current, err := xmatters.GetIncident(incidentId)
+		if err != nil {
+			return Incident{}, err
+		}
+		if current.Status != nil && current.Status.IsValid() {
+			if err := ValidateIncidentStatusTransition(*current.Status, *params.Status); err != nil {
+				return Incident{}, err
+			}
+		}
+	}
+
+	uri := buildURI(fmt.Sprintf("/incidents/%s", pathSegment(incidentId)), nil)
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPatch, uri, ContentJSON, params)
+	if err != nil {
+		return Incident{}, err
+	}
+
+	// Unmarshal the response into an Incident struct.
+	var result Incident
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Incident{}, newUnmarshalError()
+	}
+
+	// Return the modified Incident object.
+	return result, nil
+}
+
+// GetServiceIncidents retrieves the incidents in xMatters that impacted a given service.
+// It requires the serviceId parameter to identify the specific service and accepts optional query parameters,
+// such as a From/To date range, to limit the results to recent incidents.
+func (xmatters *XMattersAPI) GetServiceIncidents(serviceId string, params GetIncidentsParams) ([]*Incident, error) {
+	params.ImpactedServices = serviceId
+	return xmatters.GetIncidentList(params)
+}
+
+// GetIncidentImpactedServices retrieves the services impacted by an incident in xMatters.
+// It requires the incidentId parameter to identify the specific incident, and returns a slice of Service objects.
+func (xmatters *XMattersAPI) GetIncidentImpactedServices(incidentId string) ([]*Service, error) {
+	uri := buildURI(fmt.Sprintf("/incidents/%s/impacted-services", pathSegment(incidentId)), nil)
+
+	// Use the GetServicePaginationSet method to get all paginated results
+	serviceList, err := xmatters.GetServicePaginationSet(uri)
+	if err != nil {
+		return serviceList, err
+	}
+
+	// Return the full list of impacted Services.
+	return serviceList, nil
+}
+
+// AddIncidentImpactedServices attaches one or more impacted services to an incident in xMatters.
+// It requires the incidentId parameter and the IDs of the services to attach, and returns the modified Incident object.
+func (xmatters *XMattersAPI) AddIncidentImpactedServices(incidentId string, serviceIds ...string) (Incident, error) {
+	if len(serviceIds) == 0 {
+		return Incident{}, newValidationError("at least one service ID is required")
+	}
+
+	uri := buildURI(fmt.Sprintf("/incidents/%s/impacted-services", pathSegment(incidentId)), nil)
+	body := struct {
+		Services []*ReferenceById `json:"services"`
+	}{}
+	for _, serviceId := range serviceIds {
+		body.Services = append(body.Services, NewReferenceById(serviceId))
+	}
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, body)
+	if err != nil {
+		return Incident{}, err
+	}
+
+	// Unmarshal the response into an Incident struct.
+	var result Incident
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Incident{}, newUnmarshalError()
+	}
+
+	// Return the modified Incident object.
+	return result, nil
+}
+
+// RemoveIncidentImpactedService detaches an impacted service from an incident in xMatters.
+// It requires the incidentId and serviceId parameters.
+func (xmatters *XMattersAPI) RemoveIncidentImpactedService(incidentId, serviceId string) error {
+	uri := buildURI(fmt.Sprintf("/incidents/%s/impacted-services/%s", pathSegment(incidentId), pathSegment(serviceId)), nil)
+
+	// Perform the API request.
+	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
+	if err != nil {
+		return err
+	}
+
+	// Return
+	return nil
+}
+
+// GetIncidentPaginationSet retrieves a paginated list of incidents.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetIncidentPaginationSet(uri string) ([]*Incident, error) {
+	return getPaginationSet[Incident](xmatters, uri)
+}
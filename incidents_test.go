@@ -0,0 +1,95 @@
+package xmatters
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestValidateIncidentStatusTransition(t *testing.T) {
+	tests := []struct {
+		from, to IncidentStatus
+		legal    bool
+	}{
+		{IncidentStatusOpen, IncidentStatusInProgress, true},
+		{IncidentStatusOpen, IncidentStatusResolved, true},
+		{IncidentStatusInProgress, IncidentStatusOpen, true},
+		{IncidentStatusInProgress, IncidentStatusResolved, true},
+		{IncidentStatusResolved, IncidentStatusOpen, true},
+		{IncidentStatusResolved, IncidentStatusInProgress, false},
+		{IncidentStatusOpen, IncidentStatusOpen, false},
+		{IncidentStatusInProgress, IncidentStatusInProgress, false},
+		{IncidentStatusResolved, IncidentStatusResolved, false},
+		{IncidentStatusOpen, "CLOSED", false},
+		{"CLOSED", IncidentStatusOpen, false},
+		{IncidentStatusOpen, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s to %s", tt.from, tt.to), func(t *testing.T) {
+			err := ValidateIncidentStatusTransition(tt.from, tt.to)
+			if tt.legal && err != nil {
+				t.Errorf("got %v, want a legal transition", err)
+			}
+			if !tt.legal {
+				var xmerr XMattersError
+				if !errors.As(err, &xmerr) || xmerr.Reason != "Bad Request" {
+					t.Errorf("got %v, want a validation error", err)
+				}
+			}
+		})
+	}
+}
+
+func TestIncidentSeverityIsValid(t *testing.T) {
+	tests := []struct {
+		severity IncidentSeverity
+		valid    bool
+	}{
+		{IncidentSeverityCritical, true},
+		{IncidentSeverityMinimal, true},
+		{"critical", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := tt.severity.IsValid(); got != tt.valid {
+			t.Errorf("IncidentSeverity(%q).IsValid() = %v, want %v", tt.severity, got, tt.valid)
+		}
+	}
+}
+
+func TestUpdateIncidentStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   string
+		next      IncidentStatus
+		wantPatch bool
+	}{
+		{name: "legal transition", current: `"OPEN"`, next: IncidentStatusResolved, wantPatch: true},
+		{name: "illegal transition", current: `"RESOLVED"`, next: IncidentStatusInProgress, wantPatch: false},
+		{name: "unchanged status", current: `"OPEN"`, next: IncidentStatusOpen, wantPatch: false},
+		{name: "unknown current status", current: `"ACKNOWLEDGED"`, next: IncidentStatusInProgress, wantPatch: true},
+		{name: "missing current status", current: `null`, next: IncidentStatusResolved, wantPatch: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patched := false
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPatch {
+					patched = true
+				}
+				fmt.Fprintf(w, `{"id":"INC-1","status":%s}`, tt.current)
+			})
+			_, err := client.UpdateIncident("INC-1", UpdateIncidentParams{Status: &tt.next})
+			if tt.wantPatch && err != nil {
+				t.Fatalf("got %v, want the update to be sent", err)
+			}
+			if !tt.wantPatch && err == nil {
+				t.Fatal("got no error, want the transition to be rejected")
+			}
+			if patched != tt.wantPatch {
+				t.Errorf("sent the update = %v, want %v", patched, tt.wantPatch)
+			}
+		})
+	}
+}
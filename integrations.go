@@ -0,0 +1,239 @@
+package xmatters
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Integration Structs
+// -------------------------------------------------------------------------------------------------
+
+// Integration represents a workflow integration, such as an inbound or outbound webhook, in xMatters.
+type Integration struct {
+	ID                   *string        `json:"id"`
+	Name                 *string        `json:"name"`
+	Plan                 *PlanReference `json:"plan,omitempty"`
+	IntegrationType      *string        `json:"integrationType,omitempty"`
+	Operation            *string        `json:"operation,omitempty"`
+	Form                 *FormReference `json:"form,omitempty"`
+	Enabled              *bool          `json:"enabled,omitempty"`
+	Deployed             *bool          `json:"deployed,omitempty"`
+	AuthenticationMethod *string        `json:"authenticationMethod,omitempty"`
+	Script               *string        `json:"script,omitempty"`
+	Created              *Timestamp     `json:"created,omitempty"`
+}
+
+// IntegrationReference is a reference to an integration, such as the integration that triggered an event.
+type IntegrationReference struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// IntegrationPagination contains a paginated list of integrations.
+// It extends the Pagination struct containing links to additional pages.
+type IntegrationPagination struct {
+	*Pagination
+	Integrations []*Integration `json:"data"`
+}
+
+// IntegrationLog represents a single execution log of a workflow integration in xMatters.
+type IntegrationLog struct {
+	ID        *string    `json:"id"`
+	RequestID *string    `json:"requestId,omitempty"`
+	Status    *string    `json:"status,omitempty"`
+	Created   *Timestamp `json:"created,omitempty"`
+	Completed *Timestamp `json:"completed,omitempty"`
+	Request   *string    `json:"request,omitempty"`
+	Log       *string    `json:"log,omitempty"`
+}
+
+// IntegrationLogPagination contains a paginated list of integration logs.
+// It extends the Pagination struct containing links to additional pages.
+type IntegrationLogPagination struct {
+	*Pagination
+	Logs []*IntegrationLog `json:"data"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// PushIntegrationParams contains available API body parameters for the PushIntegration method.
+type PushIntegrationParams struct {
+	// Required Fields
+	PlanID          string `json:"-"`
+	Name            string `json:"name"`
+	IntegrationType string `json:"integrationType"`
+	Operation       string `json:"operation"`
+	// Optional Fields
+	ID                   string         `json:"id,omitempty"`
+	Form                 *ReferenceById `json:"form,omitempty"`
+	Enabled              *bool          `json:"enabled,omitempty"`
+	AuthenticationMethod *string        `json:"authenticationMethod,omitempty"`
+	Script               *string        `json:"script,omitempty"`
+}
+
+// GetIntegrationLogsParams contains available API query parameters for the GetIntegrationLogs method.
+type GetIntegrationLogsParams struct {
+	From      *Timestamp `url:"from,omitempty"`
+	To        *Timestamp `url:"to,omitempty"`
+	Status    string     `url:"status,omitempty"`
+	RequestID string     `url:"requestId,omitempty"`
+	SortOrder string     `url:"sortOrder,omitempty"`
+}
+
+// integrationScriptParams is the body of a request that modifies only the script of an integration.
+type integrationScriptParams struct {
+	ID     string `json:"id"`
+	Script string `json:"script"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Integration Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetIntegration retrieves a workflow integration in xMatters.
+// It requires the planId and integrationId parameters to identify the specific integration, and returns an Integration object.
+func (xmatters *XMattersAPI) GetIntegration(planId, integrationId string) (Integration, error) {
+	uri := buildURI(fmt.Sprintf("/plans/%s/integrations/%s", pathSegment(planId), pathSegment(integrationId)), nil)
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
+	if err != nil {
+		return Integration{}, err
+	}
+
+	// Unmarshal the response into an Integration struct.
+	var result Integration
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Integration{}, newUnmarshalError()
+	}
+
+	// Return the returned Integration object.
+	return result, nil
+}
+
+// GetIntegrationList retrieves the integrations of a workflow in xMatters.
+// It requires the planId parameter to identify the specific plan, and returns a slice of Integration objects.
+func (xmatters *XMattersAPI) GetIntegrationList(planId string) ([]*Integration, error) {
+	uri := buildURI(fmt.Sprintf("/plans/%s/integrations", pathSegment(planId)), nil)
+
+	// Use the GetIntegrationPaginationSet method to get all paginated results
+	integrationList, err := xmatters.GetIntegrationPaginationSet(uri)
+	if err != nil {
+		return integrationList, err
+	}
+
+	// Return the full list of Integrations.
+	return integrationList, nil
+}
+
+// GetIntegrationPaginationSet retrieves a paginated list of integrations.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetIntegrationPaginationSet(uri string) ([]*Integration, error) {
+	return getPaginationSet[Integration](xmatters, uri)
+}
+
+// PushIntegration either creates a new integration in a workflow in xMatters or modifies an existing integration.
+// It requires the PushIntegrationParams struct containing the plan ID and integration details.
+// It returns the created or modified Integration object.
+// If the params.ID is provided it updates the existing integration; otherwise, it creates a new one.
+func (xmatters *XMattersAPI) PushIntegration(params PushIntegrationParams) (Integration, error) {
+	uri := buildURI(fmt.Sprintf("/plans/%s/integrations", pathSegment(params.PlanID)), nil)
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
+	if err != nil {
+		return Integration{}, err
+	}
+
+	// Unmarshal the response into an Integration struct.
+	var result Integration
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Integration{}, newUnmarshalError()
+	}
+
+	// Return the returned Integration object.
+	return result, nil
+}
+
+// UpdateIntegrationScript replaces the script of an existing integration in xMatters without modifying any other fields,
+// so integration scripts can be deployed from version control. Only the ID and script of the integration are sent,
+// so concurrent changes to the other fields are kept; an integration name is resolved to its ID first.
+// It returns the modified Integration object.
+func (xmatters *XMattersAPI) UpdateIntegrationScript(planId, integrationId, script string) (Integration, error) {
+	if err := validateIdentifier("plan ID or name", planId); err != nil {
+		return Integration{}, err
+	}
+	if err := validateIdentifier("integration ID or name", integrationId); err != nil {
+		return Integration{}, err
+	}
+	if !IsUUID(integrationId) {
+		current, err := xmatters.GetIntegration(planId, integrationId)
+		if err != nil {
+			return Integration{}, err
+		}
+		integrationId = stringValue(current.ID)
+	}
+
+	uri := buildURI(fmt.Sprintf("/plans/%s/integrations", pathSegment(planId)), nil)
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, integrationScriptParams{ID: integrationId, Script: script})
+	if err != nil {
+		return Integration{}, err
+	}
+
+	// Unmarshal the response into an Integration struct.
+	var result Integration
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Integration{}, newUnmarshalError()
+	}
+
+	// Return the modified Integration object.
+	return result, nil
+}
+
+// DeleteIntegration deletes an integration from a workflow in xMatters.
+// It requires the planId and integrationId parameters to identify the specific integration to be deleted.
+// It returns an error if the deletion fails.
+func (xmatters *XMattersAPI) DeleteIntegration(planId, integrationId string) error {
+	uri := buildURI(fmt.Sprintf("/plans/%s/integrations/%s", pathSegment(planId), pathSegment(integrationId)), nil)
+
+	// Perform the API request.
+	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
+	if err != nil {
+		return err
+	}
+
+	// Return
+	return nil
+}
+
+// GetIntegrationLogs retrieves the execution logs of a workflow integration in xMatters.
+// It requires the integrationId parameter to identify the specific integration and accepts optional query parameters,
+// such as a From/To date range, to limit the results. It returns a slice of IntegrationLog objects from all pages.
+func (xmatters *XMattersAPI) GetIntegrationLogs(integrationId string, params GetIntegrationLogsParams) ([]*IntegrationLog, error) {
+	uri := buildURI(fmt.Sprintf("/integrations/%s/logs", pathSegment(integrationId)), params)
+
+	// Use the GetIntegrationLogPaginationSet method to get all paginated results
+	logList, err := xmatters.GetIntegrationLogPaginationSet(uri)
+	if err != nil {
+		return logList, err
+	}
+
+	// Return the full list of Integration Logs.
+	return logList, nil
+}
+
+// GetIntegrationLogPaginationSet retrieves a paginated list of integration logs.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetIntegrationLogPaginationSet(uri string) ([]*IntegrationLog, error) {
+	return getPaginationSet[IntegrationLog](xmatters, uri)
+}
@@ -0,0 +1,614 @@
+// Command modelgen generates Equal, Copy, and accessor methods for the model structs of a package.
+//
+// Equal compares two values field by field, dereferencing pointers, and ignores server-managed pagination
+// links. Copy returns a deep copy in which no pointer, slice, or map is shared with the original.
+// Accessors, such as GetFirstName, return the value of a field or its zero value when the field or the
+// receiver is nil. Structs with unexported, function, or error fields are not models and are skipped.
+//
+// Usage:
+//
+//	//go:generate go run ./internal/modelgen -dir . -out zz_generated_models.go -accessors zz_generated_accessors.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// importPaths maps the package names used in model field types to their import paths.
+var importPaths = map[string]string{
+	"json": "encoding/json",
+	"time": "time",
+}
+
+// generator holds the parsed package and the generated source.
+type generator struct {
+	fset    *token.FileSet
+	structs map[string]*ast.StructType
+	named   map[string]ast.Expr
+	models  map[string]bool
+	equals  map[string]bool
+	buf     bytes.Buffer
+	imports map[string]bool
+}
+
+func main() {
+	dir := flag.String("dir", ".", "package directory to generate methods for")
+	out := flag.String("out", "zz_generated_models.go", "output file name, relative to dir")
+	accessors := flag.String("accessors", "", "accessor output file name, relative to dir; accessors are not generated when empty")
+	flag.Parse()
+
+	g := &generator{
+		fset:    token.NewFileSet(),
+		structs: make(map[string]*ast.StructType),
+		named:   make(map[string]ast.Expr),
+		models:  make(map[string]bool),
+		equals:  make(map[string]bool),
+		imports: make(map[string]bool),
+	}
+	pkgName, err := g.parse(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	source, err := g.generate(pkgName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *out), source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+
+	if *accessors == "" {
+		return
+	}
+	source, err = g.generateAccessors(pkgName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *accessors), source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse collects the exported struct types of the package in dir, skipping generated files and tests.
+func (g *generator) parse(dir string) (string, error) {
+	filter := func(info os.FileInfo) bool {
+		return !strings.HasPrefix(info.Name(), "zz_generated") && !strings.HasSuffix(info.Name(), "_test.go")
+	}
+	pkgs, err := parser.ParseDir(g.fset, dir, filter, 0)
+	if err != nil {
+		return "", err
+	}
+	if len(pkgs) != 1 {
+		return "", fmt.Errorf("expected a single package in %s, found %d", dir, len(pkgs))
+	}
+
+	var pkgName string
+	for name, pkg := range pkgs {
+		pkgName = name
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if funcDecl, ok := decl.(*ast.FuncDecl); ok {
+					g.collectEqual(funcDecl)
+					continue
+				}
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || genDecl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					if structType, ok := typeSpec.Type.(*ast.StructType); ok && typeSpec.Name.IsExported() {
+						g.structs[typeSpec.Name.Name] = structType
+					} else {
+						g.named[typeSpec.Name.Name] = typeSpec.Type
+					}
+				}
+			}
+		}
+	}
+
+	// Only structs made entirely of exported data fields are treated as models
+	for name, structType := range g.structs {
+		if g.isModel(structType) {
+			g.models[name] = true
+		}
+	}
+	return pkgName, nil
+}
+
+// collectEqual records the non-model types that declare their own value Equal method, such as Timestamp.
+func (g *generator) collectEqual(funcDecl *ast.FuncDecl) {
+	if funcDecl.Recv == nil || funcDecl.Name.Name != "Equal" || len(funcDecl.Recv.List) != 1 {
+		return
+	}
+	if ident, ok := funcDecl.Recv.List[0].Type.(*ast.Ident); ok {
+		g.equals[ident.Name] = true
+	}
+}
+
+// isModel reports whether a struct contains only exported data fields.
+// Structs embedding a type from another package, such as time.Time, wrap that type and are not models.
+func (g *generator) isModel(structType *ast.StructType) bool {
+	for _, field := range structType.Fields.List {
+		if _, ok := field.Type.(*ast.SelectorExpr); ok && len(field.Names) == 0 {
+			return false
+		}
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				return false
+			}
+		}
+		if !g.isData(field.Type) {
+			return false
+		}
+	}
+	return true
+}
+
+// isData reports whether a type holds plain data that can be compared and copied.
+func (g *generator) isData(expr ast.Expr) bool {
+	switch t := expr.(type) {
+	case *ast.FuncType, *ast.ChanType:
+		return false
+	case *ast.Ident:
+		return t.Name != "error"
+	case *ast.StarExpr:
+		return g.isData(t.X)
+	case *ast.ArrayType:
+		return g.isData(t.Elt)
+	case *ast.MapType:
+		return g.isData(t.Key) && g.isData(t.Value)
+	case *ast.SelectorExpr:
+		name := g.typeString(t)
+		return !strings.HasPrefix(name, "sync.")
+	}
+	return true
+}
+
+// generate returns the formatted source of the Equal and Copy methods for every model.
+func (g *generator) generate(pkgName string) ([]byte, error) {
+	for _, name := range g.sortedModels() {
+		g.writeEqual(name, g.structs[name])
+		g.writeCopy(name, g.structs[name])
+	}
+	g.writeHelpers()
+
+	return g.render(pkgName, g.buf.Bytes())
+}
+
+// render returns the formatted source of a generated file with the given body.
+func (g *generator) render(pkgName string, body []byte) ([]byte, error) {
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "// Code generated by modelgen. DO NOT EDIT.\n\npackage %s\n\n", pkgName)
+	if len(g.imports) > 0 {
+		imports := make([]string, 0, len(g.imports))
+		for path := range g.imports {
+			imports = append(imports, path)
+		}
+		sort.Strings(imports)
+		source.WriteString("import (\n")
+		for _, path := range imports {
+			fmt.Fprintf(&source, "\t%q\n", path)
+		}
+		source.WriteString(")\n\n")
+	}
+	source.Write(body)
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w\n%s", err, source.String())
+	}
+	return formatted, nil
+}
+
+// generateAccessors returns the formatted source of the accessor methods for every model.
+func (g *generator) generateAccessors(pkgName string) ([]byte, error) {
+	g.imports = make(map[string]bool)
+	var body bytes.Buffer
+	for _, name := range g.sortedModels() {
+		for _, field := range g.structs[name].Fields.List {
+			for _, fieldName := range field.Names {
+				g.writeAccessor(&body, name, fieldName.Name, field.Type)
+			}
+		}
+	}
+	return g.render(pkgName, body.Bytes())
+}
+
+// writeAccessor writes the accessor method of a single field.
+// Pointers to models are returned as-is so accessors can be chained, and other pointers are dereferenced.
+func (g *generator) writeAccessor(w *bytes.Buffer, name, fieldName string, expr ast.Expr) {
+	resultType := g.typeString(expr)
+	value := "x." + fieldName
+	condition := "x != nil"
+	star, isPointer := expr.(*ast.StarExpr)
+	if isPointer {
+		if ident, ok := star.X.(*ast.Ident); !ok || !g.models[ident.Name] {
+			resultType = g.typeString(star.X)
+			value = "*x." + fieldName
+			condition = fmt.Sprintf("x != nil && x.%s != nil", fieldName)
+		}
+	}
+
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if pkg, ok := selector.X.(*ast.Ident); ok {
+				g.imports[importPaths[pkg.Name]] = true
+			}
+		}
+		return true
+	})
+
+	fmt.Fprintf(w, "// Get%s returns the %s field of x, or its zero value if it or x is nil.\n", fieldName, fieldName)
+	fmt.Fprintf(w, "func (x *%s) Get%s() %s {\n", name, fieldName, resultType)
+	fmt.Fprintf(w, "\tif %s {\n\t\treturn %s\n\t}\n", condition, value)
+	if zero := g.zeroValue(expr, value != "x."+fieldName); zero != "" {
+		fmt.Fprintf(w, "\treturn %s\n}\n\n", zero)
+	} else {
+		fmt.Fprintf(w, "\tvar zero %s\n\treturn zero\n}\n\n", resultType)
+	}
+}
+
+// zeroValue returns the literal zero value of a field type, dereferenced when requested,
+// or an empty string when the type has no simple literal.
+func (g *generator) zeroValue(expr ast.Expr, dereference bool) string {
+	if star, ok := expr.(*ast.StarExpr); ok && dereference {
+		expr = star.X
+	}
+	switch t := expr.(type) {
+	case *ast.StarExpr, *ast.ArrayType, *ast.MapType, *ast.InterfaceType:
+		return "nil"
+	case *ast.IndexExpr:
+		if _, ok := g.genericMap(t); ok {
+			return "nil"
+		}
+		return ""
+	case *ast.SelectorExpr:
+		if g.typeString(t) == "json.RawMessage" {
+			return "nil"
+		}
+		return ""
+	case *ast.Ident:
+		if underlying, ok := g.named[t.Name]; ok {
+			if literal := g.zeroValue(underlying, false); literal != "" && literal != "nil" {
+				return literal
+			}
+			return ""
+		}
+		switch t.Name {
+		case "string":
+			return `""`
+		case "bool":
+			return "false"
+		case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64", "byte", "rune":
+			return "0"
+		}
+	}
+	return ""
+}
+
+// sortedModels returns the names of the models in alphabetical order.
+func (g *generator) sortedModels() []string {
+	names := make([]string, 0, len(g.models))
+	for name := range g.models {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// writeEqual writes the Equal method of a model.
+func (g *generator) writeEqual(name string, structType *ast.StructType) {
+	fmt.Fprintf(&g.buf, "// Equal reports whether x and other hold the same values, ignoring pagination links.\n")
+	fmt.Fprintf(&g.buf, "func (x *%s) Equal(other *%s) bool {\n", name, name)
+	fmt.Fprintf(&g.buf, "\tif x == nil || other == nil {\n\t\treturn x == other\n\t}\n")
+	for _, field := range structType.Fields.List {
+		if g.ignoredInEqual(field) {
+			continue
+		}
+		for _, fieldName := range g.fieldNames(field) {
+			a, b := "x."+fieldName, "other."+fieldName
+			condition := "!" + g.equalExpr(field.Type, a, b)
+			if equal := a + " == " + b; condition == "!"+equal {
+				condition = a + " != " + b
+			}
+			fmt.Fprintf(&g.buf, "\tif %s {\n\t\treturn false\n\t}\n", condition)
+		}
+	}
+	fmt.Fprintf(&g.buf, "\treturn true\n}\n\n")
+}
+
+// writeCopy writes the Copy method of a model.
+func (g *generator) writeCopy(name string, structType *ast.StructType) {
+	fmt.Fprintf(&g.buf, "// Copy returns a deep copy of x, or nil if x is nil.\n")
+	fmt.Fprintf(&g.buf, "func (x *%s) Copy() *%s {\n", name, name)
+	fmt.Fprintf(&g.buf, "\tif x == nil {\n\t\treturn nil\n\t}\n")
+	fmt.Fprintf(&g.buf, "\tcopied := *x\n")
+	for _, field := range structType.Fields.List {
+		if g.isShallow(field.Type) {
+			continue
+		}
+		for _, fieldName := range g.fieldNames(field) {
+			fmt.Fprintf(&g.buf, "\tcopied.%s = %s\n", fieldName, g.copyExpr(field.Type, "x."+fieldName))
+		}
+	}
+	fmt.Fprintf(&g.buf, "\treturn &copied\n}\n\n")
+}
+
+// fieldNames returns the names of a field, using the type name for embedded fields.
+func (g *generator) fieldNames(field *ast.Field) []string {
+	if len(field.Names) == 0 {
+		expr := field.Type
+		if star, ok := expr.(*ast.StarExpr); ok {
+			expr = star.X
+		}
+		return []string{g.typeString(expr)}
+	}
+	names := make([]string, 0, len(field.Names))
+	for _, name := range field.Names {
+		names = append(names, name.Name)
+	}
+	return names
+}
+
+// ignoredInEqual reports whether a field is server-managed pagination data that Equal ignores.
+func (g *generator) ignoredInEqual(field *ast.Field) bool {
+	expr := field.Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	return (len(field.Names) == 0 && ident.Name == "Pagination") || ident.Name == "PaginationLinks"
+}
+
+// isShallow reports whether a value of the type can be copied by assignment.
+func (g *generator) isShallow(expr ast.Expr) bool {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return !g.models[t.Name]
+	case *ast.SelectorExpr:
+		return g.typeString(t) != "json.RawMessage"
+	}
+	return false
+}
+
+// equalExpr returns a boolean expression comparing a and b of the given type.
+func (g *generator) equalExpr(expr ast.Expr, a, b string) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if g.models[t.Name] {
+			return fmt.Sprintf("%s.Equal(&%s)", a, b)
+		}
+		if g.equals[t.Name] {
+			return fmt.Sprintf("%s.Equal(%s)", a, b)
+		}
+		return fmt.Sprintf("%s == %s", a, b)
+	case *ast.SelectorExpr:
+		switch g.typeString(t) {
+		case "time.Time":
+			return fmt.Sprintf("%s.Equal(%s)", a, b)
+		case "json.RawMessage":
+			g.imports["bytes"] = true
+			return fmt.Sprintf("bytes.Equal(%s, %s)", a, b)
+		}
+		return fmt.Sprintf("%s == %s", a, b)
+	case *ast.StarExpr:
+		if ident, ok := t.X.(*ast.Ident); ok && g.models[ident.Name] {
+			return fmt.Sprintf("%s.Equal(%s)", a, b)
+		}
+		if g.isShallow(t.X) && !g.hasEqual(t.X) {
+			return fmt.Sprintf("equalComparablePointer(%s, %s)", a, b)
+		}
+		elem := g.typeString(t.X)
+		return fmt.Sprintf("equalPointer(%s, %s, func(x, y %s) bool { return %s })", a, b, elem, g.equalExpr(t.X, "x", "y"))
+	case *ast.ArrayType:
+		elem := g.typeString(t.Elt)
+		return fmt.Sprintf("equalSlice(%s, %s, func(x, y %s) bool { return %s })", a, b, elem, g.equalExpr(t.Elt, "x", "y"))
+	case *ast.MapType:
+		elem := g.typeString(t.Value)
+		return fmt.Sprintf("equalMap(%s, %s, func(x, y %s) bool { return %s })", a, b, elem, g.equalExpr(t.Value, "x", "y"))
+	case *ast.IndexExpr:
+		if value, ok := g.genericMap(t); ok {
+			elem := g.typeString(value)
+			return fmt.Sprintf("equalMap(%s, %s, func(x, y %s) bool { return %s })", a, b, elem, g.equalExpr(value, "x", "y"))
+		}
+	case *ast.InterfaceType:
+		g.imports["reflect"] = true
+		return fmt.Sprintf("reflect.DeepEqual(%s, %s)", a, b)
+	}
+	log.Fatalf("unsupported field type %s", g.typeString(expr))
+	return ""
+}
+
+// copyExpr returns an expression producing a deep copy of src of the given type.
+func (g *generator) copyExpr(expr ast.Expr, src string) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if g.models[t.Name] {
+			return fmt.Sprintf("*%s.Copy()", src)
+		}
+		return src
+	case *ast.SelectorExpr:
+		if g.typeString(t) == "json.RawMessage" {
+			return fmt.Sprintf("copySlice(%s, func(x byte) byte { return x })", src)
+		}
+		return src
+	case *ast.StarExpr:
+		if ident, ok := t.X.(*ast.Ident); ok && g.models[ident.Name] {
+			return fmt.Sprintf("%s.Copy()", src)
+		}
+		if g.isShallow(t.X) {
+			return fmt.Sprintf("copyShallowPointer(%s)", src)
+		}
+		elem := g.typeString(t.X)
+		return fmt.Sprintf("copyPointer(%s, func(x %s) %s { return %s })", src, elem, elem, g.copyExpr(t.X, "x"))
+	case *ast.ArrayType:
+		elem := g.typeString(t.Elt)
+		return fmt.Sprintf("copySlice(%s, func(x %s) %s { return %s })", src, elem, elem, g.copyExpr(t.Elt, "x"))
+	case *ast.MapType:
+		elem := g.typeString(t.Value)
+		return fmt.Sprintf("copyMap(%s, func(x %s) %s { return %s })", src, elem, elem, g.copyExpr(t.Value, "x"))
+	case *ast.IndexExpr:
+		if value, ok := g.genericMap(t); ok {
+			elem := g.typeString(value)
+			return fmt.Sprintf("copyMap(%s, func(x %s) %s { return %s })", src, elem, elem, g.copyExpr(value, "x"))
+		}
+	case *ast.InterfaceType:
+		return fmt.Sprintf("copyInterface(%s)", src)
+	}
+	log.Fatalf("unsupported field type %s", g.typeString(expr))
+	return ""
+}
+
+// hasEqual reports whether a type must be compared with its own Equal method, like time.Time.
+func (g *generator) hasEqual(expr ast.Expr) bool {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return g.equals[t.Name]
+	case *ast.SelectorExpr:
+		return g.typeString(t) == "time.Time"
+	}
+	return false
+}
+
+// genericMap returns the value type of an instantiated generic map type whose values are its type parameter,
+// such as Nullable[string], which is compared and copied like a map[bool]string.
+func (g *generator) genericMap(expr *ast.IndexExpr) (ast.Expr, bool) {
+	ident, ok := expr.X.(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	mapType, ok := g.named[ident.Name].(*ast.MapType)
+	if !ok {
+		return nil, false
+	}
+	if _, ok := mapType.Value.(*ast.Ident); !ok {
+		return nil, false
+	}
+	return expr.Index, true
+}
+
+// typeString returns the source representation of a type expression.
+func (g *generator) typeString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, g.fset, expr); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+// writeHelpers writes the generic helpers used by the generated methods.
+func (g *generator) writeHelpers() {
+	g.buf.WriteString(`// equalPointer reports whether two pointers are both nil or point to equal values.
+func equalPointer[T any](a, b *T, equal func(x, y T) bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equal(*a, *b)
+}
+
+// equalComparablePointer reports whether two pointers are both nil or point to equal comparable values.
+func equalComparablePointer[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// equalSlice reports whether two slices have the same length and equal elements, treating nil as empty.
+func equalSlice[T any](a, b []T, equal func(x, y T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalMap reports whether two maps have the same keys and equal values, treating nil as empty.
+func equalMap[K comparable, V any](a, b map[K]V, equal func(x, y V) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		other, ok := b[key]
+		if !ok || !equal(value, other) {
+			return false
+		}
+	}
+	return true
+}
+
+// copyPointer returns a pointer to a copy of the value a pointer points to, or nil if it is nil.
+func copyPointer[T any](src *T, copyValue func(x T) T) *T {
+	if src == nil {
+		return nil
+	}
+	value := copyValue(*src)
+	return &value
+}
+
+// copyShallowPointer returns a pointer to a copy of a value that has no nested pointers, or nil if it is nil.
+func copyShallowPointer[T any](src *T) *T {
+	if src == nil {
+		return nil
+	}
+	value := *src
+	return &value
+}
+
+// copySlice returns a copy of a slice with each element copied, preserving nil.
+func copySlice[T any](src []T, copyValue func(x T) T) []T {
+	if src == nil {
+		return nil
+	}
+	copied := make([]T, len(src))
+	for i, value := range src {
+		copied[i] = copyValue(value)
+	}
+	return copied
+}
+
+// copyMap returns a copy of a map with each value copied, preserving nil.
+func copyMap[K comparable, V any](src map[K]V, copyValue func(x V) V) map[K]V {
+	if src == nil {
+		return nil
+	}
+	copied := make(map[K]V, len(src))
+	for key, value := range src {
+		copied[key] = copyValue(value)
+	}
+	return copied
+}
+
+// copyInterface returns a deep copy of decoded JSON values, and other values as-is.
+func copyInterface(src interface{}) interface{} {
+	switch value := src.(type) {
+	case map[string]interface{}:
+		return copyMap(value, copyInterface)
+	case []interface{}:
+		return copySlice(value, copyInterface)
+	}
+	return src
+}
+`)
+}
@@ -0,0 +1,299 @@
+// Package metrics exposes operational data of an xMatters instance as Prometheus metrics, so its health can
+// be scraped into existing monitoring. A Collector periodically queries the instance and serves the latest
+// values in the Prometheus text exposition format, without depending on the Prometheus client library.
+//
+// Usage:
+//
+//	collector := metrics.NewCollector(client)
+//	go collector.Run(ctx)
+//	http.Handle("/metrics", collector)
+//
+// The collector exposes the following gauges:
+//
+//	xmatters_license_users{license,state}       license quota usage: total, active, and unused users
+//	xmatters_people{status}                     people by status
+//	xmatters_groups{status}                     groups by status
+//	xmatters_open_events                        events that are active
+//	xmatters_group_oncall_members{group}        members currently on call in each group with shifts
+//	xmatters_oncall_coverage_gaps               groups with shifts that nobody is currently on call for
+//	xmatters_collector_success                  whether the last collection retrieved every metric
+//	xmatters_collector_duration_seconds         how long the last collection took
+//	xmatters_collector_last_success_timestamp_seconds
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xmatters/xmatters-go"
+)
+
+// DefaultInterval is the time between collections when the Interval of a Collector is not set.
+var DefaultInterval = 5 * time.Minute
+
+// onCallBatchSize is the number of groups whose on-call members are requested together.
+const onCallBatchSize = 50
+
+// -------------------------------------------------------------------------------------------------
+// Collector Structs
+// -------------------------------------------------------------------------------------------------
+
+// Collector periodically collects operational data from an xMatters instance and serves it as Prometheus metrics.
+// A Collector is safe for concurrent use; its fields must be set before Run is called.
+type Collector struct {
+	// Interval is the time between collections by Run. DefaultInterval is used when it is zero.
+	Interval time.Duration
+	// Groups filters the groups checked for on-call coverage. Active groups are checked when it is nil.
+	Groups *xmatters.GetGroupsParams
+
+	client *xmatters.XMattersAPI
+
+	mu          sync.RWMutex
+	metrics     []*metric
+	lastErr     error
+	lastSuccess time.Time
+}
+
+// metric is a gauge with its samples.
+type metric struct {
+	name    string
+	help    string
+	samples []sample
+}
+
+// sample is a value of a metric with its labels, given as alternating names and values.
+type sample struct {
+	labels []string
+	value  float64
+}
+
+// -------------------------------------------------------------------------------------------------
+// Collector Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewCollector creates a Collector that queries the client's instance.
+func NewCollector(client *xmatters.XMattersAPI) *Collector {
+	return &Collector{client: client}
+}
+
+// Run collects the metrics immediately and then at every Interval, until the context is done.
+// Failed collections are reported by the xmatters_collector_success metric and by Err.
+func (c *Collector) Run(ctx context.Context) {
+	interval := c.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.Collect()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect queries the instance once and replaces the served metrics. Metrics that cannot be retrieved are
+// left out rather than served with stale values, and their errors are returned joined into a single error.
+func (c *Collector) Collect() error {
+	started := time.Now()
+	var metrics []*metric
+	var errs []error
+	for _, collect := range []func() ([]*metric, error){c.collectLicenses, c.collectCounts, c.collectOnCall} {
+		collected, err := collect()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		metrics = append(metrics, collected...)
+	}
+	err := errors.Join(errs...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastErr = err
+	success := 0.0
+	if err == nil {
+		success = 1
+		c.lastSuccess = started
+	}
+	metrics = append(metrics,
+		gauge("xmatters_collector_success", "Whether the last collection retrieved every metric.", success),
+		gauge("xmatters_collector_duration_seconds", "Duration of the last collection in seconds.", time.Since(started).Seconds()),
+	)
+	if !c.lastSuccess.IsZero() {
+		metrics = append(metrics, gauge("xmatters_collector_last_success_timestamp_seconds",
+			"Unix time of the last collection that retrieved every metric.", float64(c.lastSuccess.Unix())))
+	}
+	c.metrics = metrics
+	return err
+}
+
+// Err returns the error of the last collection, or nil if it succeeded.
+func (c *Collector) Err() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastErr
+}
+
+// ServeHTTP serves the metrics of the last collection in the Prometheus text exposition format.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if _, err := c.WriteTo(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// WriteTo writes the metrics of the last collection to w in the Prometheus text exposition format.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var buf bytes.Buffer
+	for _, m := range c.metrics {
+		fmt.Fprintf(&buf, "# HELP %s %s\n# TYPE %s gauge\n", m.name, m.help, m.name)
+		for _, s := range m.samples {
+			buf.WriteString(m.name)
+			if len(s.labels) > 0 {
+				buf.WriteByte('{')
+				for i := 0; i+1 < len(s.labels); i += 2 {
+					if i > 0 {
+						buf.WriteByte(',')
+					}
+					fmt.Fprintf(&buf, "%s=\"%s\"", s.labels[i], escapeLabel(s.labels[i+1]))
+				}
+				buf.WriteByte('}')
+			}
+			buf.WriteByte(' ')
+			buf.WriteString(strconv.FormatFloat(s.value, 'g', -1, 64))
+			buf.WriteByte('\n')
+		}
+	}
+	return buf.WriteTo(w)
+}
+
+// collectLicenses collects the license quota usage.
+func (c *Collector) collectLicenses() ([]*metric, error) {
+	quotas, err := c.client.GetUserQuotas()
+	if err != nil {
+		return nil, fmt.Errorf("collecting license quotas: %w", err)
+	}
+	m := &metric{name: "xmatters_license_users", help: "Users counted against the license quotas of the instance."}
+	for _, quota := range []struct {
+		license string
+		details *xmatters.QuotaDetails
+	}{{"full_access", quotas.FullUsers}, {"stakeholder", quotas.StakeholderUsers}} {
+		if quota.details == nil {
+			continue
+		}
+		for _, state := range []struct {
+			name  string
+			value *int64
+		}{{"total", quota.details.Total}, {"active", quota.details.Active}, {"unused", quota.details.Unused}} {
+			if state.value != nil {
+				m.samples = append(m.samples, sample{labels: []string{"license", quota.license, "state", state.name}, value: float64(*state.value)})
+			}
+		}
+	}
+	return []*metric{m}, nil
+}
+
+// collectCounts collects the numbers of people, groups, and open events.
+func (c *Collector) collectCounts() ([]*metric, error) {
+	people := &metric{name: "xmatters_people", help: "People in the instance by status."}
+	groups := &metric{name: "xmatters_groups", help: "Groups in the instance by status."}
+	for _, status := range []xmatters.Status{xmatters.StatusActive, xmatters.StatusInactive} {
+		count, err := c.client.CountPeople(xmatters.GetPeopleParams{Status: string(status)})
+		if err != nil {
+			return nil, fmt.Errorf("counting people: %w", err)
+		}
+		people.samples = append(people.samples, sample{labels: []string{"status", string(status)}, value: float64(count)})
+
+		count, err = c.client.CountGroups(xmatters.GetGroupsParams{Status: string(status)})
+		if err != nil {
+			return nil, fmt.Errorf("counting groups: %w", err)
+		}
+		groups.samples = append(groups.samples, sample{labels: []string{"status", string(status)}, value: float64(count)})
+	}
+
+	openEvents, err := c.client.CountEvents(xmatters.GetEventsParams{Status: string(xmatters.EventStatusActive)})
+	if err != nil {
+		return nil, fmt.Errorf("counting open events: %w", err)
+	}
+	return []*metric{people, groups, gauge("xmatters_open_events", "Events that are active.", float64(openEvents))}, nil
+}
+
+// collectOnCall collects the number of members currently on call in each group with shifts, and the number
+// of such groups that nobody is on call for.
+func (c *Collector) collectOnCall() ([]*metric, error) {
+	params := xmatters.GetGroupsParams{Status: string(xmatters.StatusActive)}
+	if c.Groups != nil {
+		params = *c.Groups
+	}
+	groups, err := c.client.GetGroupList(params)
+	if err != nil {
+		return nil, fmt.Errorf("collecting on-call coverage: %w", err)
+	}
+
+	// Count the members on call now in each group, requesting the groups in batches
+	now := xmatters.NewTimestamp(time.Now())
+	onCall := make(map[string]int)
+	for start := 0; start < len(groups); start += onCallBatchSize {
+		end := start + onCallBatchSize
+		if end > len(groups) {
+			end = len(groups)
+		}
+		ids := make([]string, 0, end-start)
+		for _, group := range groups[start:end] {
+			ids = append(ids, xmatters.Value(group.ID, ""))
+		}
+		onCalls, err := c.client.GetOnCallList(xmatters.GetOnCallParams{Groups: strings.Join(ids, ","), At: now, Embed: "members"})
+		if err != nil {
+			return nil, fmt.Errorf("collecting on-call coverage: %w", err)
+		}
+		for _, period := range onCalls {
+			if period.Group == nil {
+				continue
+			}
+			name := xmatters.Value(period.Group.TargetName, xmatters.Value(period.Group.ID, ""))
+			onCall[name] += len(period.Members)
+		}
+	}
+
+	// Groups without shifts have no on-call periods, so only the groups with periods are reported
+	members := &metric{name: "xmatters_group_oncall_members", help: "Members currently on call in each group with shifts."}
+	gaps := 0
+	names := make([]string, 0, len(onCall))
+	for name := range onCall {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		members.samples = append(members.samples, sample{labels: []string{"group", name}, value: float64(onCall[name])})
+		if onCall[name] == 0 {
+			gaps++
+		}
+	}
+	return []*metric{members, gauge("xmatters_oncall_coverage_gaps", "Groups with shifts that nobody is currently on call for.", float64(gaps))}, nil
+}
+
+// gauge returns a metric with a single unlabelled sample.
+func gauge(name, help string, value float64) *metric {
+	return &metric{name: name, help: help, samples: []sample{{value: value}}}
+}
+
+// escapeLabel escapes a label value for the Prometheus text exposition format.
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
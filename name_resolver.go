@@ -0,0 +1,184 @@
+package xmatters
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Name Resolver Structs
+// -------------------------------------------------------------------------------------------------
+
+// ResolverKind identifies the type of resource whose names are resolved by a NameResolver.
+type ResolverKind string
+
+// Resource types supported by NameResolver.
+const (
+	ResolvePeople   ResolverKind = "people"
+	ResolveGroups   ResolverKind = "groups"
+	ResolveSites    ResolverKind = "sites"
+	ResolveServices ResolverKind = "services"
+)
+
+// NameResolver lazily caches the mappings between human-readable names and IDs of people, groups, sites,
+// and services, so declarative configurations that reference names do not repeat the same lookups on every
+// reconcile. People, groups, and services are identified by target name, and sites by name.
+// A NameResolver is safe for concurrent use.
+type NameResolver struct {
+	// TTL is how long a resolved mapping is cached. Mappings never expire when TTL is zero.
+	TTL time.Duration
+
+	xmatters *XMattersAPI
+	mu       sync.Mutex
+	byName   map[ResolverKind]map[string]*resolvedName
+	byId     map[ResolverKind]map[string]*resolvedName
+}
+
+// resolvedName is a cached mapping between a name and an ID.
+type resolvedName struct {
+	id      string
+	name    string
+	expires time.Time
+}
+
+// -------------------------------------------------------------------------------------------------
+// Name Resolver Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewNameResolver returns a NameResolver that looks up names using the provided client
+// and caches each mapping for the given TTL.
+func NewNameResolver(xmatters *XMattersAPI, ttl time.Duration) *NameResolver {
+	return &NameResolver{
+		TTL:      ttl,
+		xmatters: xmatters,
+		byName:   make(map[ResolverKind]map[string]*resolvedName),
+		byId:     make(map[ResolverKind]map[string]*resolvedName),
+	}
+}
+
+// ID returns the ID of the resource of the given kind with the provided name.
+// Values that are already UUIDs are returned as-is.
+func (r *NameResolver) ID(kind ResolverKind, name string) (string, error) {
+	if IsUUID(name) {
+		return name, nil
+	}
+	if entry, ok := r.cached(r.byName, kind, name); ok {
+		return entry.id, nil
+	}
+	entry, err := r.fetch(kind, name, false)
+	if err != nil {
+		return "", err
+	}
+	return entry.id, nil
+}
+
+// Name returns the name of the resource of the given kind with the provided ID.
+func (r *NameResolver) Name(kind ResolverKind, id string) (string, error) {
+	if entry, ok := r.cached(r.byId, kind, id); ok {
+		return entry.name, nil
+	}
+	entry, err := r.fetch(kind, id, true)
+	if err != nil {
+		return "", err
+	}
+	return entry.name, nil
+}
+
+// Invalidate removes all cached mappings of the given kinds, or of every kind when none are provided.
+// It should be called after renaming or deleting resources outside of the resolver.
+func (r *NameResolver) Invalidate(kinds ...ResolverKind) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(kinds) == 0 {
+		r.byName = make(map[ResolverKind]map[string]*resolvedName)
+		r.byId = make(map[ResolverKind]map[string]*resolvedName)
+		return
+	}
+	for _, kind := range kinds {
+		delete(r.byName, kind)
+		delete(r.byId, kind)
+	}
+}
+
+// cached returns an unexpired mapping from the given index.
+func (r *NameResolver) cached(index map[ResolverKind]map[string]*resolvedName, kind ResolverKind, key string) (*resolvedName, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := index[kind][key]
+	if !ok {
+		return nil, false
+	}
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		delete(r.byName[kind], entry.name)
+		delete(r.byId[kind], entry.id)
+		return nil, false
+	}
+	return entry, true
+}
+
+// fetch looks up a resource by name or ID and caches the resulting mapping.
+func (r *NameResolver) fetch(kind ResolverKind, key string, byId bool) (*resolvedName, error) {
+	entry := &resolvedName{}
+	switch kind {
+	case ResolvePeople:
+		person, err := r.xmatters.GetPerson(key, WithEmbed())
+		if err != nil {
+			return nil, err
+		}
+		entry.id, entry.name = stringValue(person.ID), stringValue(person.TargetName)
+	case ResolveGroups:
+		group, err := r.xmatters.GetGroup(key, WithEmbed())
+		if err != nil {
+			return nil, err
+		}
+		entry.id, entry.name = stringValue(group.ID), stringValue(group.TargetName)
+	case ResolveServices:
+		service, err := r.xmatters.GetService(key, WithEmbed())
+		if err != nil {
+			return nil, err
+		}
+		entry.id, entry.name = stringValue(service.ID), stringValue(service.TargetName)
+	case ResolveSites:
+		site, err := r.fetchSite(key, byId)
+		if err != nil {
+			return nil, err
+		}
+		entry.id, entry.name = stringValue(site.ID), stringValue(site.Name)
+	default:
+		return nil, newValidationError(fmt.Sprintf("unsupported resolver kind %q", kind))
+	}
+
+	// Cache the mapping in both directions
+	if r.TTL > 0 {
+		entry.expires = time.Now().Add(r.TTL)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.byName[kind] == nil {
+		r.byName[kind] = make(map[string]*resolvedName)
+		r.byId[kind] = make(map[string]*resolvedName)
+	}
+	r.byName[kind][entry.name] = entry
+	r.byId[kind][entry.id] = entry
+	return entry, nil
+}
+
+// fetchSite looks up a site by ID, or by name since sites cannot be retrieved by name directly.
+func (r *NameResolver) fetchSite(key string, byId bool) (*Site, error) {
+	if byId {
+		site, err := r.xmatters.GetSite(key)
+		if err != nil {
+			return nil, err
+		}
+		return &site, nil
+	}
+
+	site, err := r.xmatters.getSiteByName(key)
+	if err != nil {
+		return nil, err
+	}
+	return &site, nil
+}
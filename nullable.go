@@ -0,0 +1,105 @@
+package xmatters
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Nullable Structs
+// -------------------------------------------------------------------------------------------------
+
+// Nullable is a tri-state field of a write model that controls exactly what is sent to xMatters.
+// An unspecified field, the zero value, is omitted from the request so the server keeps its current value;
+// a null field is sent as an explicit null to clear the value; and a set field sends its value.
+//
+// Nullable fields must be tagged with omitempty. The map representation lets omitempty drop unspecified
+// fields: the value is stored under true, and an explicit null under false.
+type Nullable[T any] map[bool]T
+
+// -------------------------------------------------------------------------------------------------
+// Nullable Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewNullable returns a Nullable that is set to the provided value.
+func NewNullable[T any](value T) Nullable[T] {
+	return Nullable[T]{true: value}
+}
+
+// NewNull returns a Nullable that is explicitly null, which clears the field in xMatters.
+func NewNull[T any]() Nullable[T] {
+	return Nullable[T]{false: *new(T)}
+}
+
+// NullableFromPtr returns a Nullable set to the value a pointer points to, or an unspecified Nullable if it is nil.
+// It converts the pointer fields of read models, such as Service.Description, into write model fields.
+func NullableFromPtr[T any](value *T) Nullable[T] {
+	if value == nil {
+		return nil
+	}
+	return NewNullable(*value)
+}
+
+// Get returns the value of the Nullable, and false if it is null or unspecified.
+func (n Nullable[T]) Get() (T, bool) {
+	value, ok := n[true]
+	return value, ok
+}
+
+// IsNull reports whether the Nullable is explicitly null.
+func (n Nullable[T]) IsNull() bool {
+	_, ok := n[false]
+	return ok
+}
+
+// IsSpecified reports whether the Nullable is set to a value or explicitly null.
+func (n Nullable[T]) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets the Nullable to the provided value.
+func (n *Nullable[T]) Set(value T) {
+	*n = NewNullable(value)
+}
+
+// SetNull sets the Nullable to an explicit null.
+func (n *Nullable[T]) SetNull() {
+	*n = NewNull[T]()
+}
+
+// SetUnspecified resets the Nullable so it is omitted from requests.
+func (n *Nullable[T]) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON encodes the value of the Nullable, or null if it is null or unspecified.
+// Unspecified fields tagged with omitempty are omitted before MarshalJSON is called.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if value, ok := n.Get(); ok {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON decodes a value into the Nullable, or marks it null when the JSON value is null.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		n.SetNull()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// nullableOrNull returns a Nullable set to the value a pointer points to, or an explicit null if it is nil.
+// Declarative updates use it so that values missing from the desired state are cleared in xMatters.
+func nullableOrNull[T any](value *T) Nullable[T] {
+	if value == nil {
+		return NewNull[T]()
+	}
+	return NewNullable(*value)
+}
@@ -0,0 +1,126 @@
+package xmatters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// -------------------------------------------------------------------------------------------------
+// On-Call Structs
+// -------------------------------------------------------------------------------------------------
+
+// OnCall represents a single on-call period of a group shift in xMatters.
+// Members are ordered by their escalation position within the shift.
+type OnCall struct {
+	Group   *GroupReference `json:"group"`
+	Shift   *ShiftReference `json:"shift"`
+	Start   *Timestamp      `json:"start"`
+	End     *Timestamp      `json:"end"`
+	Members []*OnCallMember `json:"members"`
+}
+
+// OnCallPagination contains a paginated list of on-call periods.
+// It extends the Pagination struct containing links to additional pages.
+type OnCallPagination struct {
+	*Pagination
+	OnCalls []*OnCall `json:"data"`
+}
+
+// ShiftReference represents a shorthand version of a shift in xMatters.
+type ShiftReference struct {
+	ID   *string `json:"id" tfsdk:"id"`
+	Name *string `json:"name" tfsdk:"name"`
+}
+
+// OnCallMember represents a recipient that is on call during an on-call period.
+type OnCallMember struct {
+	Position       *int64              `json:"position"`
+	Delay          *int64              `json:"delay"`
+	EscalationType *string             `json:"escalationType"`
+	Member         *RecipientReference `json:"member"`
+}
+
+// OnCallMemberPagination contains a paginated list of on-call members.
+// It extends the Pagination struct containing links to additional pages.
+type OnCallMemberPagination struct {
+	*Pagination
+	Members []*OnCallMember `json:"data"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// GetOnCallParams contains available API query parameters for the GetOnCallList method.
+// Groups is a comma-separated list of group IDs or target names, and From and To bound the returned
+// on-call periods as ISO-8601 timestamps.
+type GetOnCallParams struct {
+	Groups          string     `url:"groups,omitempty"`
+	From            *Timestamp `url:"from,omitempty"`
+	To              *Timestamp `url:"to,omitempty"`
+	At              *Timestamp `url:"at,omitempty"`
+	MembersPerShift int64      `url:"membersPerShift,omitempty"`
+	Embed           string     `url:"embed,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// On-Call Methods
+// -------------------------------------------------------------------------------------------------
+
+// Custom Unmarshaller for OnCall to handle embedded members
+// This is necessary because the members are nested within a pagination object.
+func (o *OnCall) UnmarshalJSON(data []byte) error {
+	// Define an alias to avoid recursion
+	type Alias OnCall
+	aux := &struct {
+		Members json.RawMessage `json:"members"`
+		*Alias
+	}{
+		Alias: (*Alias)(o),
+	}
+
+	// Unmarshal the JSON into the auxiliary struct
+	if err := json.Unmarshal(data, aux); err != nil {
+		return fmt.Errorf("failed to unmarshal OnCall: %w", err)
+	}
+
+	// Members are returned either as a plain list or nested within a pagination object
+	o.Members = nil
+	trimmed := bytes.TrimSpace(aux.Members)
+	if len(trimmed) == 0 || string(trimmed) == "null" {
+		return nil
+	}
+	if trimmed[0] == '[' {
+		return json.Unmarshal(trimmed, &o.Members)
+	}
+	var members OnCallMemberPagination
+	if err := json.Unmarshal(trimmed, &members); err != nil {
+		return fmt.Errorf("failed to unmarshal OnCall members: %w", err)
+	}
+	o.Members = members.Members
+
+	return nil
+}
+
+// GetOnCallList retrieves the on-call periods of groups in xMatters.
+// It accepts optional query parameters to select the groups and time range, and returns a slice of OnCall objects.
+func (xmatters *XMattersAPI) GetOnCallList(params GetOnCallParams) ([]*OnCall, error) {
+	uri := buildURI("/on-call", params)
+
+	// Use the GetOnCallPaginationSet method to get all paginated results, ahead of background requests
+	onCallList, err := xmatters.interactive().GetOnCallPaginationSet(uri)
+	if err != nil {
+		return onCallList, err
+	}
+
+	// Return the full list of OnCalls.
+	return onCallList, nil
+}
+
+// GetOnCallPaginationSet retrieves a paginated list of on-call periods.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetOnCallPaginationSet(uri string) ([]*OnCall, error) {
+	return getPaginationSet[OnCall](xmatters, uri)
+}
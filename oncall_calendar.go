@@ -0,0 +1,310 @@
+package xmatters
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// On-Call Calendar Structs
+// -------------------------------------------------------------------------------------------------
+
+// OnCallCalendarParams contains the options for the GetOnCallCalendar method.
+type OnCallCalendarParams struct {
+	// Group is the ID or target name of the group whose schedule is rendered.
+	Group string
+	// From and To bound the rendered schedule. From defaults to the current time and To to 30 days after From.
+	From time.Time
+	To   time.Time
+	// Members limits the calendar to the on-call periods of these target names, such as a person subscribing
+	// to their own shifts. All members are included when it is empty.
+	Members []string
+	// Name is the name of the calendar shown by calendar applications. Defaults to "<group> on call".
+	Name string
+}
+
+// onCallSpan is a span of time during which a recipient is on call for a shift position of a group.
+type onCallSpan struct {
+	group     string
+	shift     string
+	position  int64
+	member    *RecipientReference
+	replacing string
+	start     time.Time
+	end       time.Time
+}
+
+// -------------------------------------------------------------------------------------------------
+// On-Call Calendar Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetOnCallCalendar renders the on-call schedule of a group as an iCalendar (RFC 5545) feed, so it can be
+// subscribed to from calendar applications such as Google Calendar or Outlook. Each event is a span of time
+// during which a member is on call for a shift, as resolved by xMatters from the shift rotations.
+// Temporary absences are applied: the replacement is on call instead for the time they cover, noted in the
+// event description, and absences without a replacement leave a gap in the member's events.
+func (xmatters *XMattersAPI) GetOnCallCalendar(params OnCallCalendarParams) (string, error) {
+	if err := validateIdentifier("group ID or target name", params.Group); err != nil {
+		return "", err
+	}
+	from := params.From
+	if from.IsZero() {
+		from = time.Now()
+	}
+	to := params.To
+	if to.IsZero() {
+		to = from.Add(30 * 24 * time.Hour)
+	}
+	if !to.After(from) {
+		return "", newValidationError("the end of the calendar must be after its start")
+	}
+	from, to = from.UTC(), to.UTC()
+	groupName, events, err := xmatters.getOnCallSpans(params.Group, from, to)
+	if err != nil {
+		return "", err
+	}
+
+	// Keep the events of the requested members
+	if len(params.Members) > 0 {
+		wanted := make(map[string]bool, len(params.Members))
+		for _, member := range params.Members {
+			wanted[strings.ToLower(member)] = true
+		}
+		kept := events[:0]
+		for _, event := range events {
+			if wanted[strings.ToLower(stringValue(event.member.TargetName))] {
+				kept = append(kept, event)
+			}
+		}
+		events = kept
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].start.Equal(events[j].start) {
+			return events[i].start.Before(events[j].start)
+		}
+		return events[i].position < events[j].position
+	})
+
+	name := params.Name
+	if name == "" {
+		name = groupName + " on call"
+	}
+	return renderOnCallCalendar(name, events, time.Now().UTC()), nil
+}
+
+// OnCallCalendarHandler returns an http.Handler that serves the on-call calendar of a group, so it can be
+// subscribed to by URL. The calendar is rendered on every request; unset From and To bounds are relative
+// to the time of the request.
+func (xmatters *XMattersAPI) OnCallCalendarHandler(params OnCallCalendarParams) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calendar, err := xmatters.GetOnCallCalendar(params)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		fmt.Fprint(w, calendar)
+	})
+}
+
+// getOnCallSpans retrieves the on-call periods of a group between from and to, and splits them into spans of
+// time during which each member is on call, applying temporary absences as splitOnCallAbsences does.
+// Periods are clipped to the window. It also returns the target name of the group.
+func (xmatters *XMattersAPI) getOnCallSpans(group string, from, to time.Time) (string, []*onCallSpan, error) {
+	fromTimestamp, toTimestamp := TimeRange(from, to)
+
+	// Retrieve the resolved on-call periods and the absences that may replace their members
+	onCalls, err := xmatters.GetOnCallList(GetOnCallParams{
+		Groups: group,
+		From:   fromTimestamp,
+		To:     toTimestamp,
+		Embed:  "members",
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	absenceList, err := xmatters.GetTemporaryAbsenceList(GetTemporaryAbsencesParams{From: fromTimestamp, To: toTimestamp})
+	if err != nil {
+		return "", nil, err
+	}
+	absences := make(map[string][]*TemporaryAbsence)
+	for _, absence := range absenceList {
+		if absence.Member != nil && absence.Start != nil && absence.End != nil {
+			id := stringValue(absence.Member.ID)
+			absences[id] = append(absences[id], absence)
+		}
+	}
+
+	// Split each member's on-call period around their absences
+	groupName := group
+	var spans []*onCallSpan
+	for _, onCall := range onCalls {
+		if onCall.Start == nil || onCall.End == nil {
+			continue
+		}
+		if onCall.Group != nil && onCall.Group.TargetName != nil {
+			groupName = *onCall.Group.TargetName
+		}
+		shiftName := ""
+		if onCall.Shift != nil {
+			shiftName = stringValue(onCall.Shift.Name)
+		}
+		for _, member := range onCall.Members {
+			if member.Member == nil {
+				continue
+			}
+			span := &onCallSpan{
+				group:    groupName,
+				shift:    shiftName,
+				position: int64Value(member.Position),
+				member:   member.Member,
+				start:    maxTime(onCall.Start.Time, from),
+				end:      minTime(onCall.End.Time, to),
+			}
+			if !span.end.After(span.start) {
+				continue
+			}
+			spans = append(spans, splitOnCallAbsences(span, onCall.Group, absences[stringValue(member.Member.ID)])...)
+		}
+	}
+	return groupName, spans, nil
+}
+
+// splitOnCallAbsences applies the absences of an on-call member to their event. The parts of the event covered
+// by an absence with a replacement become events of the replacement, and the parts covered by an absence
+// without one are dropped. Absences scoped to another group do not apply.
+func splitOnCallAbsences(event *onCallSpan, group *GroupReference, absences []*TemporaryAbsence) []*onCallSpan {
+	sort.SliceStable(absences, func(i, j int) bool { return absences[i].Start.Before(absences[j].Start.Time) })
+
+	var events []*onCallSpan
+	cursor := event.start
+	for _, absence := range absences {
+		if absence.Group != nil && group != nil && stringValue(absence.Group.ID) != stringValue(group.ID) {
+			continue
+		}
+		start, end := maxTime(absence.Start.Time, cursor), minTime(absence.End.Time, event.end)
+		if !end.After(start) {
+			continue
+		}
+		if start.After(cursor) {
+			events = append(events, event.span(cursor, start))
+		}
+		if absence.Replacement != nil {
+			replacement := event.span(start, end)
+			replacement.member = &RecipientReference{
+				ID:            absence.Replacement.ID,
+				TargetName:    absence.Replacement.TargetName,
+				RecipientType: StringPtr("PERSON"),
+			}
+			replacement.replacing = stringValue(event.member.TargetName)
+			events = append(events, replacement)
+		}
+		cursor = end
+	}
+	if event.end.After(cursor) {
+		events = append(events, event.span(cursor, event.end))
+	}
+	return events
+}
+
+// span returns a copy of the event covering the given time.
+func (e *onCallSpan) span(start, end time.Time) *onCallSpan {
+	copied := *e
+	copied.start, copied.end = start, end
+	return &copied
+}
+
+// renderOnCallCalendar renders the events as an iCalendar feed with CRLF line endings and folded lines.
+func renderOnCallCalendar(name string, events []*onCallSpan, stamp time.Time) string {
+	const format = "20060102T150405Z"
+	var b strings.Builder
+	line := func(property, value string) {
+		b.WriteString(foldCalendarLine(property + ":" + value))
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//xMatters//xmatters-go on-call calendar//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	line("X-WR-CALNAME", escapeCalendarText(name))
+	for _, event := range events {
+		member := stringValue(event.member.TargetName)
+		if member == "" {
+			member = stringValue(event.member.ID)
+		}
+		summary := member + " on call for " + event.group
+		description := []string{"Group: " + event.group}
+		if event.shift != "" {
+			summary += " (" + event.shift + ")"
+			description = append(description, "Shift: "+event.shift)
+		}
+		if event.position > 0 {
+			description = append(description, fmt.Sprintf("Position: %d", event.position))
+		}
+		if event.replacing != "" {
+			description = append(description, "Replacing: "+event.replacing)
+		}
+
+		uid := sha1.Sum([]byte(strings.Join([]string{event.group, event.shift, fmt.Sprint(event.position),
+			stringValue(event.member.ID), event.start.Format(format)}, "\x00")))
+		line("BEGIN", "VEVENT")
+		line("UID", hex.EncodeToString(uid[:])+"@xmatters-go")
+		line("DTSTAMP", stamp.Format(format))
+		line("DTSTART", event.start.Format(format))
+		line("DTEND", event.end.Format(format))
+		line("SUMMARY", escapeCalendarText(summary))
+		line("DESCRIPTION", escapeCalendarText(strings.Join(description, "\n")))
+		line("TRANSP", "TRANSPARENT")
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+	return b.String()
+}
+
+// escapeCalendarText escapes a TEXT property value as required by RFC 5545.
+func escapeCalendarText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+// foldCalendarLine terminates a content line with CRLF, folding it into lines of at most 75 octets
+// without splitting UTF-8 characters.
+func foldCalendarLine(line string) string {
+	var b strings.Builder
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts towards their length
+		limit = 74
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+// maxTime returns the later of two times.
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// minTime returns the earlier of two times.
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
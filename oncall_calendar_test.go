@@ -0,0 +1,158 @@
+package xmatters
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestFoldCalendarLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		line  string
+		lines int
+	}{
+		{name: "short", line: "SUMMARY:on call", lines: 1},
+		{name: "exactly 75 octets", line: strings.Repeat("a", 75), lines: 1},
+		{name: "76 octets", line: strings.Repeat("a", 76), lines: 2},
+		{name: "two-byte rune straddling the fold", line: strings.Repeat("a", 74) + "é" + "tail", lines: 2},
+		{name: "three-byte rune straddling the fold", line: strings.Repeat("a", 74) + "€" + "tail", lines: 2},
+		{name: "four-byte rune straddling the fold", line: strings.Repeat("a", 73) + "😀" + "tail", lines: 2},
+		{name: "multi-byte continuation lines", line: "SUMMARY:" + strings.Repeat("日本語", 40), lines: 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			folded := foldCalendarLine(tt.line)
+			if !strings.HasSuffix(folded, "\r\n") {
+				t.Fatalf("folded line %q does not end with CRLF", folded)
+			}
+			lines := strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n")
+			if len(lines) != tt.lines {
+				t.Errorf("got %d lines, want %d: %q", len(lines), tt.lines, lines)
+			}
+			var unfolded strings.Builder
+			for i, line := range lines {
+				if len(line) > 75 {
+					t.Errorf("line %d is %d octets, want at most 75", i, len(line))
+				}
+				if i > 0 {
+					if !strings.HasPrefix(line, " ") {
+						t.Errorf("continuation line %d %q does not start with a space", i, line)
+					}
+					line = line[1:]
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d %q splits a UTF-8 sequence", i, line)
+				}
+				unfolded.WriteString(line)
+			}
+			if unfolded.String() != tt.line {
+				t.Errorf("unfolded line = %q, want %q", unfolded.String(), tt.line)
+			}
+		})
+	}
+}
+
+func TestSplitOnCallAbsences(t *testing.T) {
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time { return base.Add(time.Duration(hour) * time.Hour) }
+	absence := func(start, end int, replacement string, group string) *TemporaryAbsence {
+		a := &TemporaryAbsence{Start: &Timestamp{Time: at(start)}, End: &Timestamp{Time: at(end)}}
+		if replacement != "" {
+			a.Replacement = &PersonReference{ID: StringPtr(replacement), TargetName: StringPtr(replacement)}
+		}
+		if group != "" {
+			a.Group = &GroupReference{ID: StringPtr(group)}
+		}
+		return a
+	}
+	type span struct {
+		member     string
+		start, end int
+	}
+
+	tests := []struct {
+		name     string
+		absences []*TemporaryAbsence
+		want     []span
+	}{
+		{name: "no absences", want: []span{{"alice", 0, 24}}},
+		{
+			name:     "absence outside the event",
+			absences: []*TemporaryAbsence{absence(30, 40, "bob", "")},
+			want:     []span{{"alice", 0, 24}},
+		},
+		{
+			name:     "replacement in the middle",
+			absences: []*TemporaryAbsence{absence(8, 12, "bob", "")},
+			want:     []span{{"alice", 0, 8}, {"bob", 8, 12}, {"alice", 12, 24}},
+		},
+		{
+			name:     "absence without replacement is dropped",
+			absences: []*TemporaryAbsence{absence(8, 12, "", "")},
+			want:     []span{{"alice", 0, 8}, {"alice", 12, 24}},
+		},
+		{
+			name:     "absence covering the whole event",
+			absences: []*TemporaryAbsence{absence(-4, 30, "bob", "")},
+			want:     []span{{"bob", 0, 24}},
+		},
+		{
+			name:     "overlapping absences",
+			absences: []*TemporaryAbsence{absence(6, 12, "bob", ""), absence(10, 16, "carol", "")},
+			want:     []span{{"alice", 0, 6}, {"bob", 6, 12}, {"carol", 12, 16}, {"alice", 16, 24}},
+		},
+		{
+			name:     "overlapping absences out of order",
+			absences: []*TemporaryAbsence{absence(10, 16, "carol", ""), absence(6, 12, "bob", "")},
+			want:     []span{{"alice", 0, 6}, {"bob", 6, 12}, {"carol", 12, 16}, {"alice", 16, 24}},
+		},
+		{
+			name:     "contained absence",
+			absences: []*TemporaryAbsence{absence(4, 20, "bob", ""), absence(8, 12, "carol", "")},
+			want:     []span{{"alice", 0, 4}, {"bob", 4, 20}, {"alice", 20, 24}},
+		},
+		{
+			name:     "adjacent absences",
+			absences: []*TemporaryAbsence{absence(4, 8, "bob", ""), absence(8, 12, "carol", "")},
+			want:     []span{{"alice", 0, 4}, {"bob", 4, 8}, {"carol", 8, 12}, {"alice", 12, 24}},
+		},
+		{
+			name:     "absence scoped to another group",
+			absences: []*TemporaryAbsence{absence(8, 12, "bob", "other-group")},
+			want:     []span{{"alice", 0, 24}},
+		},
+		{
+			name:     "absence scoped to this group",
+			absences: []*TemporaryAbsence{absence(8, 12, "bob", "group-id")},
+			want:     []span{{"alice", 0, 8}, {"bob", 8, 12}, {"alice", 12, 24}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := &onCallSpan{
+				group:  "Ops",
+				member: &RecipientReference{ID: StringPtr("alice"), TargetName: StringPtr("alice")},
+				start:  at(0),
+				end:    at(24),
+			}
+			group := &GroupReference{ID: StringPtr("group-id")}
+
+			got := splitOnCallAbsences(event, group, tt.absences)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d spans, want %d", len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				member := stringValue(got[i].member.TargetName)
+				if member != want.member || !got[i].start.Equal(at(want.start)) || !got[i].end.Equal(at(want.end)) {
+					t.Errorf("span %d = %s %v-%v, want %s %v-%v", i, member, got[i].start, got[i].end,
+						want.member, at(want.start), at(want.end))
+				}
+				if member != "alice" && got[i].replacing != "alice" {
+					t.Errorf("span %d replacing = %q, want %q", i, got[i].replacing, "alice")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,389 @@
+package xmatters
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// On-Call Report Structs
+// -------------------------------------------------------------------------------------------------
+
+// ReportFormat is the encoding used when writing a report.
+type ReportFormat string
+
+// Supported report formats.
+const (
+	ReportCSV  ReportFormat = "csv"
+	ReportJSON ReportFormat = "json"
+)
+
+// OnCallReport is a flattened view of who is on call for each group, who is on call next,
+// and how to reach them.
+type OnCallReport struct {
+	GeneratedAt string             `json:"generatedAt"`
+	Rows        []*OnCallReportRow `json:"rows"`
+}
+
+// OnCallReportRow describes a single escalation position of a group at the time of the report.
+// Shift is empty for groups without shifts, whose rows list the group roster instead.
+// Replacing names the absent member that Current is covering for, if any, and Devices lists
+// the contact devices of Current.
+type OnCallReportRow struct {
+	Group        string   `json:"group"`
+	Shift        string   `json:"shift,omitempty"`
+	Position     int64    `json:"position"`
+	Current      string   `json:"current"`
+	CurrentUntil string   `json:"currentUntil,omitempty"`
+	Replacing    string   `json:"replacing,omitempty"`
+	Next         string   `json:"next,omitempty"`
+	NextFrom     string   `json:"nextFrom,omitempty"`
+	Devices      []string `json:"devices"`
+}
+
+// OnCallReportParams contains the options for the GetOnCallReport method.
+type OnCallReportParams struct {
+	// Groups filters the groups included in the report.
+	Groups GetGroupsParams
+	// At is the point in time the report describes. The current time is used when not set.
+	At time.Time
+	// Lookahead is how far past At to search for the next on-call members. Defaults to 7 days.
+	Lookahead time.Duration
+}
+
+// -------------------------------------------------------------------------------------------------
+// On-Call Report Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetOnCallReport builds a who's-on-call report for the groups in xMatters.
+// It combines groups, shifts, rosters, and temporary absences into one row per group escalation position
+// listing the current on-call member, the next on-call member, and the current member's contact devices.
+// Members on a temporary absence without a replacement are skipped, as they are when xMatters notifies the group.
+func (xmatters *XMattersAPI) GetOnCallReport(params OnCallReportParams) (*OnCallReport, error) {
+	at := params.At
+	if at.IsZero() {
+		at = time.Now()
+	}
+	at = at.UTC()
+	lookahead := params.Lookahead
+	if lookahead <= 0 {
+		lookahead = 7 * 24 * time.Hour
+	}
+	from, to := TimeRange(at, at.Add(lookahead))
+
+	// Retrieve the groups to report on and the absences within the report window
+	groups, err := xmatters.GetGroupList(params.Groups)
+	if err != nil {
+		return nil, err
+	}
+	absences, err := xmatters.GetTemporaryAbsenceList(GetTemporaryAbsencesParams{From: from, To: to})
+	if err != nil {
+		return nil, err
+	}
+
+	builder := &onCallReportBuilder{
+		xmatters: xmatters,
+		absences: make(map[string][]*TemporaryAbsence),
+		people:   make(map[string]string),
+		devices:  make(map[string][]string),
+	}
+	for _, absence := range absences {
+		if absence.Member != nil {
+			id := stringValue(absence.Member.ID)
+			builder.absences[id] = append(builder.absences[id], absence)
+		}
+	}
+
+	report := &OnCallReport{GeneratedAt: at.Format(time.RFC3339), Rows: []*OnCallReportRow{}}
+	for _, group := range groups {
+		onCalls, err := xmatters.GetOnCallList(GetOnCallParams{
+			Groups: stringValue(group.ID),
+			From:   from,
+			To:     to,
+			Embed:  "members",
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Groups without shifts are reported from their roster
+		var rows []*OnCallReportRow
+		if len(onCalls) == 0 {
+			rows, err = builder.rosterRows(group)
+		} else {
+			rows, err = builder.shiftRows(group, onCalls, at)
+		}
+		if err != nil {
+			return nil, err
+		}
+		report.Rows = append(report.Rows, rows...)
+	}
+
+	// Return the report
+	return report, nil
+}
+
+// Write encodes the OnCallReport to w in the requested format.
+// CSV output contains a header row followed by one line per report row, with devices separated by semicolons.
+func (r *OnCallReport) Write(w io.Writer, format ReportFormat) error {
+	switch format {
+	case ReportJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(r)
+	case ReportCSV:
+		writer := csv.NewWriter(w)
+		header := []string{"group", "shift", "position", "current", "current_until", "replacing", "next", "next_from", "devices"}
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+		for _, row := range r.Rows {
+			record := []string{
+				row.Group,
+				row.Shift,
+				strconv.FormatInt(row.Position, 10),
+				row.Current,
+				row.CurrentUntil,
+				row.Replacing,
+				row.Next,
+				row.NextFrom,
+				strings.Join(row.Devices, "; "),
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	default:
+		return fmt.Errorf("unsupported report format %q", format)
+	}
+}
+
+// onCallReportBuilder caches the lookups made while building an OnCallReport.
+type onCallReportBuilder struct {
+	xmatters *XMattersAPI
+	absences map[string][]*TemporaryAbsence
+	people   map[string]string
+	devices  map[string][]string
+}
+
+// shiftRows returns the report rows for a group with shifts, from its on-call periods.
+func (b *onCallReportBuilder) shiftRows(group *Group, onCalls []*OnCall, at time.Time) ([]*OnCallReportRow, error) {
+	sort.SliceStable(onCalls, func(i, j int) bool {
+		return timeValue(onCalls[i].Start).Before(timeValue(onCalls[j].Start))
+	})
+
+	rows := []*OnCallReportRow{}
+	for i, onCall := range onCalls {
+		if onCall.Start == nil || onCall.End == nil || at.Before(onCall.Start.Time) || !at.Before(onCall.End.Time) {
+			continue
+		}
+
+		// Find the following on-call period of the same shift
+		var next *OnCall
+		for _, candidate := range onCalls[i+1:] {
+			if onCallShiftId(candidate) == onCallShiftId(onCall) && !timeValue(candidate.Start).Before(onCall.End.Time) {
+				next = candidate
+				break
+			}
+		}
+		nextMembers := map[int64]*OnCallMember{}
+		var nextStart time.Time
+		if next != nil {
+			nextStart = timeValue(next.Start)
+			for _, member := range next.Members {
+				nextMembers[int64Value(member.Position)] = member
+			}
+		}
+
+		shiftName := ""
+		if onCall.Shift != nil {
+			shiftName = stringValue(onCall.Shift.Name)
+		}
+		for _, member := range onCall.Members {
+			current, replacing, ok := b.effectiveMember(member.Member, at)
+			if !ok {
+				continue
+			}
+			row := &OnCallReportRow{
+				Group:        stringValue(group.TargetName),
+				Shift:        shiftName,
+				Position:     int64Value(member.Position),
+				Current:      b.recipientName(current),
+				CurrentUntil: onCall.End.String(),
+				Replacing:    replacing,
+			}
+			if nextMember, ok := nextMembers[row.Position]; ok {
+				if upcoming, _, ok := b.effectiveMember(nextMember.Member, nextStart); ok {
+					row.Next = b.recipientName(upcoming)
+					row.NextFrom = Value(next.Start, Timestamp{}).String()
+				}
+			}
+			devices, err := b.contactDevices(current)
+			if err != nil {
+				return nil, err
+			}
+			row.Devices = devices
+			rows = append(rows, row)
+		}
+	}
+
+	return rows, nil
+}
+
+// rosterRows returns the report rows for a group without shifts, from its roster.
+func (b *onCallReportBuilder) rosterRows(group *Group) ([]*OnCallReportRow, error) {
+	roster, err := b.xmatters.GetGroupRoster(stringValue(group.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []*OnCallReportRow{}
+	for i, member := range roster.Members {
+		recipient := &RecipientReference{ID: member.ID, RecipientType: member.MemberType}
+		name, err := b.memberName(recipient)
+		if err != nil {
+			return nil, err
+		}
+		recipient.TargetName = StringPtr(name)
+		devices, err := b.contactDevices(recipient)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, &OnCallReportRow{
+			Group:    stringValue(group.TargetName),
+			Position: int64(i + 1),
+			Current:  name,
+			Devices:  devices,
+		})
+	}
+
+	return rows, nil
+}
+
+// effectiveMember applies temporary absences to an on-call member at the given time.
+// It returns the recipient actually on call, the target name of the absent member being replaced, if any,
+// and false when the member is absent without a replacement.
+func (b *onCallReportBuilder) effectiveMember(member *RecipientReference, at time.Time) (*RecipientReference, string, bool) {
+	if member == nil {
+		return nil, "", false
+	}
+	if stringValue(member.RecipientType) != "PERSON" {
+		return member, "", true
+	}
+	for _, absence := range b.absences[stringValue(member.ID)] {
+		if absence.Start == nil || absence.End == nil || at.Before(absence.Start.Time) || !at.Before(absence.End.Time) {
+			continue
+		}
+		if absence.Replacement == nil {
+			return nil, "", false
+		}
+		replacement := &RecipientReference{
+			ID:            absence.Replacement.ID,
+			TargetName:    absence.Replacement.TargetName,
+			RecipientType: StringPtr("PERSON"),
+		}
+		return replacement, stringValue(member.TargetName), true
+	}
+	return member, "", true
+}
+
+// recipientName returns the display name of an on-call recipient.
+func (b *onCallReportBuilder) recipientName(recipient *RecipientReference) string {
+	if recipient.TargetName != nil {
+		return *recipient.TargetName
+	}
+	return stringValue(recipient.ID)
+}
+
+// memberName looks up the target name of a roster member, which the roster only references by ID.
+func (b *onCallReportBuilder) memberName(recipient *RecipientReference) (string, error) {
+	id := stringValue(recipient.ID)
+	switch stringValue(recipient.RecipientType) {
+	case "PERSON":
+		if name, ok := b.people[id]; ok {
+			return name, nil
+		}
+		person, err := b.xmatters.GetPerson(id)
+		if err != nil {
+			return "", err
+		}
+		b.people[id] = stringValue(person.TargetName)
+		return b.people[id], nil
+	case "GROUP":
+		group, err := b.xmatters.GetGroup(id)
+		if err != nil {
+			return "", err
+		}
+		return stringValue(group.TargetName), nil
+	case "DEVICE":
+		device, err := b.xmatters.GetDevice(id)
+		if err != nil {
+			return "", err
+		}
+		return stringValue(device.TargetName), nil
+	}
+	return id, nil
+}
+
+// contactDevices returns the formatted contact devices of a recipient.
+// People are reached through their active devices, devices are their own contact, and groups have none.
+func (b *onCallReportBuilder) contactDevices(recipient *RecipientReference) ([]string, error) {
+	id := stringValue(recipient.ID)
+	if cached, ok := b.devices[id]; ok {
+		return cached, nil
+	}
+
+	var devices []*Device
+	switch stringValue(recipient.RecipientType) {
+	case "PERSON":
+		personDevices, err := b.xmatters.GetPersonDevices(id)
+		if err != nil {
+			return nil, err
+		}
+		devices = personDevices
+	case "DEVICE":
+		device, err := b.xmatters.GetDevice(id)
+		if err != nil {
+			return nil, err
+		}
+		devices = []*Device{&device}
+	}
+
+	formatted := []string{}
+	for _, device := range devices {
+		if device.Status != nil && *device.Status != "ACTIVE" {
+			continue
+		}
+		formatted = append(formatted, formatContactDevice(device))
+	}
+	b.devices[id] = formatted
+	return formatted, nil
+}
+
+// formatContactDevice returns a device name followed by its address or phone number.
+func formatContactDevice(device *Device) string {
+	contact := stringValue(device.EmailAddress)
+	if contact == "" {
+		contact = stringValue(device.PhoneNumber)
+	}
+	if contact == "" {
+		return stringValue(device.Name)
+	}
+	return fmt.Sprintf("%s: %s", stringValue(device.Name), contact)
+}
+
+// onCallShiftId returns the ID of the shift of an on-call period.
+func onCallShiftId(onCall *OnCall) string {
+	if onCall.Shift == nil {
+		return ""
+	}
+	return stringValue(onCall.Shift.ID)
+}
@@ -0,0 +1,343 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Check Structs
+// -------------------------------------------------------------------------------------------------
+
+// DriftKind describes how a model or parameter struct differs from the OpenAPI definition.
+type DriftKind string
+
+const (
+	// DriftMissingSchema is reported when a binding names a schema or operation that is not in the definition.
+	DriftMissingSchema DriftKind = "MISSING_SCHEMA"
+	// DriftMissingField is reported for a schema property that the model has no field for.
+	DriftMissingField DriftKind = "MISSING_FIELD"
+	// DriftUnknownField is reported for a model field that is not a property of the schema.
+	DriftUnknownField DriftKind = "UNKNOWN_FIELD"
+	// DriftTypeMismatch is reported when a model field cannot hold the type of its schema property.
+	DriftTypeMismatch DriftKind = "TYPE_MISMATCH"
+	// DriftDecodeFailure is reported when the example of a schema cannot be decoded into the model.
+	DriftDecodeFailure DriftKind = "DECODE_FAILURE"
+	// DriftMissingParameter is reported for a query parameter of an operation that the parameter struct does not set.
+	DriftMissingParameter DriftKind = "MISSING_PARAMETER"
+	// DriftUnknownParameter is reported for a parameter struct field that the operation does not accept.
+	DriftUnknownParameter DriftKind = "UNKNOWN_PARAMETER"
+)
+
+// Drift is a single difference between the library and the OpenAPI definition.
+// Target is the schema name, or the method and path of an operation, and Field is the dotted JSON
+// path of the field or the name of the query parameter.
+type Drift struct {
+	Target string
+	Field  string
+	Kind   DriftKind
+	Detail string
+}
+
+// Binding pairs the name of a schema in the definition with the model that represents it.
+type Binding struct {
+	Schema string
+	Model  interface{}
+}
+
+// queryKeyer is implemented by query parameter types that encode themselves into several query parameters,
+// such as xmatters.SearchQuery.
+type queryKeyer interface {
+	QueryKeys() []string
+}
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// -------------------------------------------------------------------------------------------------
+// Check Methods
+// -------------------------------------------------------------------------------------------------
+
+// String returns a one-line description of the drift.
+func (d Drift) String() string {
+	location := d.Target
+	if d.Field != "" {
+		location += " " + d.Field
+	}
+	if d.Detail == "" {
+		return fmt.Sprintf("%s: %s", location, d.Kind)
+	}
+	return fmt.Sprintf("%s: %s: %s", location, d.Kind, d.Detail)
+}
+
+// Check compares each bound model with its schema and returns the drifts of all bindings.
+func (s *Spec) Check(bindings ...Binding) []Drift {
+	drifts := []Drift{}
+	for _, binding := range bindings {
+		drifts = append(drifts, s.CheckModel(binding.Schema, binding.Model)...)
+	}
+	return drifts
+}
+
+// CheckModel compares a model, such as xmatters.Person{}, with the named schema.
+// It reports schema properties without a matching field, fields that are not schema properties,
+// fields whose type cannot hold the property, and schema examples that fail to decode into the model.
+// Embedded collections, which the API wraps in pagination objects, are compared with the items of
+// the pagination data.
+func (s *Spec) CheckModel(schemaName string, model interface{}) []Drift {
+	schema, ok := s.Schema(schemaName)
+	if !ok {
+		return []Drift{{Target: schemaName, Kind: DriftMissingSchema}}
+	}
+
+	checker := &modelChecker{spec: s, target: schemaName, visited: make(map[visit]bool)}
+	modelType := indirectType(reflect.TypeOf(model))
+	resolved := s.resolve(schema, 0)
+	checker.compare("", resolved, modelType)
+
+	// Decode the schema example to surface decoding failures
+	if len(resolved.Example) > 0 {
+		value := reflect.New(modelType).Interface()
+		if err := json.Unmarshal(resolved.Example, value); err != nil {
+			checker.add("", DriftDecodeFailure, err.Error())
+		}
+	}
+	sortDrifts(checker.drifts)
+	return checker.drifts
+}
+
+// CheckQuery compares the url tags of a parameter struct, such as xmatters.GetPeopleParams{},
+// with the query parameters of the operation for a method and path, such as "GET" and "/people".
+func (s *Spec) CheckQuery(method, path string, params interface{}) []Drift {
+	target := strings.ToUpper(method) + " " + path
+	parameters, ok := s.parameters(method, path)
+	if !ok {
+		return []Drift{{Target: target, Kind: DriftMissingSchema}}
+	}
+
+	accepted := make(map[string]bool)
+	for _, parameter := range parameters {
+		if parameter.In == "query" {
+			accepted[parameter.Name] = true
+		}
+	}
+	sent := queryNames(indirectType(reflect.TypeOf(params)))
+
+	drifts := []Drift{}
+	for name := range sent {
+		if !accepted[name] {
+			drifts = append(drifts, Drift{Target: target, Field: name, Kind: DriftUnknownParameter})
+		}
+	}
+	for name := range accepted {
+		if !sent[name] {
+			drifts = append(drifts, Drift{Target: target, Field: name, Kind: DriftMissingParameter})
+		}
+	}
+	sortDrifts(drifts)
+	return drifts
+}
+
+// visit identifies a schema and model type pair that has been compared, to stop recursive models.
+type visit struct {
+	schema *Schema
+	model  reflect.Type
+}
+
+// modelChecker accumulates the drifts found while comparing a model with its schema.
+type modelChecker struct {
+	spec    *Spec
+	target  string
+	visited map[visit]bool
+	drifts  []Drift
+}
+
+// add records a drift of the field at the given path.
+func (c *modelChecker) add(path string, kind DriftKind, detail string) {
+	c.drifts = append(c.drifts, Drift{Target: c.target, Field: path, Kind: kind, Detail: detail})
+}
+
+// compare compares a resolved schema with a model type, recursing into objects and arrays.
+func (c *modelChecker) compare(path string, schema *Schema, modelType reflect.Type) {
+	schema = c.spec.resolve(schema, 0)
+	modelType = indirectType(modelType)
+	if schema == nil || modelType.Kind() == reflect.Interface {
+		return
+	}
+
+	// Types that decode themselves, such as Timestamp and Nullable, are compared as opaque values
+	if reflect.PointerTo(modelType).Implements(textUnmarshalerType) {
+		if schema.Type != "" && schema.Type != "string" {
+			c.add(path, DriftTypeMismatch, fmt.Sprintf("%s cannot hold %s", modelType, schema.Type))
+		}
+		return
+	}
+	if modelType.Kind() != reflect.Struct && reflect.PointerTo(modelType).Implements(jsonUnmarshalerType) {
+		return
+	}
+
+	key := visit{schema: schema, model: modelType}
+	if c.visited[key] {
+		return
+	}
+	c.visited[key] = true
+
+	switch modelType.Kind() {
+	case reflect.Struct:
+		if schema.Type != "" && schema.Type != "object" {
+			c.add(path, DriftTypeMismatch, fmt.Sprintf("%s cannot hold %s", modelType, schema.Type))
+			return
+		}
+		c.compareFields(path, schema, modelType)
+	case reflect.Slice, reflect.Array:
+		switch {
+		case schema.Type == "array":
+			c.compare(path+"[]", schema.Items, modelType.Elem())
+		case schema.Properties["data"] != nil:
+			// Embedded collections are wrapped in pagination objects
+			data := c.spec.resolve(schema.Properties["data"], 0)
+			c.compare(path+".data[]", data.Items, modelType.Elem())
+		case schema.Type != "":
+			c.add(path, DriftTypeMismatch, fmt.Sprintf("%s cannot hold %s", modelType, schema.Type))
+		}
+	case reflect.Map:
+		if schema.Type != "" && schema.Type != "object" {
+			c.add(path, DriftTypeMismatch, fmt.Sprintf("%s cannot hold %s", modelType, schema.Type))
+		}
+	default:
+		if !kindHolds(modelType.Kind(), schema.Type) {
+			c.add(path, DriftTypeMismatch, fmt.Sprintf("%s cannot hold %s", modelType, schema.Type))
+		}
+	}
+}
+
+// compareFields compares the properties of an object schema with the JSON fields of a struct.
+func (c *modelChecker) compareFields(path string, schema *Schema, modelType reflect.Type) {
+	if len(schema.Properties) == 0 {
+		return
+	}
+	fields := jsonFields(modelType)
+	for name, property := range schema.Properties {
+		fieldType, ok := fields[name]
+		if !ok {
+			c.add(joinPath(path, name), DriftMissingField, "")
+			continue
+		}
+		c.compare(joinPath(path, name), property, fieldType)
+	}
+	for name := range fields {
+		if _, ok := schema.Properties[name]; !ok {
+			c.add(joinPath(path, name), DriftUnknownField, "")
+		}
+	}
+}
+
+// jsonFields returns the JSON field names of a struct and their types, following the encoding/json rules
+// for embedded structs and skipping fields tagged with "-".
+func jsonFields(structType reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && indirectType(field.Type).Kind() == reflect.Struct {
+			for embeddedName, embeddedType := range jsonFields(indirectType(field.Type)) {
+				if _, ok := fields[embeddedName]; !ok {
+					fields[embeddedName] = embeddedType
+				}
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// queryNames returns the query parameter names set by the url tags of a parameter struct.
+func queryNames(structType reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	if structType.Kind() != reflect.Struct {
+		return names
+	}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("url"), ",")
+		if name == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+		if keyer, ok := reflect.New(indirectType(field.Type)).Interface().(queryKeyer); ok {
+			for _, key := range keyer.QueryKeys() {
+				names[key] = true
+			}
+			continue
+		}
+		if field.Anonymous && name == "" && indirectType(field.Type).Kind() == reflect.Struct {
+			for embeddedName := range queryNames(indirectType(field.Type)) {
+				names[embeddedName] = true
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = true
+	}
+	return names
+}
+
+// kindHolds reports whether a Go kind can hold a JSON schema type.
+func kindHolds(kind reflect.Kind, schemaType string) bool {
+	switch schemaType {
+	case "":
+		return true
+	case "string":
+		return kind == reflect.String
+	case "boolean":
+		return kind == reflect.Bool
+	case "integer":
+		return kind >= reflect.Int && kind <= reflect.Uint64
+	case "number":
+		return (kind >= reflect.Int && kind <= reflect.Uint64) || kind == reflect.Float32 || kind == reflect.Float64
+	}
+	return false
+}
+
+// indirectType returns the type a pointer type points to, or the type itself.
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// joinPath appends a field name to a dotted JSON path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// sortDrifts orders drifts by field and kind so reports are stable.
+func sortDrifts(drifts []Drift) {
+	sort.Slice(drifts, func(i, j int) bool {
+		if drifts[i].Field != drifts[j].Field {
+			return drifts[i].Field < drifts[j].Field
+		}
+		return drifts[i].Kind < drifts[j].Kind
+	})
+}
@@ -0,0 +1,221 @@
+// Package openapi checks the models and query parameters of xmatters-go against the xMatters OpenAPI definition.
+//
+// xMatters adds and renames fields over time. Comparing the library's structs with the published definition
+// catches that drift before it surfaces as silently missing data. Both OpenAPI 3 and Swagger 2 documents are
+// supported, in JSON or YAML.
+//
+// Usage:
+//
+//	spec, err := openapi.Load("xmatters-openapi.yaml")
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	drifts := spec.Check(
+//	    openapi.Binding{Schema: "Person", Model: xmatters.Person{}},
+//	    openapi.Binding{Schema: "Group", Model: xmatters.Group{}},
+//	)
+//	drifts = append(drifts, spec.CheckQuery(http.MethodGet, "/people", xmatters.GetPeopleParams{})...)
+//	for _, drift := range drifts {
+//	    t.Error(drift)
+//	}
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Spec Structs
+// -------------------------------------------------------------------------------------------------
+
+// Spec is the subset of an OpenAPI or Swagger document needed to check models and query parameters.
+type Spec struct {
+	Components  Components            `json:"components"`
+	Definitions map[string]*Schema    `json:"definitions"`
+	Parameters  map[string]*Parameter `json:"parameters"`
+	Paths       map[string]*PathItem  `json:"paths"`
+}
+
+// Components contains the reusable objects of an OpenAPI 3 document.
+type Components struct {
+	Schemas    map[string]*Schema    `json:"schemas"`
+	Parameters map[string]*Parameter `json:"parameters"`
+}
+
+// PathItem contains the operations available on a single path.
+type PathItem struct {
+	Parameters []*Parameter `json:"parameters"`
+	Get        *Operation   `json:"get"`
+	Put        *Operation   `json:"put"`
+	Post       *Operation   `json:"post"`
+	Delete     *Operation   `json:"delete"`
+	Patch      *Operation   `json:"patch"`
+}
+
+// Schema describes the shape of a JSON value.
+type Schema struct {
+	Ref        string             `json:"$ref,omitempty"`
+	Type       string             `json:"type,omitempty"`
+	Format     string             `json:"format,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+	AllOf      []*Schema          `json:"allOf,omitempty"`
+	Example    json.RawMessage    `json:"example,omitempty"`
+}
+
+// Operation describes a single API operation.
+type Operation struct {
+	Parameters []*Parameter `json:"parameters"`
+}
+
+// Parameter describes a single operation parameter. In is query, path, header, or cookie.
+type Parameter struct {
+	Ref  string `json:"$ref,omitempty"`
+	Name string `json:"name"`
+	In   string `json:"in"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Spec Methods
+// -------------------------------------------------------------------------------------------------
+
+// Load reads an OpenAPI or Swagger document from a JSON or YAML file.
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading OpenAPI document: %w", err)
+	}
+	yamlFile := strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml")
+	spec, err := Parse(data, yamlFile)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	return spec, nil
+}
+
+// Parse decodes an OpenAPI or Swagger document from JSON, or from YAML when yamlDocument is true.
+func Parse(data []byte, yamlDocument bool) (*Spec, error) {
+	if yamlDocument {
+		// Convert YAML to JSON so the document is decoded with a single set of struct tags
+		var generic interface{}
+		if err := yaml.Unmarshal(data, &generic); err != nil {
+			return nil, fmt.Errorf("decoding OpenAPI YAML: %w", err)
+		}
+		converted, err := json.Marshal(normalizeYAML(generic))
+		if err != nil {
+			return nil, fmt.Errorf("converting OpenAPI YAML: %w", err)
+		}
+		data = converted
+	}
+
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("decoding OpenAPI document: %w", err)
+	}
+	return &spec, nil
+}
+
+// Schema returns the named schema from the components of an OpenAPI 3 document
+// or the definitions of a Swagger 2 document.
+func (s *Spec) Schema(name string) (*Schema, bool) {
+	if schema, ok := s.Components.Schemas[name]; ok {
+		return schema, true
+	}
+	schema, ok := s.Definitions[name]
+	return schema, ok
+}
+
+// resolve follows references and merges allOf compositions into a single schema.
+// The depth limit guards against reference cycles.
+func (s *Spec) resolve(schema *Schema, depth int) *Schema {
+	if schema == nil || depth > 32 {
+		return schema
+	}
+	if schema.Ref != "" {
+		name := schema.Ref[strings.LastIndex(schema.Ref, "/")+1:]
+		if referenced, ok := s.Schema(name); ok {
+			return s.resolve(referenced, depth+1)
+		}
+		return schema
+	}
+	if len(schema.AllOf) == 0 {
+		return schema
+	}
+
+	merged := &Schema{Type: schema.Type, Format: schema.Format, Properties: map[string]*Schema{}, Items: schema.Items, Example: schema.Example}
+	for name, property := range schema.Properties {
+		merged.Properties[name] = property
+	}
+	for _, part := range schema.AllOf {
+		part = s.resolve(part, depth+1)
+		if part == nil {
+			continue
+		}
+		if merged.Type == "" {
+			merged.Type = part.Type
+		}
+		for name, property := range part.Properties {
+			merged.Properties[name] = property
+		}
+	}
+	return merged
+}
+
+// parameters returns the resolved parameters of the operation for a method and path,
+// including the parameters declared on the path itself.
+func (s *Spec) parameters(method, path string) ([]*Parameter, bool) {
+	item, ok := s.Paths[path]
+	if !ok || item == nil {
+		return nil, false
+	}
+	operations := map[string]*Operation{"GET": item.Get, "PUT": item.Put, "POST": item.Post, "DELETE": item.Delete, "PATCH": item.Patch}
+	operation := operations[strings.ToUpper(method)]
+	if operation == nil {
+		return nil, false
+	}
+
+	parameters := []*Parameter{}
+	for _, parameter := range append(append([]*Parameter{}, operation.Parameters...), item.Parameters...) {
+		if parameter == nil {
+			continue
+		}
+		if parameter.Ref != "" {
+			name := parameter.Ref[strings.LastIndex(parameter.Ref, "/")+1:]
+			if referenced, ok := s.Components.Parameters[name]; ok {
+				parameter = referenced
+			} else if referenced, ok := s.Parameters[name]; ok {
+				parameter = referenced
+			}
+		}
+		parameters = append(parameters, parameter)
+	}
+	return parameters, true
+}
+
+// normalizeYAML converts mappings with non-string keys, such as unquoted response codes,
+// into mappings with string keys that can be encoded as JSON.
+func normalizeYAML(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(typed))
+		for key, element := range typed {
+			converted[fmt.Sprint(key)] = normalizeYAML(element)
+		}
+		return converted
+	case map[string]interface{}:
+		for key, element := range typed {
+			typed[key] = normalizeYAML(element)
+		}
+	case []interface{}:
+		for i, element := range typed {
+			typed[i] = normalizeYAML(element)
+		}
+	}
+	return value
+}
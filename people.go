@@ -13,22 +13,22 @@ import (
 
 // Person represents a person in xMatters.
 type Person struct {
-	ID              *string        `json:"id"`
-	TargetName      *string        `json:"targetName"`
-	FirstName       *string        `json:"firstName"`
-	LastName        *string        `json:"lastName"`
-	Roles           []*Role        `json:"roles"`
-	Status          *string        `json:"status,omitempty"`
-	WebLogin        *string        `json:"webLogin,omitempty"`
-	Site            *ReferenceById `json:"site,omitempty"`
-	Timezone        *string        `json:"timezone,omitempty"`
-	Language        *string        `json:"language,omitempty"`
-	Supervisors     []*Person      `json:"supervisors,omitempty"`
-	PhoneLogin      *string        `json:"phoneLogin,omitempty"`
-	LicenseType     *string        `json:"licenseType,omitempty"`
-	ExternalKey     *string        `json:"externalKey,omitempty"`
-	ExternallyOwned *bool          `json:"externallyOwned,omitempty"`
-	LastLogin       *string        `json:"lastLogin,omitempty"`
+	ID              *string        `json:"id" tfsdk:"id"`
+	TargetName      *string        `json:"targetName" tfsdk:"target_name"`
+	FirstName       *string        `json:"firstName" tfsdk:"first_name"`
+	LastName        *string        `json:"lastName" tfsdk:"last_name"`
+	Roles           []*Role        `json:"roles" tfsdk:"roles"`
+	Status          *string        `json:"status,omitempty" tfsdk:"status"`
+	WebLogin        *string        `json:"webLogin,omitempty" tfsdk:"web_login"`
+	Site            *ReferenceById `json:"site,omitempty" tfsdk:"site"`
+	Timezone        *string        `json:"timezone,omitempty" tfsdk:"timezone"`
+	Language        *string        `json:"language,omitempty" tfsdk:"language"`
+	Supervisors     []*Person      `json:"supervisors,omitempty" tfsdk:"supervisors"`
+	PhoneLogin      *string        `json:"phoneLogin,omitempty" tfsdk:"phone_login"`
+	LicenseType     *string        `json:"licenseType,omitempty" tfsdk:"license_type"`
+	ExternalKey     *string        `json:"externalKey,omitempty" tfsdk:"external_key"`
+	ExternallyOwned *bool          `json:"externallyOwned,omitempty" tfsdk:"externally_owned"`
+	LastLogin       *Timestamp     `json:"lastLogin,omitempty" tfsdk:"last_login"`
 }
 
 // PersonPagination contains a paginated list of people.
@@ -38,12 +38,24 @@ type PersonPagination struct {
 	People []*Person `json:"data"`
 }
 
+// personJSON is the JSON representation of a Person, with its embedded collections nested within pagination objects.
+// Lists of people are decoded into personJSON values in a single pass, rather than decoding every person
+// again in UnmarshalJSON.
+type personJSON struct {
+	personFields
+	Roles       embeddedList[*Role]       `json:"roles"`
+	Supervisors embeddedList[*personJSON] `json:"supervisors"`
+}
+
+// personFields has the fields of a Person without its methods, so decoding it does not call UnmarshalJSON.
+type personFields Person
+
 // PersonReference represents a shorthand version of a person in xMatters.
 type PersonReference struct {
-	ID         *string `json:"id"`
-	TargetName *string `json:"targetName"`
-	FirstName  *string `json:"firstName"`
-	LastName   *string `json:"lastName"`
+	ID         *string `json:"id" tfsdk:"id"`
+	TargetName *string `json:"targetName" tfsdk:"target_name"`
+	FirstName  *string `json:"firstName" tfsdk:"first_name"`
+	LastName   *string `json:"lastName" tfsdk:"last_name"`
 }
 
 // -------------------------------------------------------------------------------------------------
@@ -72,103 +84,138 @@ type QuotaDetails struct {
 type GetPeopleParams struct {
 	Embed string `url:"embed,omitempty"`
 	// Provider Search Object
-	Terms   string `url:"search,omitempty"`
-	Fields  string `url:"fields,omitempty"`
-	Operand string `url:"operand,omitempty"`
+	SearchQuery `url:"search,omitempty"`
 	// Provider Filters Object
-	CreatedAfter       string `url:"createdAfter,omitempty"`
-	CreatedBefore      string `url:"createdBefore,omitempty"`
-	CreatedFrom        string `url:"createdFrom,omitempty"`
-	CreatedTo          string `url:"createdTo,omitempty"`
-	DevicesExists      *bool  `url:"devices.exists,omitempty"`
-	DevicesEmailExists *bool  `url:"devices.email.exists,omitempty"`
-	DevicesFailsafe    *bool  `url:"devices.failsafe.exists,omitempty"`
-	DevicesMobile      *bool  `url:"devices.mobile.exists,omitempty"`
-	DevicesSMS         *bool  `url:"devices.sms.exists,omitempty"`
-	DevicesVoice       *bool  `url:"devices.voice.exists,omitempty"`
-	DevicesStatus      string `url:"devices.status,omitempty"`
-	DevicesTestStatus  string `url:"devices.testStatus,omitempty"`
-	EmailAddress       string `url:"emailAddress,omitempty"`
-	FirstName          string `url:"firstName,omitempty"`
-	Groups             string `url:"groups,omitempty"`
-	GroupsExists       *bool  `url:"groups.exists,omitempty"`
-	LastName           string `url:"lastName,omitempty"`
-	LicenseType        string `url:"licenseType,omitempty"`
-	PhoneNumber        string `url:"phoneNumber,omitempty"`
-	Roles              string `url:"roles,omitempty"`
-	Site               string `url:"site,omitempty"`
-	Status             string `url:"status,omitempty"`
-	Supervisors        string `url:"supervisors,omitempty"`
-	SupervisorsExists  *bool  `url:"supervisors.exists,omitempty"`
-	TargetName         string `url:"targetName,omitempty"`
-	WebLogin           string `url:"webLogin,omitempty"`
+	CreatedAfter       *Timestamp `url:"createdAfter,omitempty"`
+	CreatedBefore      *Timestamp `url:"createdBefore,omitempty"`
+	CreatedFrom        *Timestamp `url:"createdFrom,omitempty"`
+	CreatedTo          *Timestamp `url:"createdTo,omitempty"`
+	DevicesExists      *bool      `url:"devices.exists,omitempty"`
+	DevicesEmailExists *bool      `url:"devices.email.exists,omitempty"`
+	DevicesFailsafe    *bool      `url:"devices.failsafe.exists,omitempty"`
+	DevicesMobile      *bool      `url:"devices.mobile.exists,omitempty"`
+	DevicesSMS         *bool      `url:"devices.sms.exists,omitempty"`
+	DevicesVoice       *bool      `url:"devices.voice.exists,omitempty"`
+	DevicesStatus      string     `url:"devices.status,omitempty"`
+	DevicesTestStatus  string     `url:"devices.testStatus,omitempty"`
+	EmailAddress       string     `url:"emailAddress,omitempty"`
+	FirstName          string     `url:"firstName,omitempty"`
+	Groups             string     `url:"groups,omitempty"`
+	GroupsExists       *bool      `url:"groups.exists,omitempty"`
+	LastName           string     `url:"lastName,omitempty"`
+	LicenseType        string     `url:"licenseType,omitempty"`
+	PhoneNumber        string     `url:"phoneNumber,omitempty"`
+	Roles              string     `url:"roles,omitempty"`
+	Site               string     `url:"site,omitempty"`
+	Status             string     `url:"status,omitempty"`
+	Supervisors        string     `url:"supervisors,omitempty"`
+	SupervisorsExists  *bool      `url:"supervisors.exists,omitempty"`
+	TargetName         string     `url:"targetName,omitempty"`
+	WebLogin           string     `url:"webLogin,omitempty"`
+	ExternalKey        string     `url:"externalKey,omitempty"`
 	// Provider Options Object
 	SortBy    string `url:"sortBy,omitempty"`
 	SortOrder string `url:"sortOrder,omitempty"`
 }
 
 // PushPersonParams contains available API body parameters for the PushPerson method.
+// Supervisors replaces the supervisors of the person: an empty list removes them, and a nil list is
+// left out of the request so an update keeps the current supervisors.
 type PushPersonParams struct {
 	// Required Fields
-	TargetName  string    `json:"targetName"`
-	FirstName   string    `json:"firstName"`
-	LastName    string    `json:"lastName"`
-	Roles       []*string `json:"roles"`
-	LicenseType string    `json:"licenseType"`
-	Site        string    `json:"site"`
-	Language    string    `json:"language"`
-	Supervisors []*string `json:"supervisors"`
-	Timezone    string    `json:"timezone"`
-	WebLogin    string    `json:"webLogin"`
+	TargetName  string      `json:"targetName"`
+	FirstName   string      `json:"firstName"`
+	LastName    string      `json:"lastName"`
+	Roles       []*string   `json:"roles"`
+	LicenseType LicenseType `json:"licenseType"`
+	Site        string      `json:"site"`
+	Language    string      `json:"language"`
+	Supervisors []*string   `json:"supervisors"`
+	Timezone    string      `json:"timezone"`
+	WebLogin    string      `json:"webLogin"`
 	// Optional Fields
-	ID              string  `json:"id,omitempty"`
-	Status          string  `json:"status,omitempty"`
-	PhoneLogin      *string `json:"phoneLogin"`
-	PhonePin        string  `json:"phonePin,omitempty"`
-	ExternalKey     *string `json:"externalKey"`
-	ExternallyOwned *bool   `json:"externallyOwned"`
+	ID              string           `json:"id,omitempty"`
+	Status          Status           `json:"status,omitempty"`
+	PhoneLogin      Nullable[string] `json:"phoneLogin,omitempty"`
+	PhonePin        string           `json:"phonePin,omitempty"`
+	ExternalKey     Nullable[string] `json:"externalKey,omitempty"`
+	ExternallyOwned Nullable[bool]   `json:"externallyOwned,omitempty"`
 }
 
 // -------------------------------------------------------------------------------------------------
 // People Methods
 // -------------------------------------------------------------------------------------------------
 
+// Custom Marshaller for PushPersonParams to leave out nil supervisors, which would otherwise be sent as null.
+func (p PushPersonParams) MarshalJSON() ([]byte, error) {
+	type params PushPersonParams
+	if p.Supervisors != nil {
+		return json.Marshal(params(p))
+	}
+	return json.Marshal(&struct {
+		params
+		Supervisors []*string `json:"supervisors,omitempty"`
+	}{params: params(p)})
+}
+
 // Custom Unmarshaller for Person to handle embedded roles and supervisors
 // This is necessary because the JSON structure for roles and supervisors is nested within pagination objects.
 func (p *Person) UnmarshalJSON(data []byte) error {
-	// Define an alias to avoid recursion
-	type Alias Person
-	aux := &struct {
-		Roles struct {
-			Roles []*Role `json:"data"`
-		} `json:"roles"`
-		Supervisors struct {
-			People []*Person `json:"data"`
-		} `json:"supervisors,omitempty"`
-		*Alias
-	}{
-		Alias: (*Alias)(p),
-	}
-
-	// Unmarshal the JSON into the auxiliary struct
-	if err := json.Unmarshal(data, aux); err != nil {
+	var decoded personJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
 		return fmt.Errorf("failed to unmarshal Person: %w", err)
 	}
+	*p = *decoded.person()
+	return nil
+}
+
+// Custom Marshaller for Person to nest embedded roles and supervisors within pagination objects
+// This mirrors UnmarshalJSON, so a marshalled Person can be unmarshalled again without losing data.
+func (p Person) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Roles       *embeddedList[*Role]   `json:"roles,omitempty"`
+		Supervisors *embeddedList[*Person] `json:"supervisors,omitempty"`
+		personFields
+	}{
+		Roles:        newEmbeddedList(p.Roles),
+		Supervisors:  newEmbeddedList(p.Supervisors),
+		personFields: personFields(p),
+	})
+}
 
-	// Assign the extracted attributes
-	p.Roles = aux.Roles.Roles
-	p.Supervisors = aux.Supervisors.People
+// person returns the Person held by its JSON representation, taking its embedded collections out of their pagination objects.
+// The Person shares its fields with the JSON representation, so no copy is made.
+func (p *personJSON) person() *Person {
+	result := (*Person)(&p.personFields)
+	result.Roles = p.Roles.Data
+	result.Supervisors = convertAll(p.Supervisors.Data, (*personJSON).person)
+	return result
+}
 
-	return nil
+// String returns a compact, human-readable summary of the person, such as "Person jsmith (John Smith, ACTIVE)".
+func (p Person) String() string {
+	name := strings.TrimSpace(stringValue(p.FirstName) + " " + stringValue(p.LastName))
+	return formatSummary("Person", stringValue(p.TargetName), name, stringValue(p.Status))
+}
+
+// PushParams returns the parameters that push the person as it is, so an update can change selected fields
+// without clearing the others. Roles and supervisors are included when they were embedded in the person.
+func (p Person) PushParams() PushPersonParams {
+	params, _ := personPushParams(&p, identity, identity)
+	return params
 }
 
 // GetPerson retrieves a person in xMatters.
 // It requires the personId parameter to identify the specific person, and returns a Person object.
-// A URL parameter is added to the request URI to embed the roles and supervisors of the person in the response.
-func (xmatters *XMattersAPI) GetPerson(personId string) (Person, error) {
-	uri := buildURI(fmt.Sprintf("/people/%s", personId), struct {
-		Embed string `url:"embed"`
-	}{Embed: "roles,supervisors"})
+// A URL parameter is added to the request URI to embed the roles and supervisors of the person in the response,
+// and the remaining pages of embedded roles and supervisors are retrieved so that both lists are complete.
+// Optional GetOption values, such as WithEmbed, override the embedded objects and fields of the response.
+func (xmatters *XMattersAPI) GetPerson(personId string, opts ...GetOption) (Person, error) {
+	if err := validateIdentifier("person ID or target name", personId); err != nil {
+		return Person{}, err
+	}
+
+	uri := buildURI(fmt.Sprintf("/people/%s", pathSegment(personId)), parseGetOptions("roles,supervisors", opts))
 
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
@@ -176,15 +223,68 @@ func (xmatters *XMattersAPI) GetPerson(personId string) (Person, error) {
 		return Person{}, err
 	}
 
-	// Unmarshal the response into an Person struct.
-	var result Person
-	err = json.Unmarshal(resp, &result)
+	// Unmarshal the response into the JSON representation of a Person, which keeps the embedded pagination links.
+	var decoded personJSON
+	err = xmatters.decode(resp, &decoded)
 	if err != nil {
 		return Person{}, newUnmarshalError()
 	}
+	result := decoded.person()
+
+	// Fetch the remaining pages of embedded roles and supervisors
+	if result.Roles, err = getEmbeddedPages(xmatters, result.Roles, decoded.Roles.Links); err != nil {
+		return Person{}, err
+	}
+	if result.Supervisors, err = getEmbeddedPages(xmatters, result.Supervisors, decoded.Supervisors.Links); err != nil {
+		return Person{}, err
+	}
 
 	// Return the returned Person object.
-	return result, nil
+	return *result, nil
+}
+
+// GetPersonByExternalKey retrieves the person in xMatters with the given external key, such as the ID of the
+// person in a system of record, with their roles and supervisors embedded.
+// It returns a 404 XMattersError when no person has the key, and an error when more than one person has it.
+func (xmatters *XMattersAPI) GetPersonByExternalKey(externalKey string) (Person, error) {
+	if err := validateIdentifier("external key", externalKey); err != nil {
+		return Person{}, err
+	}
+	people, err := xmatters.GetPersonList(GetPeopleParams{Embed: "roles,supervisors", ExternalKey: externalKey})
+	if err != nil {
+		return Person{}, err
+	}
+	return findByExternalKey("person", "people", externalKey, people, func(person *Person) *string { return person.ExternalKey })
+}
+
+// findByExternalKey returns the only item whose external key matches exactly, in case the external key filter
+// of a list matches more loosely, such as without regard to case.
+func findByExternalKey[T any](singular, plural, externalKey string, items []*T, keyOf func(*T) *string) (T, error) {
+	var found []*T
+	for _, item := range items {
+		if item != nil && stringValue(keyOf(item)) == externalKey {
+			found = append(found, item)
+		}
+	}
+	switch len(found) {
+	case 0:
+		var none T
+		return none, XMattersError{
+			Code:    404,
+			Message: fmt.Sprintf("No %s has the external key %q", singular, externalKey),
+			Reason:  "Not Found",
+		}
+	case 1:
+		return *found[0], nil
+	}
+	var none T
+	return none, newValidationError(fmt.Sprintf("%d %s have the external key %q", len(found), plural, externalKey))
+}
+
+// CountPeople returns the number of people in xMatters matching the query parameters,
+// without retrieving the people themselves.
+func (xmatters *XMattersAPI) CountPeople(params GetPeopleParams) (int64, error) {
+	return xmatters.countResults("/people", params)
 }
 
 // GetPersonList retrieves a list of people in xMatters.
@@ -195,55 +295,63 @@ func (xmatters *XMattersAPI) GetPersonList(params GetPeopleParams) ([]*Person, e
 	// Use the GetPersonPaginationSet method to get all paginated results
 	personList, err := xmatters.GetPersonPaginationSet(uri)
 	if err != nil {
-		return []*Person{}, err
+		return personList, err
 	}
 
 	// Return the full list of People.
 	return personList, nil
 }
 
-// GetPersonPaginationSet is a recursive helper function that handles a paginated list of people.
-// It takes a URI as input and retrieves the paginated set from that URI.
-// It checks for additional pages and recursively fetches them until all pages are retrieved.
+// GetPersonPaginationSet retrieves a paginated list of people.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
 func (xmatters *XMattersAPI) GetPersonPaginationSet(uri string) ([]*Person, error) {
-	// Perform the API request with provided URI
-	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
-	if err != nil {
-		return []*Person{}, err
-	}
+	return getConvertedPaginationSet(xmatters, uri, (*personJSON).person)
+}
 
-	// Unmarshal the response into a PersonPagination struct.
-	var personPagination PersonPagination
-	err = json.Unmarshal(resp, &personPagination)
-	if err != nil {
-		return []*Person{}, newUnmarshalError()
-	}
+// GetPersonDevices retrieves the devices owned by a person in xMatters.
+// It requires the personId parameter to identify the specific person, and returns a slice of Device objects.
+func (xmatters *XMattersAPI) GetPersonDevices(personId string) ([]*Device, error) {
+	uri := buildURI(fmt.Sprintf("/people/%s/devices", pathSegment(personId)), struct {
+		Embed string `url:"embed"`
+	}{Embed: "timeframes"})
 
-	// Assign people to be returned
-	personList := personPagination.People
-
-	// Check for additional paginated results
-	if personPagination.Pagination.Links.Next != nil {
-		// Remove defaultBasePath (/api/xm/1) from the next URI
-		nextUri := strings.ReplaceAll(*personPagination.Pagination.Links.Next, defaultBasePath, "")
-		// Use recursion to get the next set of results
-		nextSet, err := xmatters.GetPersonPaginationSet(nextUri)
-		if err != nil {
-			return []*Person{}, err
-		}
-		personList = append(personList, nextSet...)
+	// Use the GetDevicePaginationSet method to get all paginated results
+	deviceList, err := xmatters.GetDevicePaginationSet(uri)
+	if err != nil {
+		return deviceList, err
 	}
 
-	// Return the fully concatenated list of people from all paginated results
-	return personList, nil
+	// Return the full list of the person's Devices.
+	return deviceList, nil
 }
 
 // PushPerson either creates a new person in xMatters or modifies an existing person.
 // It requires the PushPersonParams struct containing the person details.
 // It returns the created or modified Person object.
 // If the params.ID is provided it updates the existing person; otherwise, it creates a new one.
-func (xmatters *XMattersAPI) PushPerson(params PushPersonParams) (Person, error) {
+// With IfUnchanged, an update fails with ErrConflict when the person was changed since it was read.
+// WithExternalKey sets the external key of the person and marks them as externally owned.
+func (xmatters *XMattersAPI) PushPerson(params PushPersonParams, opts ...PushOption) (Person, error) {
 	uri := buildURI("/people", nil) // The URI for creating or modifying a Person in xMatters
+	options := parsePushOptions(opts)
+	if options.externalKey != "" {
+		params.ExternalKey = NewNullable(options.externalKey)
+		params.ExternallyOwned = NewNullable(true)
+	}
+
+	// Validate the enumerated fields before sending the request
+	if params.LicenseType != "" && !params.LicenseType.IsValid() {
+		return Person{}, newValidationError(fmt.Sprintf("invalid license type %q", params.LicenseType))
+	}
+	if params.Status != "" && !params.Status.IsValid() {
+		return Person{}, newValidationError(fmt.Sprintf("invalid person status %q", params.Status))
+	}
+
+	// Check that the person is unchanged since it was read
+	if err := checkUnchanged(params.ID, options, xmatters.GetPerson, unchangedPerson); err != nil {
+		return Person{}, err
+	}
 
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
@@ -253,7 +361,7 @@ func (xmatters *XMattersAPI) PushPerson(params PushPersonParams) (Person, error)
 
 	// Unmarshal the response into a Person struct.
 	var result Person
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return Person{}, newUnmarshalError()
 	}
@@ -266,7 +374,11 @@ func (xmatters *XMattersAPI) PushPerson(params PushPersonParams) (Person, error)
 // It requires the personId parameter to identify the specific person to be deleted.
 // It returns an error if the deletion fails.
 func (xmatters *XMattersAPI) DeletePerson(personId *string) error {
-	uri := buildURI(fmt.Sprintf("/people/%s", *personId), nil)
+	if err := validateIdentifier("person ID or target name", stringValue(personId)); err != nil {
+		return err
+	}
+
+	uri := buildURI(fmt.Sprintf("/people/%s", pathSegment(stringValue(personId))), nil)
 
 	// Perform the API request.
 	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
@@ -294,7 +406,7 @@ func (xmatters *XMattersAPI) GetUserQuotas() (UserQuotas, error) {
 
 	// Unmarshal the response into an UserQuotas struct.
 	var result UserQuotas
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return UserQuotas{}, newUnmarshalError()
 	}
@@ -0,0 +1,255 @@
+package xmatters
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Plan Structs
+// -------------------------------------------------------------------------------------------------
+
+// Plan represents a communication plan (workflow) in xMatters.
+type Plan struct {
+	ID              *string          `json:"id"`
+	Name            *string          `json:"name"`
+	Description     *string          `json:"description,omitempty"`
+	PlanType        *string          `json:"planType,omitempty"`
+	Enabled         *bool            `json:"enabled,omitempty"`
+	Editable        *bool            `json:"editable,omitempty"`
+	AccessibleByAll *bool            `json:"accessibleByAll,omitempty"`
+	FloodControl    *bool            `json:"floodControl,omitempty"`
+	Creator         *PersonReference `json:"creator,omitempty"`
+	Created         *Timestamp       `json:"created,omitempty"`
+}
+
+// PlanPagination contains a paginated list of plans.
+// It extends the Pagination struct containing links to additional pages.
+type PlanPagination struct {
+	*Pagination
+	Plans []*Plan `json:"data"`
+}
+
+// PlanReference represents a shorthand version of a communication plan in xMatters.
+type PlanReference struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// PlanType represents the type of a communication plan in xMatters.
+type PlanType string
+
+const (
+	// PlanTypeUser is a workflow created and maintained by users.
+	PlanTypeUser PlanType = "USER"
+	// PlanTypeRelay is a plan that relays events from a built-in integration.
+	PlanTypeRelay PlanType = "RELAY"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// GetPlansParams contains available API query parameters for the GetPlanList method.
+type GetPlansParams struct {
+	Search   string `url:"search,omitempty"`
+	PlanType string `url:"planType,omitempty"`
+	Enabled  *bool  `url:"enabled,omitempty"`
+}
+
+// PushPlanParams contains available API body parameters for the PushPlan method.
+type PushPlanParams struct {
+	// Required Fields
+	Name string `json:"name"`
+	// Optional Fields
+	ID              string   `json:"id,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	PlanType        PlanType `json:"planType,omitempty"`
+	Enabled         *bool    `json:"enabled,omitempty"`
+	AccessibleByAll *bool    `json:"accessibleByAll,omitempty"`
+	FloodControl    *bool    `json:"floodControl,omitempty"`
+}
+
+// planEnabledParams is the body of a request that modifies only the enabled state of a plan.
+type planEnabledParams struct {
+	ID      string `json:"id"`
+	Enabled bool   `json:"enabled"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Plan Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetPlan retrieves a communication plan in xMatters.
+// It requires the planId parameter, which may be the plan's UUID or name, and returns a Plan object.
+func (xmatters *XMattersAPI) GetPlan(planId string) (Plan, error) {
+	if err := validateIdentifier("plan ID or name", planId); err != nil {
+		return Plan{}, err
+	}
+
+	uri := buildURI(fmt.Sprintf("/plans/%s", pathSegment(planId)), nil)
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	// Unmarshal the response into a Plan struct.
+	var result Plan
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Plan{}, newUnmarshalError()
+	}
+
+	// Return the returned Plan object.
+	return result, nil
+}
+
+// GetPlanList retrieves a list of communication plans in xMatters.
+// It accepts optional query parameters to filter the results and returns a slice of Plan objects.
+func (xmatters *XMattersAPI) GetPlanList(params GetPlansParams) ([]*Plan, error) {
+	uri := buildURI("/plans", params) // The URI including any Query Parameters
+
+	// Use the GetPlanPaginationSet method to get all paginated results
+	planList, err := xmatters.GetPlanPaginationSet(uri)
+	if err != nil {
+		return planList, err
+	}
+
+	// Return the full list of Plans.
+	return planList, nil
+}
+
+// GetPlanPaginationSet retrieves a paginated list of plans.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetPlanPaginationSet(uri string) ([]*Plan, error) {
+	return getPaginationSet[Plan](xmatters, uri)
+}
+
+// PushPlan either creates a new communication plan in xMatters or modifies an existing plan.
+// It requires the PushPlanParams struct containing the plan details.
+// It returns the created or modified Plan object.
+// If the params.ID is provided it updates the existing plan; otherwise, it creates a new one.
+func (xmatters *XMattersAPI) PushPlan(params PushPlanParams) (Plan, error) {
+	uri := buildURI("/plans", nil) // The URI including any Query Parameters
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	// Unmarshal the response into a Plan struct.
+	var result Plan
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Plan{}, newUnmarshalError()
+	}
+
+	// Return the returned Plan object.
+	return result, nil
+}
+
+// EnablePlan enables an existing communication plan in xMatters.
+// It requires the planId parameter to identify the specific plan, and returns the modified Plan object.
+func (xmatters *XMattersAPI) EnablePlan(planId string) (Plan, error) {
+	return xmatters.SetPlanEnabled(planId, true)
+}
+
+// DisablePlan disables an existing communication plan in xMatters, preventing its forms from being used.
+// It requires the planId parameter to identify the specific plan, and returns the modified Plan object.
+func (xmatters *XMattersAPI) DisablePlan(planId string) (Plan, error) {
+	return xmatters.SetPlanEnabled(planId, false)
+}
+
+// SetPlanEnabled enables or disables an existing communication plan in xMatters without modifying any other fields.
+// Only the ID and enabled state of the plan are sent, so concurrent changes to the other fields are kept; a plan name is
+// resolved to the ID of the plan first. It returns the modified Plan object.
+func (xmatters *XMattersAPI) SetPlanEnabled(planId string, enabled bool) (Plan, error) {
+	if err := validateIdentifier("plan ID or name", planId); err != nil {
+		return Plan{}, err
+	}
+	if !IsUUID(planId) {
+		current, err := xmatters.GetPlan(planId)
+		if err != nil {
+			return Plan{}, err
+		}
+		planId = stringValue(current.ID)
+	}
+
+	uri := buildURI("/plans", nil) // The URI for modifying a Plan in xMatters
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, planEnabledParams{ID: planId, Enabled: enabled})
+	if err != nil {
+		return Plan{}, err
+	}
+
+	// Unmarshal the response into a Plan struct.
+	var result Plan
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Plan{}, newUnmarshalError()
+	}
+
+	// Return the modified Plan object.
+	return result, nil
+}
+
+// DeletePlan deletes a communication plan in xMatters.
+// It requires the planId parameter to identify the specific plan to be deleted.
+// It returns an error if the deletion fails.
+func (xmatters *XMattersAPI) DeletePlan(planId string) error {
+	uri := buildURI(fmt.Sprintf("/plans/%s", pathSegment(planId)), nil)
+
+	// Perform the API request.
+	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
+	if err != nil {
+		return err
+	}
+
+	// Return
+	return nil
+}
+
+// ExportPlan exports a communication plan from xMatters as a ZIP archive.
+// It requires the planId parameter to identify the specific plan and streams the archive to w as it is received,
+// so workflows can be promoted between instances without holding the archive in memory.
+// If the export fails part way, w may hold a partial archive.
+func (xmatters *XMattersAPI) ExportPlan(planId string, w io.Writer) error {
+	uri := buildURI(fmt.Sprintf("/plans/%s/export", pathSegment(planId)), nil)
+
+	// Perform the API request, streaming the archive to the provided writer.
+	err := xmatters.send(http.MethodGet, *xmatters.BaseURL+uri, "", ContentZIP, nil, w)
+	if err != nil {
+		return err
+	}
+
+	// Return
+	return nil
+}
+
+// ImportPlan imports a communication plan into xMatters from a ZIP archive created by ExportPlan.
+// It reads the archive from r and returns the imported Plan object.
+func (xmatters *XMattersAPI) ImportPlan(r io.Reader) (Plan, error) {
+	uri := buildURI("/plans/import", nil) // The URI for importing a Plan in xMatters
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentZIP, r)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	// Unmarshal the response into a Plan struct.
+	var result Plan
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Plan{}, newUnmarshalError()
+	}
+
+	// Return the imported Plan object.
+	return result, nil
+}
@@ -0,0 +1,50 @@
+package xmatters
+
+// Ptr returns a pointer to a copy of the provided value.
+// It is useful for populating the optional pointer fields of parameter structs.
+func Ptr[T any](value T) *T {
+	return &value
+}
+
+// Helper function to get a string pointer
+func StringPtr(value string) *string {
+	return &value
+}
+
+// Helper function to get a bool pointer
+func BoolPtr(value bool) *bool {
+	return &value
+}
+
+// Helper function to get an int32 pointer
+func Int32Ptr(value int32) *int32 {
+	return &value
+}
+
+// Helper function to get an int64 pointer
+func Int64Ptr(value int64) *int64 {
+	return &value
+}
+
+// Helper function to get a float64 pointer
+func Float64Ptr(value float64) *float64 {
+	return &value
+}
+
+// Value safely dereferences a pointer, returning defaultValue if the pointer is nil.
+func Value[T any](pointer *T, defaultValue T) T {
+	if pointer == nil {
+		return defaultValue
+	}
+	return *pointer
+}
+
+// Helper function to get the value of a string pointer, or an empty string if it is nil
+func stringValue(value *string) string {
+	return Value(value, "")
+}
+
+// Helper function to get the value of an int64 pointer, or zero if it is nil
+func int64Value(value *int64) int64 {
+	return Value(value, 0)
+}
@@ -0,0 +1,112 @@
+package xmatters
+
+import (
+	"sync"
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Progress Structs
+// -------------------------------------------------------------------------------------------------
+
+// Progress describes how far a long-running operation, such as a paginated list or a roster
+// reconciliation, has got.
+type Progress struct {
+	// Operation names the operation, such as "GetPersonPaginationSet", "PushGroupRoster", or "Export".
+	Operation string
+	// Done is the number of items processed so far.
+	Done int
+	// Total is the number of items the operation expects to process, or zero when it is not known yet.
+	Total int
+	// Page is the number of the last page retrieved by a paginated list, or zero for other operations.
+	Page int
+	// Elapsed is the time since the operation started.
+	Elapsed time.Duration
+	// ETA is the estimated time until the operation completes, or zero when it cannot be estimated.
+	ETA time.Duration
+}
+
+// ProgressReporter receives the progress of long-running operations, such as to draw a progress bar.
+// ReportProgress is called after each page or item, and may be called concurrently by batch operations
+// and by operations running in parallel on a shared client.
+type ProgressReporter interface {
+	ReportProgress(progress Progress)
+}
+
+// ProgressFunc adapts a function to the ProgressReporter interface.
+type ProgressFunc func(progress Progress)
+
+// progressTracker accumulates the progress of a single operation and reports each update.
+// A nil *progressTracker ignores updates, so operations do not need to check for a reporter.
+type progressTracker struct {
+	reporter  ProgressReporter
+	operation string
+	started   time.Time
+
+	mu    sync.Mutex
+	done  int
+	total int
+	page  int
+}
+
+// -------------------------------------------------------------------------------------------------
+// Progress Methods
+// -------------------------------------------------------------------------------------------------
+
+// ReportProgress calls f with the progress.
+func (f ProgressFunc) ReportProgress(progress Progress) {
+	f(progress)
+}
+
+// startProgress starts tracking an operation expected to process total items, or an unknown number of
+// items when total is zero. It returns nil when the client has no ProgressReporter.
+func (xmatters *XMattersAPI) startProgress(operation string, total int) *progressTracker {
+	if xmatters.progress == nil {
+		return nil
+	}
+	return &progressTracker{reporter: xmatters.progress, operation: operation, started: time.Now(), total: total}
+}
+
+// advance records n more processed items and reports the progress.
+func (t *progressTracker) advance(n int) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	t.done += n
+	progress := t.progress()
+	t.mu.Unlock()
+	t.reporter.ReportProgress(progress)
+}
+
+// pageDone records the retrieval of a page, the number of items retrieved so far, and the total number
+// of items reported by the page, and reports the progress.
+func (t *progressTracker) pageDone(page, done int, total *int64) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	t.page, t.done = page, done
+	if total != nil {
+		t.total = int(*total)
+	}
+	progress := t.progress()
+	t.mu.Unlock()
+	t.reporter.ReportProgress(progress)
+}
+
+// progress returns the current progress, estimating the time remaining from the average time per item.
+// The caller must hold the lock.
+func (t *progressTracker) progress() Progress {
+	progress := Progress{
+		Operation: t.operation,
+		Done:      t.done,
+		Total:     t.total,
+		Page:      t.page,
+		Elapsed:   time.Since(t.started),
+	}
+	if t.done > 0 && t.total > t.done {
+		progress.ETA = progress.Elapsed * time.Duration(t.total-t.done) / time.Duration(t.done)
+	}
+	return progress
+}
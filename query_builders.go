@@ -0,0 +1,278 @@
+package xmatters
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Query Builder Structs
+// -------------------------------------------------------------------------------------------------
+
+// SortOrder represents the direction in which list results are sorted.
+type SortOrder string
+
+const (
+	// SortAscending sorts results in ascending order.
+	SortAscending SortOrder = "ASCENDING"
+	// SortDescending sorts results in descending order.
+	SortDescending SortOrder = "DESCENDING"
+)
+
+// PeopleSortField represents a field people can be sorted by.
+type PeopleSortField string
+
+const (
+	// SortPeopleByFirstLastName sorts people by first name, then last name.
+	SortPeopleByFirstLastName PeopleSortField = "FIRST_LAST_NAME"
+	// SortPeopleByLastFirstName sorts people by last name, then first name.
+	SortPeopleByLastFirstName PeopleSortField = "LAST_FIRST_NAME"
+	// SortPeopleByTargetName sorts people by target name.
+	SortPeopleByTargetName PeopleSortField = "TARGET_NAME"
+	// SortPeopleBySiteName sorts people by the name of their site.
+	SortPeopleBySiteName PeopleSortField = "SITE_NAME"
+)
+
+// GroupSortField represents a field groups can be sorted by.
+type GroupSortField string
+
+const (
+	// SortGroupsByName sorts groups by target name.
+	SortGroupsByName GroupSortField = "NAME"
+	// SortGroupsByStatus sorts groups by status.
+	SortGroupsByStatus GroupSortField = "STATUS"
+)
+
+// PeopleQuery builds GetPeopleParams, taking care of the comma-separated list conventions of the API.
+// Create one with NewPeopleQuery and pass the result of Params to GetPersonList.
+type PeopleQuery struct {
+	params GetPeopleParams
+}
+
+// GroupQuery builds GetGroupsParams, taking care of the comma-separated list conventions of the API.
+// Create one with NewGroupQuery and pass the result of Params to GetGroupList.
+type GroupQuery struct {
+	params GetGroupsParams
+}
+
+// -------------------------------------------------------------------------------------------------
+// People Query Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewPeopleQuery returns an empty PeopleQuery, which matches all people.
+func NewPeopleQuery() *PeopleQuery {
+	return &PeopleQuery{}
+}
+
+// Params returns the GetPeopleParams built by the query.
+func (q *PeopleQuery) Params() GetPeopleParams {
+	return q.params
+}
+
+// Search matches people against the provided search terms.
+func (q *PeopleQuery) Search(terms ...string) *PeopleQuery {
+	q.params.Terms = strings.Join(terms, " ")
+	return q
+}
+
+// SearchFields limits the search to the provided fields, such as SearchFirstName or SearchTargetName.
+func (q *PeopleQuery) SearchFields(fields ...SearchField) *PeopleQuery {
+	q.params.Fields = fields
+	return q
+}
+
+// WithOperand sets how multiple search terms are combined.
+func (q *PeopleQuery) WithOperand(operand SearchOperand) *PeopleQuery {
+	q.params.Operand = operand
+	return q
+}
+
+// WithStatus matches people with the provided status.
+func (q *PeopleQuery) WithStatus(status Status) *PeopleQuery {
+	q.params.Status = string(status)
+	return q
+}
+
+// WithGroups matches people that are members of any of the provided groups, by ID or target name.
+func (q *PeopleQuery) WithGroups(groups ...string) *PeopleQuery {
+	q.params.Groups = joinValues(groups)
+	return q
+}
+
+// WithRoles matches people that have any of the provided roles.
+func (q *PeopleQuery) WithRoles(roles ...string) *PeopleQuery {
+	q.params.Roles = joinValues(roles)
+	return q
+}
+
+// WithSite matches people assigned to the provided site, by ID or name.
+func (q *PeopleQuery) WithSite(site string) *PeopleQuery {
+	q.params.Site = site
+	return q
+}
+
+// WithSupervisors matches people supervised by any of the provided people, by ID or target name.
+func (q *PeopleQuery) WithSupervisors(supervisors ...string) *PeopleQuery {
+	q.params.Supervisors = joinValues(supervisors)
+	return q
+}
+
+// WithLicenseType matches people with the provided license type.
+func (q *PeopleQuery) WithLicenseType(licenseType LicenseType) *PeopleQuery {
+	q.params.LicenseType = string(licenseType)
+	return q
+}
+
+// WithTargetName matches the person with the provided target name.
+func (q *PeopleQuery) WithTargetName(targetName string) *PeopleQuery {
+	q.params.TargetName = targetName
+	return q
+}
+
+// WithWebLogin matches the person with the provided web login.
+func (q *PeopleQuery) WithWebLogin(webLogin string) *PeopleQuery {
+	q.params.WebLogin = webLogin
+	return q
+}
+
+// WithEmailAddress matches people with an email device for the provided address.
+func (q *PeopleQuery) WithEmailAddress(emailAddress string) *PeopleQuery {
+	q.params.EmailAddress = emailAddress
+	return q
+}
+
+// WithPhoneNumber matches people with a voice or SMS device for the provided phone number.
+func (q *PeopleQuery) WithPhoneNumber(phoneNumber string) *PeopleQuery {
+	q.params.PhoneNumber = phoneNumber
+	return q
+}
+
+// WithGroupsExist matches people that are, or are not, members of at least one group.
+func (q *PeopleQuery) WithGroupsExist(exists bool) *PeopleQuery {
+	q.params.GroupsExists = BoolPtr(exists)
+	return q
+}
+
+// WithSupervisorsExist matches people that do, or do not, have at least one supervisor.
+func (q *PeopleQuery) WithSupervisorsExist(exists bool) *PeopleQuery {
+	q.params.SupervisorsExists = BoolPtr(exists)
+	return q
+}
+
+// WithDevicesExist matches people that do, or do not, have at least one device.
+func (q *PeopleQuery) WithDevicesExist(exists bool) *PeopleQuery {
+	q.params.DevicesExists = BoolPtr(exists)
+	return q
+}
+
+// CreatedBetween matches people created within the provided time range. A zero time leaves that end open.
+func (q *PeopleQuery) CreatedBetween(from, to time.Time) *PeopleQuery {
+	q.params.CreatedFrom, q.params.CreatedTo = TimeRange(from, to)
+	return q
+}
+
+// Embed includes the provided objects, such as roles or devices, in each person.
+func (q *PeopleQuery) Embed(objects ...string) *PeopleQuery {
+	q.params.Embed = joinValues(objects)
+	return q
+}
+
+// SortBy sorts the results by the provided field and order.
+func (q *PeopleQuery) SortBy(field PeopleSortField, order SortOrder) *PeopleQuery {
+	q.params.SortBy = string(field)
+	q.params.SortOrder = string(order)
+	return q
+}
+
+// -------------------------------------------------------------------------------------------------
+// Group Query Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewGroupQuery returns an empty GroupQuery, which matches all groups.
+func NewGroupQuery() *GroupQuery {
+	return &GroupQuery{}
+}
+
+// Params returns the GetGroupsParams built by the query.
+func (q *GroupQuery) Params() GetGroupsParams {
+	return q.params
+}
+
+// Search matches groups against the provided search terms.
+func (q *GroupQuery) Search(terms ...string) *GroupQuery {
+	q.params.Terms = strings.Join(terms, " ")
+	return q
+}
+
+// SearchFields limits the search to the provided fields, such as SearchName or SearchDescription.
+func (q *GroupQuery) SearchFields(fields ...SearchField) *GroupQuery {
+	q.params.Fields = fields
+	return q
+}
+
+// WithOperand sets how multiple search terms are combined.
+func (q *GroupQuery) WithOperand(operand SearchOperand) *GroupQuery {
+	q.params.Operand = operand
+	return q
+}
+
+// WithStatus matches groups with the provided status.
+func (q *GroupQuery) WithStatus(status Status) *GroupQuery {
+	q.params.Status = string(status)
+	return q
+}
+
+// WithGroupType matches groups of the provided type.
+func (q *GroupQuery) WithGroupType(groupType GroupType) *GroupQuery {
+	q.params.GroupType = string(groupType)
+	return q
+}
+
+// WithMembers matches groups containing any of the provided members, by ID or target name.
+func (q *GroupQuery) WithMembers(members ...string) *GroupQuery {
+	q.params.Members = joinValues(members)
+	return q
+}
+
+// WithMembersExist matches groups that do, or do not, have at least one member.
+func (q *GroupQuery) WithMembersExist(exists bool) *GroupQuery {
+	q.params.MemberExists = strconv.FormatBool(exists)
+	return q
+}
+
+// WithSites matches groups assigned to any of the provided sites.
+func (q *GroupQuery) WithSites(sites ...string) *GroupQuery {
+	q.params.Sites = joinValues(sites)
+	return q
+}
+
+// WithSupervisors matches groups supervised by any of the provided people, by ID or target name.
+func (q *GroupQuery) WithSupervisors(supervisors ...string) *GroupQuery {
+	q.params.Supervisors = joinValues(supervisors)
+	return q
+}
+
+// Embed includes the provided objects, such as supervisors or services, in each group.
+func (q *GroupQuery) Embed(objects ...string) *GroupQuery {
+	q.params.Embed = joinValues(objects)
+	return q
+}
+
+// SortBy sorts the results by the provided field and order.
+func (q *GroupQuery) SortBy(field GroupSortField, order SortOrder) *GroupQuery {
+	q.params.SortBy = string(field)
+	q.params.SortOrder = string(order)
+	return q
+}
+
+// joinValues joins list values with commas, dropping empty values and surrounding whitespace.
+func joinValues(values []string) string {
+	cleaned := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			cleaned = append(cleaned, value)
+		}
+	}
+	return strings.Join(cleaned, ",")
+}
@@ -0,0 +1,179 @@
+package xmatters
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Recipient Resolution Structs
+// -------------------------------------------------------------------------------------------------
+
+// ResolvedRecipients contains the concrete people and devices that a prospective event would target.
+type ResolvedRecipients struct {
+	People     []*Person              `json:"people"`
+	Devices    []*Device              `json:"devices"`
+	Unresolved []*UnresolvedRecipient `json:"unresolved,omitempty"`
+}
+
+// UnresolvedRecipient describes a recipient that could not be expanded into people and devices.
+type UnresolvedRecipient struct {
+	Recipient *EventRecipient `json:"recipient"`
+	Reason    string          `json:"reason"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Recipient Resolution Methods
+// -------------------------------------------------------------------------------------------------
+
+// ResolveRecipients reports which people and devices would be targeted by an event with the provided parameters.
+// People are expanded into their active devices and groups are expanded recursively through their rosters,
+// so the result is the full set of recipients that could be notified. On-call schedules are not evaluated,
+// and dynamic teams and subscriptions are reported as unresolved because their membership is determined at trigger time.
+func (xmatters *XMattersAPI) ResolveRecipients(params TriggerEventParams) (ResolvedRecipients, error) {
+	resolver := &recipientResolver{
+		xmatters: xmatters,
+		people:   make(map[string]bool),
+		devices:  make(map[string]bool),
+		groups:   make(map[string]bool),
+	}
+
+	// Resolve each of the event recipients
+	for _, recipient := range params.Recipients {
+		if err := resolver.resolve(recipient); err != nil {
+			return ResolvedRecipients{}, err
+		}
+	}
+
+	// Return the resolved people and devices
+	return resolver.result, nil
+}
+
+// recipientResolver tracks the recipients visited while resolving an event's recipients.
+type recipientResolver struct {
+	xmatters *XMattersAPI
+	result   ResolvedRecipients
+	people   map[string]bool
+	devices  map[string]bool
+	groups   map[string]bool
+}
+
+// resolve expands a single event recipient into people and devices.
+func (r *recipientResolver) resolve(recipient *EventRecipient) error {
+	identifier := recipient.ID
+	if identifier == "" {
+		identifier = recipient.TargetName
+	}
+	if identifier == "" {
+		r.unresolved(recipient, "recipient has no ID or target name")
+		return nil
+	}
+
+	switch strings.ToUpper(recipient.RecipientType) {
+	case "PERSON":
+		return r.resolvePerson(identifier)
+	case "GROUP":
+		return r.resolveGroup(identifier)
+	case "DEVICE":
+		return r.resolveDevice(identifier)
+	case "DYNAMIC_TEAM":
+		r.unresolved(recipient, "dynamic team membership is evaluated when the event is triggered")
+		return nil
+	case "":
+		// Without a recipient type, try a person first and then a group
+		err := r.resolvePerson(identifier)
+		if !isNotFound(err) {
+			return err
+		}
+		err = r.resolveGroup(identifier)
+		if isNotFound(err) {
+			r.unresolved(recipient, "no person or group matches the recipient")
+			return nil
+		}
+		return err
+	default:
+		r.unresolved(recipient, fmt.Sprintf("unsupported recipient type %s", recipient.RecipientType))
+		return nil
+	}
+}
+
+// resolvePerson adds a person and their active devices to the result.
+func (r *recipientResolver) resolvePerson(personId string) error {
+	person, err := r.xmatters.GetPerson(personId)
+	if err != nil {
+		return err
+	}
+	if r.people[stringValue(person.ID)] {
+		return nil
+	}
+	r.people[stringValue(person.ID)] = true
+	r.result.People = append(r.result.People, &person)
+
+	// Add each of the person's active devices
+	devices, err := r.xmatters.GetPersonDevices(stringValue(person.ID))
+	if err != nil {
+		return err
+	}
+	for _, device := range devices {
+		if stringValue(device.Status) == "ACTIVE" {
+			r.addDevice(device)
+		}
+	}
+	return nil
+}
+
+// resolveGroup expands a group roster, recursing into nested groups.
+func (r *recipientResolver) resolveGroup(groupId string) error {
+	group, err := r.xmatters.GetGroup(groupId)
+	if err != nil {
+		return err
+	}
+	if r.groups[stringValue(group.ID)] {
+		return nil
+	}
+	r.groups[stringValue(group.ID)] = true
+
+	// Resolve each member of the group roster
+	roster, err := r.xmatters.GetGroupRoster(stringValue(group.ID))
+	if err != nil {
+		return err
+	}
+	for _, member := range roster.Members {
+		memberRecipient := &EventRecipient{ID: stringValue(member.ID), RecipientType: stringValue(member.MemberType)}
+		if err := r.resolve(memberRecipient); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveDevice adds a single device to the result.
+func (r *recipientResolver) resolveDevice(deviceId string) error {
+	device, err := r.xmatters.GetDevice(deviceId)
+	if err != nil {
+		return err
+	}
+	r.addDevice(&device)
+	return nil
+}
+
+// addDevice adds a device to the result if it has not been seen before.
+func (r *recipientResolver) addDevice(device *Device) {
+	if r.devices[stringValue(device.ID)] {
+		return
+	}
+	r.devices[stringValue(device.ID)] = true
+	r.result.Devices = append(r.result.Devices, device)
+}
+
+// unresolved records a recipient that could not be expanded.
+func (r *recipientResolver) unresolved(recipient *EventRecipient, reason string) {
+	r.result.Unresolved = append(r.result.Unresolved, &UnresolvedRecipient{Recipient: recipient, Reason: reason})
+}
+
+// isNotFound reports whether an error is an xMatters 404 response.
+func isNotFound(err error) bool {
+	var xmerr XMattersError
+	return errors.As(err, &xmerr) && xmerr.Code == 404
+}
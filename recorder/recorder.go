@@ -0,0 +1,264 @@
+// Package recorder provides an http.RoundTripper that records xMatters API interactions to fixture files
+// and replays them, so automation built on xmatters-go can be regression tested without a live instance.
+//
+// Recorded fixtures only contain the method, path, query, and body of each request and the status code,
+// content type, and body of each response. Credentials and the instance hostname are never written, and
+// sanitizers can redact personal data, such as email addresses and phone numbers, from the bodies.
+//
+// Usage:
+//
+//	// Record against a live instance once, then replay the fixture in tests
+//	rec, err := recorder.New("testdata/people.json", recorder.ModeReplayOrRecord,
+//	    recorder.WithSanitizers(recorder.RedactJSONFields("emailAddress", "phoneNumber")))
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//
+//	client, err := xmatters.NewWithToken(&hostname, &token, xmatters.WithHTTPClient(rec.Client()))
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Recorder Structs
+// -------------------------------------------------------------------------------------------------
+
+// Mode controls whether a Recorder replays recorded interactions or records new ones.
+type Mode int
+
+const (
+	// ModeReplay serves every request from the fixture file and fails requests that were not recorded.
+	ModeReplay Mode = iota
+	// ModeRecord sends every request to the live API and records the interactions, replacing the fixture file.
+	ModeRecord
+	// ModeReplayOrRecord replays the fixture file when it exists and records it otherwise.
+	ModeReplayOrRecord
+)
+
+// ErrNoInteraction is returned in replay mode for a request that does not match any unused recorded interaction.
+var ErrNoInteraction = errors.New("recorder: no recorded interaction matches the request")
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the recorded part of an API request. URL contains only the path and query.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is the recorded part of an API response.
+type Response struct {
+	StatusCode  int    `json:"statusCode"`
+	ContentType string `json:"contentType,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+// Fixture is the content of a fixture file.
+type Fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Sanitizer modifies an interaction before it is recorded, such as to redact personal data.
+type Sanitizer func(*Interaction)
+
+// RecorderOption is a functional option for configuring a Recorder.
+type RecorderOption func(*Recorder)
+
+// Recorder is an http.RoundTripper that records or replays API interactions.
+// A Recorder is safe for concurrent use; replayed interactions are matched in recorded order.
+type Recorder struct {
+	path       string
+	mode       Mode
+	transport  http.RoundTripper
+	sanitizers []Sanitizer
+
+	mu       sync.Mutex
+	fixture  *Fixture
+	replayed []bool
+}
+
+// -------------------------------------------------------------------------------------------------
+// Recorder Methods
+// -------------------------------------------------------------------------------------------------
+
+// WithTransport sets the transport used to reach the live API while recording.
+// http.DefaultTransport is used by default.
+func WithTransport(transport http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithSanitizers adds sanitizers that are applied to every interaction before it is recorded.
+func WithSanitizers(sanitizers ...Sanitizer) RecorderOption {
+	return func(r *Recorder) {
+		r.sanitizers = append(r.sanitizers, sanitizers...)
+	}
+}
+
+// New returns a Recorder for the fixture file at path. In replay mode the fixture file is loaded immediately.
+func New(path string, mode Mode, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, transport: http.DefaultTransport, fixture: &Fixture{}}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeReplayOrRecord {
+		r.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		}
+	}
+	if r.mode != ModeReplay {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("recorder: reading fixture: %w", err)
+	}
+	if err := json.Unmarshal(data, r.fixture); err != nil {
+		return nil, fmt.Errorf("recorder: decoding fixture %s: %w", path, err)
+	}
+	r.replayed = make([]bool, len(r.fixture.Interactions))
+	return r, nil
+}
+
+// Client returns an *http.Client that sends its requests through the Recorder,
+// for use with the xmatters.WithHTTPClient option.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Recording reports whether the Recorder is recording, rather than replaying, interactions.
+func (r *Recorder) Recording() bool {
+	return r.mode == ModeRecord
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	recorded := Request{Method: req.Method, URL: req.URL.RequestURI(), Body: body}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+	return r.record(req, recorded)
+}
+
+// Stop writes the recorded interactions to the fixture file. It does nothing in replay mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data, err := json.MarshalIndent(r.fixture, "", "  ")
+	if err != nil {
+		return fmt.Errorf("recorder: encoding fixture: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("recorder: creating fixture directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("recorder: writing fixture: %w", err)
+	}
+	return nil
+}
+
+// replay returns the response of the first unused interaction matching the request.
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.fixture.Interactions {
+		if r.replayed[i] || interaction.Request.Method != recorded.Method || interaction.Request.URL != recorded.URL {
+			continue
+		}
+		r.replayed[i] = true
+		return newResponse(req, interaction.Response), nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, recorded.Method, recorded.URL)
+}
+
+// record sends the request to the live API and records the sanitized interaction.
+func (r *Recorder) record(req *http.Request, recorded Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        body,
+		},
+	}
+	for _, sanitize := range r.sanitizers {
+		sanitize(interaction)
+	}
+
+	r.mu.Lock()
+	r.fixture.Interactions = append(r.fixture.Interactions, interaction)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// readBody reads a request or response body and replaces it with an unread copy.
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return "", fmt.Errorf("recorder: reading body: %w", err)
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return string(data), nil
+}
+
+// newResponse builds an *http.Response from a recorded response.
+func newResponse(req *http.Request, recorded Response) *http.Response {
+	header := make(http.Header)
+	if recorded.ContentType != "" {
+		header.Set("Content-Type", recorded.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(recorded.Body))),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
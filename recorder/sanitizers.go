@@ -0,0 +1,77 @@
+package recorder
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// Redacted replaces the values removed by sanitizers.
+const Redacted = "REDACTED"
+
+// RedactJSONFields returns a Sanitizer that replaces the values of the named fields, at any depth,
+// in JSON request and response bodies. Bodies that are not JSON are left unchanged.
+func RedactJSONFields(fields ...string) Sanitizer {
+	names := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		names[field] = true
+	}
+	return func(interaction *Interaction) {
+		interaction.Request.Body = redactJSON(interaction.Request.Body, names)
+		interaction.Response.Body = redactJSON(interaction.Response.Body, names)
+	}
+}
+
+// RedactQueryParams returns a Sanitizer that replaces the values of the named query parameters in request URLs.
+// Replayed requests are matched against the sanitized URL, so it should only redact parameters
+// whose values are the same in the recording and in the test.
+func RedactQueryParams(params ...string) Sanitizer {
+	return func(interaction *Interaction) {
+		parsed, err := url.ParseRequestURI(interaction.Request.URL)
+		if err != nil {
+			return
+		}
+		query := parsed.Query()
+		for _, param := range params {
+			if query.Has(param) {
+				query.Set(param, Redacted)
+			}
+		}
+		parsed.RawQuery = query.Encode()
+		interaction.Request.URL = parsed.RequestURI()
+	}
+}
+
+// redactJSON returns body with the values of the named fields replaced, or body itself if it is not JSON.
+func redactJSON(body string, names map[string]bool) string {
+	if body == "" {
+		return body
+	}
+	var document interface{}
+	if err := json.Unmarshal([]byte(body), &document); err != nil {
+		return body
+	}
+	redacted, err := json.Marshal(redactValue(document, names))
+	if err != nil {
+		return body
+	}
+	return string(redacted)
+}
+
+// redactValue replaces the values of the named fields within a decoded JSON value.
+func redactValue(value interface{}, names map[string]bool) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, field := range typed {
+			if names[key] {
+				typed[key] = Redacted
+			} else {
+				typed[key] = redactValue(field, names)
+			}
+		}
+	case []interface{}:
+		for i, element := range typed {
+			typed[i] = redactValue(element, names)
+		}
+	}
+	return value
+}
@@ -0,0 +1,71 @@
+package xmatters
+
+// -------------------------------------------------------------------------------------------------
+// Reference Constructors
+// -------------------------------------------------------------------------------------------------
+
+// NewReferenceById returns a ReferenceById for the provided ID.
+func NewReferenceById(id string) *ReferenceById {
+	return &ReferenceById{ID: StringPtr(id)}
+}
+
+// NewReferencesById returns a slice of ReferenceById values for the provided IDs,
+// such as for the Supervisors field of PushGroupParams.
+func NewReferencesById(ids ...string) []*ReferenceById {
+	references := make([]*ReferenceById, 0, len(ids))
+	for _, id := range ids {
+		references = append(references, NewReferenceById(id))
+	}
+	return references
+}
+
+// NewReferenceByName returns a ReferenceByName for the provided name.
+func NewReferenceByName(name string) *ReferenceByName {
+	return &ReferenceByName{Name: StringPtr(name)}
+}
+
+// NewReferencesByName returns a slice of ReferenceByName values for the provided names,
+// such as for the Observers field of PushGroupParams.
+func NewReferencesByName(names ...string) []*ReferenceByName {
+	references := make([]*ReferenceByName, 0, len(names))
+	for _, name := range names {
+		references = append(references, NewReferenceByName(name))
+	}
+	return references
+}
+
+// NewGroupRefById returns a GroupReference for the group with the provided ID.
+func NewGroupRefById(id string) *GroupReference {
+	return &GroupReference{ID: StringPtr(id)}
+}
+
+// NewGroupRefByName returns a GroupReference for the group with the provided target name.
+func NewGroupRefByName(targetName string) *GroupReference {
+	return &GroupReference{TargetName: StringPtr(targetName)}
+}
+
+// NewPersonRef returns a PersonReference for the provided person identifier.
+// UUIDs are set as the ID and any other value is set as the target name.
+func NewPersonRef(idOrTargetName string) *PersonReference {
+	if IsUUID(idOrTargetName) {
+		return &PersonReference{ID: StringPtr(idOrTargetName)}
+	}
+	return &PersonReference{TargetName: StringPtr(idOrTargetName)}
+}
+
+// NewServiceRef returns a ServiceReference for the service with the provided ID.
+func NewServiceRef(id string) *ServiceReference {
+	return &ServiceReference{ID: StringPtr(id)}
+}
+
+// NewRecipient returns a RecipientPointer for the recipient with the provided ID and type,
+// such as for the members of a shift.
+func NewRecipient(id string, recipientType RecipientType) *RecipientPointer {
+	return &RecipientPointer{ID: StringPtr(id), Type: StringPtr(string(recipientType))}
+}
+
+// NewGroupMember returns a GroupMember for the recipient with the provided ID and type,
+// such as for the params of PushGroupRoster.
+func NewGroupMember(id string, memberType RecipientType) *GroupMember {
+	return &GroupMember{ID: StringPtr(id), MemberType: StringPtr(string(memberType))}
+}
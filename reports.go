@@ -0,0 +1,358 @@
+package xmatters
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Site Distribution Report Structs
+// -------------------------------------------------------------------------------------------------
+
+// SiteDistribution contains the number of active people and devices assigned to a single site.
+// LicenseTypes is only populated when the report is requested with IncludeLicenseTypes set.
+type SiteDistribution struct {
+	Site          *Site            `json:"site"`
+	ActivePeople  int64            `json:"activePeople"`
+	ActiveDevices int64            `json:"activeDevices"`
+	LicenseTypes  map[string]int64 `json:"licenseTypes,omitempty"`
+}
+
+// SiteDistributionParams contains the options for the GetSiteDistributionReport method.
+type SiteDistributionParams struct {
+	// Sites filters the sites included in the report.
+	Sites GetSitesParams
+	// IncludeLicenseTypes adds a per-license-type breakdown of active people to each site.
+	IncludeLicenseTypes bool
+}
+
+// -------------------------------------------------------------------------------------------------
+// Site Distribution Report Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetSiteDistributionReport returns the count of active people and devices for each site in xMatters.
+// It combines the sites, people, and devices endpoints so capacity and business continuity planning
+// can be done from a single call. Devices are attributed to the site of their owner.
+func (xmatters *XMattersAPI) GetSiteDistributionReport(params SiteDistributionParams) ([]*SiteDistribution, error) {
+	// Retrieve the sites to report on
+	sites, err := xmatters.GetSiteList(params.Sites)
+	if err != nil {
+		return []*SiteDistribution{}, err
+	}
+
+	// Retrieve all active people and devices
+	people, err := xmatters.GetPersonList(GetPeopleParams{Status: "ACTIVE"})
+	if err != nil {
+		return []*SiteDistribution{}, err
+	}
+	devices, err := xmatters.GetDeviceList(GetDevicesParams{DeviceStatus: "ACTIVE"})
+	if err != nil {
+		return []*SiteDistribution{}, err
+	}
+
+	// Initialize a report entry for each site
+	report := make([]*SiteDistribution, 0, len(sites))
+	bySite := make(map[string]*SiteDistribution, len(sites))
+	for _, site := range sites {
+		entry := &SiteDistribution{Site: site}
+		if params.IncludeLicenseTypes {
+			entry.LicenseTypes = make(map[string]int64)
+		}
+		report = append(report, entry)
+		bySite[stringValue(site.ID)] = entry
+	}
+
+	// Count people per site, remembering each person's site for device attribution
+	personSites := make(map[string]string, len(people))
+	for _, person := range people {
+		if person.Site == nil || person.Site.ID == nil {
+			continue
+		}
+		personSites[stringValue(person.ID)] = *person.Site.ID
+		entry, ok := bySite[*person.Site.ID]
+		if !ok {
+			continue
+		}
+		entry.ActivePeople++
+		if params.IncludeLicenseTypes {
+			entry.LicenseTypes[stringValue(person.LicenseType)]++
+		}
+	}
+
+	// Count devices per site based on the site of the device owner
+	for _, device := range devices {
+		if device.Owner == nil || device.Owner.ID == nil {
+			continue
+		}
+		if entry, ok := bySite[personSites[*device.Owner.ID]]; ok {
+			entry.ActiveDevices++
+		}
+	}
+
+	// Return the per-site report
+	return report, nil
+}
+
+// -------------------------------------------------------------------------------------------------
+// Event Metrics Report Structs
+// -------------------------------------------------------------------------------------------------
+
+// EventMetrics contains aggregated event volume and response metrics for a date range.
+// MeanTimeToAcknowledgeSeconds is the mean time from event creation to the first positive response,
+// calculated over the acknowledged events whose creation time is known.
+type EventMetrics struct {
+	From                         *Timestamp           `json:"from"`
+	To                           *Timestamp           `json:"to"`
+	TotalEvents                  int64                `json:"totalEvents"`
+	EventsByPriority             map[string]int64     `json:"eventsByPriority"`
+	EventsByStatus               map[string]int64     `json:"eventsByStatus"`
+	AcknowledgedEvents           int64                `json:"acknowledgedEvents"`
+	MeanTimeToAcknowledgeSeconds float64              `json:"meanTimeToAcknowledgeSeconds"`
+	Groups                       []*GroupResponseRate `json:"groups"`
+}
+
+// GroupResponseRate contains the response rate for events that targeted a single group.
+// ResponseRate is the fraction of TargetedEvents that received at least one positive response.
+type GroupResponseRate struct {
+	Group              *RecipientReference `json:"group"`
+	TargetedEvents     int64               `json:"targetedEvents"`
+	AcknowledgedEvents int64               `json:"acknowledgedEvents"`
+	ResponseRate       float64             `json:"responseRate"`
+}
+
+// EventMetricsParams contains the options for the GetEventMetricsReport method.
+type EventMetricsParams struct {
+	// From and To limit the report to events created within the date range.
+	From *Timestamp
+	To   *Timestamp
+	// Events further filters the events included in the report. Its From and To fields are overridden.
+	Events GetEventsParams
+}
+
+// -------------------------------------------------------------------------------------------------
+// Event Metrics Report Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetEventMetricsReport aggregates event volume, mean time to acknowledge (MTTA), and per-group response rates
+// for the events created within a date range. An event is acknowledged when a recipient selects a response option
+// with a POSITIVE contribution; the first such response, taken from the event's user deliveries, is used for MTTA.
+func (xmatters *XMattersAPI) GetEventMetricsReport(params EventMetricsParams) (EventMetrics, error) {
+	metrics := EventMetrics{
+		From:             params.From,
+		To:               params.To,
+		EventsByPriority: make(map[string]int64),
+		EventsByStatus:   make(map[string]int64),
+		Groups:           []*GroupResponseRate{},
+	}
+
+	// Retrieve the events in the date range, including the groups they targeted
+	eventParams := params.Events
+	eventParams.From = params.From
+	eventParams.To = params.To
+	eventParams.Embed = "targetedRecipients"
+	events, err := xmatters.GetEventList(eventParams)
+	if err != nil {
+		return EventMetrics{}, err
+	}
+
+	// Events without a creation time are acknowledged, but cannot be timed
+	var totalAcknowledge time.Duration
+	var timedEvents int64
+	groups := make(map[string]*GroupResponseRate)
+	responded := true
+	for _, event := range events {
+		metrics.TotalEvents++
+		metrics.EventsByPriority[stringValue(event.Priority)]++
+		metrics.EventsByStatus[stringValue(event.Status)]++
+
+		// Find the first positive response from the event's user deliveries
+		deliveries, err := xmatters.GetEventUserDeliveries(stringValue(event.ID), GetUserDeliveriesParams{Responded: &responded})
+		if err != nil {
+			return EventMetrics{}, err
+		}
+		acknowledgedAt, acknowledged := firstPositiveResponse(deliveries)
+		if acknowledged {
+			metrics.AcknowledgedEvents++
+			if event.Created != nil {
+				timedEvents++
+				totalAcknowledge += acknowledgedAt.Sub(event.Created.Time)
+			}
+		}
+
+		// Count the event against each targeted group
+		for _, recipient := range event.TargetedRecipients {
+			if stringValue(recipient.RecipientType) != "GROUP" {
+				continue
+			}
+			group, ok := groups[stringValue(recipient.ID)]
+			if !ok {
+				group = &GroupResponseRate{Group: recipient}
+				groups[stringValue(recipient.ID)] = group
+				metrics.Groups = append(metrics.Groups, group)
+			}
+			group.TargetedEvents++
+			if acknowledged {
+				group.AcknowledgedEvents++
+			}
+		}
+	}
+
+	// Calculate the averages and rates
+	if timedEvents > 0 {
+		metrics.MeanTimeToAcknowledgeSeconds = totalAcknowledge.Seconds() / float64(timedEvents)
+	}
+	for _, group := range metrics.Groups {
+		group.ResponseRate = float64(group.AcknowledgedEvents) / float64(group.TargetedEvents)
+	}
+	sort.Slice(metrics.Groups, func(i, j int) bool {
+		return stringValue(metrics.Groups[i].Group.TargetName) < stringValue(metrics.Groups[j].Group.TargetName)
+	})
+
+	// Return the aggregated metrics
+	return metrics, nil
+}
+
+// firstPositiveResponse returns the time of the earliest response with a POSITIVE contribution.
+func firstPositiveResponse(deliveries []*UserDelivery) (time.Time, bool) {
+	var first time.Time
+	found := false
+	for _, delivery := range deliveries {
+		if delivery.Response == nil || stringValue(delivery.Response.Contribution) != string(ResponseContributionPositive) {
+			continue
+		}
+		if delivery.Response.Received == nil {
+			continue
+		}
+		if received := delivery.Response.Received.Time; !found || received.Before(first) {
+			first = received
+			found = true
+		}
+	}
+	return first, found
+}
+
+// -------------------------------------------------------------------------------------------------
+// Hygiene Report Structs
+// -------------------------------------------------------------------------------------------------
+
+// HygieneFindingType identifies the kind of stale or incomplete data found by the hygiene report.
+type HygieneFindingType string
+
+// Hygiene finding types reported by GetHygieneReport.
+const (
+	HygieneStaleLogin       HygieneFindingType = "STALE_LOGIN"
+	HygieneFailedDeviceTest HygieneFindingType = "FAILED_DEVICE_TEST"
+	HygieneEmptyRoster      HygieneFindingType = "EMPTY_ROSTER"
+	HygieneUnownedService   HygieneFindingType = "UNOWNED_SERVICE"
+)
+
+// HygieneFinding identifies a single person, device, group, or service that needs cleanup.
+type HygieneFinding struct {
+	Type       HygieneFindingType `json:"type"`
+	ID         string             `json:"id"`
+	TargetName string             `json:"targetName"`
+	Detail     string             `json:"detail"`
+}
+
+// HygieneReportParams contains the options for the GetHygieneReport method.
+type HygieneReportParams struct {
+	// StaleLoginDays is the number of days without a login after which a person is flagged. Defaults to 90.
+	StaleLoginDays int
+	// IncludeNeverLoggedIn also flags people that have never logged in.
+	IncludeNeverLoggedIn bool
+}
+
+// -------------------------------------------------------------------------------------------------
+// Hygiene Report Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetHygieneReport flags stale or incomplete data in xMatters so cleanup campaigns can be scripted.
+// It reports people whose last login is older than params.StaleLoginDays, devices with a FAILED test status,
+// groups with empty rosters, and services without an owning group. Findings are ordered by type, then target name.
+func (xmatters *XMattersAPI) GetHygieneReport(params HygieneReportParams) ([]*HygieneFinding, error) {
+	findings := []*HygieneFinding{}
+	staleDays := params.StaleLoginDays
+	if staleDays <= 0 {
+		staleDays = 90
+	}
+	cutoff := time.Now().AddDate(0, 0, -staleDays)
+
+	// Flag people that have not logged in since the cutoff
+	people, err := xmatters.GetPersonList(GetPeopleParams{})
+	if err != nil {
+		return findings, err
+	}
+	for _, person := range people {
+		finding := &HygieneFinding{Type: HygieneStaleLogin, ID: stringValue(person.ID), TargetName: stringValue(person.TargetName)}
+		if person.LastLogin == nil {
+			if !params.IncludeNeverLoggedIn {
+				continue
+			}
+			finding.Detail = "never logged in"
+		} else {
+			if !person.LastLogin.Before(cutoff) {
+				continue
+			}
+			finding.Detail = fmt.Sprintf("last logged in %s", person.LastLogin)
+		}
+		findings = append(findings, finding)
+	}
+
+	// Flag devices that failed their last test
+	devices, err := xmatters.GetDeviceList(GetDevicesParams{})
+	if err != nil {
+		return findings, err
+	}
+	for _, device := range devices {
+		if stringValue(device.TestStatus) != "FAILED" {
+			continue
+		}
+		findings = append(findings, &HygieneFinding{
+			Type:       HygieneFailedDeviceTest,
+			ID:         stringValue(device.ID),
+			TargetName: stringValue(device.TargetName),
+			Detail:     fmt.Sprintf("%s device test failed", stringValue(device.DeviceType)),
+		})
+	}
+
+	// Flag groups without any members
+	groups, err := xmatters.GetGroupList(GetGroupsParams{MemberExists: "false"})
+	if err != nil {
+		return findings, err
+	}
+	for _, group := range groups {
+		findings = append(findings, &HygieneFinding{
+			Type:       HygieneEmptyRoster,
+			ID:         stringValue(group.ID),
+			TargetName: stringValue(group.TargetName),
+			Detail:     "group roster is empty",
+		})
+	}
+
+	// Flag services without an owning group
+	services, err := xmatters.GetServiceList(GetServicesParams{})
+	if err != nil {
+		return findings, err
+	}
+	for _, service := range services {
+		if service.OwnedBy != nil && service.OwnedBy.ID != nil {
+			continue
+		}
+		findings = append(findings, &HygieneFinding{
+			Type:       HygieneUnownedService,
+			ID:         stringValue(service.ID),
+			TargetName: stringValue(service.TargetName),
+			Detail:     "service has no owner",
+		})
+	}
+
+	// Return the findings in a stable order
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Type != findings[j].Type {
+			return findings[i].Type < findings[j].Type
+		}
+		return findings[i].TargetName < findings[j].TargetName
+	})
+	return findings, nil
+}
@@ -1,10 +1,20 @@
 package xmatters
 
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Role Structs
+// -------------------------------------------------------------------------------------------------
+
 // Role represents a role in xMatters.
 type Role struct {
-	ID          *string `json:"id,omitempty"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
+	ID          *string `json:"id,omitempty" tfsdk:"id"`
+	Name        *string `json:"name" tfsdk:"name"`
+	Description *string `json:"description" tfsdk:"description"`
 }
 
 // RolePagination contains a paginated list of roles.
@@ -13,3 +23,93 @@ type RolePagination struct {
 	*Pagination
 	Roles []*Role `json:"data,omitempty"`
 }
+
+// personRolesParams is the body of a request that modifies only the roles of a person.
+type personRolesParams struct {
+	ID    string   `json:"id"`
+	Roles []string `json:"roles"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Role Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetPersonRoles retrieves the roles of a person in xMatters.
+// It requires the personId parameter to identify the specific person, and returns a slice of Role objects.
+// All pages of the person's roles are retrieved.
+func (xmatters *XMattersAPI) GetPersonRoles(personId string) ([]*Role, error) {
+	person, err := xmatters.GetPerson(personId, WithEmbed("roles"))
+	if err != nil {
+		return nil, err
+	}
+	if person.Roles == nil {
+		return []*Role{}, nil
+	}
+	return person.Roles, nil
+}
+
+// UpdatePersonRoles adds and removes roles of a person in xMatters, keeping the person's other roles.
+// Roles are named as in xMatters, such as "Standard User", and are matched without regard to case.
+// A role that is both added and removed is removed.
+// Only the roles of the person are sent, so the change does not overwrite profile fields modified by
+// another client. No request is made when the person already has the resulting roles.
+// It returns the roles of the person after the change, or a validation error if no role would remain,
+// as xMatters requires every person to have at least one role.
+func (xmatters *XMattersAPI) UpdatePersonRoles(personId string, add, remove []string) ([]*Role, error) {
+	person, err := xmatters.GetPerson(personId, WithEmbed("roles"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Merge the changes into the current roles, keeping their order
+	removed := make(map[string]bool, len(remove))
+	for _, name := range remove {
+		removed[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+	roles := []string{}
+	seen := make(map[string]bool)
+	changed := false
+	for _, role := range person.Roles {
+		name := stringValue(role.Name)
+		if removed[strings.ToLower(name)] {
+			changed = true
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		roles = append(roles, name)
+	}
+	for _, name := range add {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[strings.ToLower(name)] || removed[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		roles = append(roles, name)
+		changed = true
+	}
+
+	if len(roles) == 0 {
+		return nil, newValidationError(fmt.Sprintf("person %s must keep at least one role", personId))
+	}
+	if !changed {
+		return person.Roles, nil
+	}
+
+	// Perform the API request.
+	uri := buildURI("/people", nil)
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, personRolesParams{ID: stringValue(person.ID), Roles: roles})
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the response into a Person struct, and retrieve the roles if the response does not embed them.
+	var decoded personJSON
+	if err := xmatters.decode(resp, &decoded); err != nil {
+		return nil, newUnmarshalError()
+	}
+	result := decoded.person()
+	if result.Roles == nil {
+		return xmatters.GetPersonRoles(stringValue(person.ID))
+	}
+	return getEmbeddedPages(xmatters, result.Roles, decoded.Roles.Links)
+}
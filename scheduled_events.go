@@ -0,0 +1,33 @@
+package xmatters
+
+import (
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Scheduled Event Structs
+// -------------------------------------------------------------------------------------------------
+
+// EventSchedule schedules a triggered event for future delivery instead of sending it immediately.
+// StartTime is when the event is sent; Timezone optionally names the time zone the schedule is displayed in.
+type EventSchedule struct {
+	StartTime Timestamp `json:"startTime"`
+	Timezone  string    `json:"timezone,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Scheduled Event Methods
+// -------------------------------------------------------------------------------------------------
+
+// ScheduleEvent triggers an event in xMatters for delivery at a future time.
+// It requires the TriggerEventParams struct containing the event details and the time the event should be sent.
+// It returns the EventTrigger containing the request ID of the scheduled event.
+// Scheduled events are created through the trigger endpoint of the form; the REST API does not document an
+// endpoint to list or cancel them, so they are managed in the xMatters web user interface.
+func (xmatters *XMattersAPI) ScheduleEvent(params TriggerEventParams, startTime time.Time) (EventTrigger, error) {
+	if !startTime.After(time.Now()) {
+		return EventTrigger{}, newValidationError("a scheduled event must start in the future")
+	}
+	params.Schedule = &EventSchedule{StartTime: Timestamp{Time: startTime.UTC()}}
+	return xmatters.TriggerEvent(params)
+}
@@ -0,0 +1,119 @@
+package xmatters
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Scheduler Structs
+// -------------------------------------------------------------------------------------------------
+
+// Priority orders the requests of a client that wait for its rate limiter. When requests are waiting,
+// the next request to be sent is the oldest one with the highest priority.
+type Priority int
+
+const (
+	// PriorityBackground is for bulk work that can be delayed, such as nightly syncs and exports.
+	PriorityBackground Priority = -1
+	// PriorityNormal is the priority of requests that are not prioritized.
+	PriorityNormal Priority = 0
+	// PriorityInteractive is for requests someone is waiting on, such as triggering an event or
+	// looking up who is on call. TriggerEvent and GetOnCallList are always sent at least at this priority.
+	PriorityInteractive Priority = 1
+)
+
+// scheduler hands out the tokens of a rate limiter to waiting requests in priority order.
+// Only one request waits on the limiter at a time, so a request queued behind a long backlog of
+// background requests is sent as soon as the next token is available rather than after the backlog.
+type scheduler struct {
+	limiter *rate.Limiter
+
+	mu      sync.Mutex
+	busy    bool
+	waiting map[Priority][]chan struct{}
+}
+
+// -------------------------------------------------------------------------------------------------
+// Scheduler Methods
+// -------------------------------------------------------------------------------------------------
+
+// Prioritized returns a client that sends its requests at the given priority. The returned client shares the
+// configuration, connections, and rate limiter of this one, so a background sync and interactive calls made
+// through the same client share its request rate while the interactive calls are sent first.
+// Prioritizing has no effect on a client without a rate limit, as its requests do not wait.
+// Example usage:
+//
+//	people, err := client.Prioritized(xmatters.PriorityBackground).GetPersonList(xmatters.GetPeopleParams{})
+func (xmatters *XMattersAPI) Prioritized(priority Priority) *XMattersAPI {
+	prioritized := *xmatters
+	prioritized.priority = priority
+	return &prioritized
+}
+
+// interactive returns a client that sends its requests at least at PriorityInteractive.
+func (xmatters *XMattersAPI) interactive() *XMattersAPI {
+	if xmatters.priority >= PriorityInteractive {
+		return xmatters
+	}
+	return xmatters.Prioritized(PriorityInteractive)
+}
+
+// acquire waits for a free request slot when the requests in flight are limited, and returns the function
+// that frees it once the request completes.
+func (xmatters *XMattersAPI) acquire() func() {
+	if xmatters.inFlight == nil {
+		return func() {}
+	}
+	xmatters.inFlight <- struct{}{}
+	return func() { <-xmatters.inFlight }
+}
+
+// newScheduler creates a scheduler for the rate limiter.
+func newScheduler(limiter *rate.Limiter) *scheduler {
+	return &scheduler{limiter: limiter, waiting: make(map[Priority][]chan struct{})}
+}
+
+// wait blocks until the limiter allows a request of the given priority to be sent.
+func (s *scheduler) wait(ctx context.Context, priority Priority) error {
+	s.mu.Lock()
+	if s.busy {
+		turn := make(chan struct{})
+		s.waiting[priority] = append(s.waiting[priority], turn)
+		s.mu.Unlock()
+		<-turn
+	} else {
+		s.busy = true
+		s.mu.Unlock()
+	}
+	defer s.next()
+	return s.limiter.Wait(ctx)
+}
+
+// next passes the turn to wait on the limiter to the oldest waiting request with the highest priority.
+func (s *scheduler) next() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	found := false
+	var highest Priority
+	for priority, queue := range s.waiting {
+		if len(queue) > 0 && (!found || priority > highest) {
+			highest, found = priority, true
+		}
+	}
+	if !found {
+		s.busy = false
+		return
+	}
+	queue := s.waiting[highest]
+	turn := queue[0]
+	if len(queue) == 1 {
+		delete(s.waiting, highest)
+	} else {
+		s.waiting[highest] = queue[1:]
+	}
+	close(turn)
+}
@@ -0,0 +1,130 @@
+package xmatters
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestSchedulerNextOrder(t *testing.T) {
+	type waiter struct {
+		name     string
+		priority Priority
+	}
+	tests := []struct {
+		name    string
+		waiting []waiter
+		want    []string
+	}{
+		{name: "none", want: []string{}},
+		{
+			name:    "same priority in arrival order",
+			waiting: []waiter{{"a", PriorityNormal}, {"b", PriorityNormal}, {"c", PriorityNormal}},
+			want:    []string{"a", "b", "c"},
+		},
+		{
+			name:    "highest priority first",
+			waiting: []waiter{{"bulk", PriorityBackground}, {"normal", PriorityNormal}, {"page", PriorityInteractive}},
+			want:    []string{"page", "normal", "bulk"},
+		},
+		{
+			name: "interleaved priorities",
+			waiting: []waiter{
+				{"bulk1", PriorityBackground}, {"page1", PriorityInteractive}, {"bulk2", PriorityBackground},
+				{"normal1", PriorityNormal}, {"page2", PriorityInteractive},
+			},
+			want: []string{"page1", "page2", "normal1", "bulk1", "bulk2"},
+		},
+		{
+			name:    "custom priorities",
+			waiting: []waiter{{"low", Priority(-5)}, {"high", Priority(7)}, {"normal", PriorityNormal}},
+			want:    []string{"high", "normal", "low"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newScheduler(rate.NewLimiter(rate.Inf, 1))
+			s.busy = true
+			turns := make(map[chan struct{}]string)
+			for _, w := range tt.waiting {
+				turn := make(chan struct{})
+				turns[turn] = w.name
+				s.waiting[w.priority] = append(s.waiting[w.priority], turn)
+			}
+
+			got := []string{}
+			for range tt.waiting {
+				s.next()
+				for turn, name := range turns {
+					select {
+					case <-turn:
+						got = append(got, name)
+						delete(turns, turn)
+					default:
+					}
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("released %v, want %v", got, tt.want)
+			}
+
+			s.next()
+			if s.busy || len(s.waiting) != 0 {
+				t.Errorf("busy = %v with %d priorities waiting, want the scheduler to be idle", s.busy, len(s.waiting))
+			}
+		})
+	}
+}
+
+func TestSchedulerWaitPriority(t *testing.T) {
+	// A slow limiter with its single token taken, so every request waits its turn
+	s := newScheduler(rate.NewLimiter(rate.Every(20*time.Millisecond), 1))
+	if err := s.wait(context.Background(), PriorityNormal); err != nil {
+		t.Fatal(err)
+	}
+
+	// Hold the turn while the requests queue up, so they are all waiting before any is released
+	s.mu.Lock()
+	s.busy = true
+	s.mu.Unlock()
+
+	var mu sync.Mutex
+	var order []Priority
+	var wg sync.WaitGroup
+	priorities := []Priority{PriorityBackground, PriorityNormal, PriorityInteractive, PriorityBackground, PriorityInteractive}
+	for i, priority := range priorities {
+		wg.Add(1)
+		go func(priority Priority) {
+			defer wg.Done()
+			if err := s.wait(context.Background(), priority); err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			order = append(order, priority)
+			mu.Unlock()
+		}(priority)
+
+		// Wait for the request to be queued before starting the next one
+		for queued := 0; queued <= i; {
+			time.Sleep(time.Millisecond)
+			s.mu.Lock()
+			queued = 0
+			for _, queue := range s.waiting {
+				queued += len(queue)
+			}
+			s.mu.Unlock()
+		}
+	}
+
+	s.next()
+	wg.Wait()
+	want := []Priority{PriorityInteractive, PriorityInteractive, PriorityNormal, PriorityBackground, PriorityBackground}
+	if !reflect.DeepEqual(order, want) {
+		t.Errorf("sent in order %v, want %v", order, want)
+	}
+}
@@ -0,0 +1,101 @@
+package xmatters
+
+import (
+	"net/url"
+	"strings"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Search Query Structs
+// -------------------------------------------------------------------------------------------------
+
+// SearchOperand represents how multiple search terms are combined.
+type SearchOperand string
+
+const (
+	// SearchAnd returns results that match all search terms.
+	SearchAnd SearchOperand = "AND"
+	// SearchOr returns results that match any search term.
+	SearchOr SearchOperand = "OR"
+)
+
+// SearchField represents a field that search terms are matched against.
+type SearchField string
+
+// Search fields of people.
+const (
+	SearchFirstName    SearchField = "FIRST_NAME"
+	SearchLastName     SearchField = "LAST_NAME"
+	SearchTargetName   SearchField = "TARGET_NAME"
+	SearchWebLogin     SearchField = "WEB_LOGIN"
+	SearchPhoneNumber  SearchField = "PHONE_NUMBER"
+	SearchEmailAddress SearchField = "EMAIL_ADDRESS"
+)
+
+// Search fields of groups, services, and sites.
+const (
+	SearchName        SearchField = "NAME"
+	SearchDescription SearchField = "DESCRIPTION"
+)
+
+// SearchQuery contains the search parameters shared by the people, group, service, and site lists.
+// Terms are separated by spaces, and each term matches the start of a word in any of the Fields,
+// or in the default search fields of the resource when Fields is empty.
+// Operand controls whether results must match all terms (AND, the default) or any term (OR).
+// Fields and Operand only apply to a search, so they are not sent when Terms is empty.
+type SearchQuery struct {
+	Terms   string
+	Fields  []SearchField
+	Operand SearchOperand
+}
+
+// -------------------------------------------------------------------------------------------------
+// Search Query Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewSearchQuery returns a SearchQuery that matches all of the provided terms.
+func NewSearchQuery(terms ...string) SearchQuery {
+	return SearchQuery{Terms: strings.Join(terms, " ")}
+}
+
+// InFields returns a copy of the query that only matches terms against the provided fields.
+func (q SearchQuery) InFields(fields ...SearchField) SearchQuery {
+	q.Fields = fields
+	return q
+}
+
+// MatchAny returns a copy of the query that matches results containing any of its terms.
+func (q SearchQuery) MatchAny() SearchQuery {
+	q.Operand = SearchOr
+	return q
+}
+
+// IsZero reports whether the query has no search terms, in which case no search parameters are sent.
+func (q SearchQuery) IsZero() bool {
+	return strings.TrimSpace(q.Terms) == ""
+}
+
+// QueryKeys returns the names of the query parameters a SearchQuery may add.
+func (q SearchQuery) QueryKeys() []string {
+	return []string{"search", "fields", "operand"}
+}
+
+// EncodeValues adds the search, fields, and operand query parameters. The key is ignored, as the
+// API expects the three parameters side by side. It implements the query.Encoder interface.
+func (q SearchQuery) EncodeValues(_ string, v *url.Values) error {
+	if q.IsZero() {
+		return nil
+	}
+	v.Set("search", strings.TrimSpace(q.Terms))
+	fields := make([]string, 0, len(q.Fields))
+	for _, field := range q.Fields {
+		fields = append(fields, string(field))
+	}
+	if joined := joinValues(fields); joined != "" {
+		v.Set("fields", joined)
+	}
+	if q.Operand != "" {
+		v.Set("operand", string(q.Operand))
+	}
+	return nil
+}
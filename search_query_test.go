@@ -0,0 +1,68 @@
+package xmatters
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestSearchQueryEncodeValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		query SearchQuery
+		want  url.Values
+	}{
+		{name: "zero", query: SearchQuery{}, want: url.Values{}},
+		{name: "blank terms", query: SearchQuery{Terms: "   ", Fields: []SearchField{SearchName}, Operand: SearchOr}, want: url.Values{}},
+		{name: "terms", query: NewSearchQuery("john", "smith"), want: url.Values{"search": {"john smith"}}},
+		{name: "trimmed terms", query: SearchQuery{Terms: "  john  "}, want: url.Values{"search": {"john"}}},
+		{
+			name:  "fields and operand",
+			query: NewSearchQuery("db").InFields(SearchName, SearchDescription).MatchAny(),
+			want:  url.Values{"search": {"db"}, "fields": {"NAME,DESCRIPTION"}, "operand": {"OR"}},
+		},
+		{
+			name:  "blank fields are dropped",
+			query: NewSearchQuery("db").InFields("", SearchName, " "),
+			want:  url.Values{"search": {"db"}, "fields": {"NAME"}},
+		},
+		{name: "empty fields", query: NewSearchQuery("db").InFields(), want: url.Values{"search": {"db"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := url.Values{}
+			if err := tt.query.EncodeValues("ignored", &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Encode() != tt.want.Encode() {
+				t.Errorf("got %q, want %q", got.Encode(), tt.want.Encode())
+			}
+		})
+	}
+}
+
+func TestSearchQueryInURI(t *testing.T) {
+	tests := []struct {
+		name   string
+		params GetPeopleParams
+		want   string
+	}{
+		{name: "no search", params: GetPeopleParams{}, want: "/people"},
+		{
+			name:   "reserved characters",
+			params: GetPeopleParams{SearchQuery: NewSearchQuery("a&b", "c+d", "50%")},
+			want:   "/people?search=a%26b+c%2Bd+50%25",
+		},
+		{
+			name:   "with other parameters",
+			params: GetPeopleParams{SearchQuery: NewSearchQuery("smith").InFields(SearchLastName), Status: "ACTIVE"},
+			want:   "/people?fields=LAST_NAME&search=smith&status=ACTIVE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildURI("/people", tt.params); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package xmatters
+
+import "fmt"
+
+// -------------------------------------------------------------------------------------------------
+// Service Catalog Structs
+// -------------------------------------------------------------------------------------------------
+
+// ServiceCatalog is a declarative description of the services and service dependencies expected in xMatters.
+// Services and dependencies are identified by target name so a catalog can be shared between instances.
+type ServiceCatalog struct {
+	Services     []*CatalogService    `json:"services"`
+	Dependencies []*CatalogDependency `json:"dependencies"`
+}
+
+// CatalogService describes a single service within a ServiceCatalog.
+type CatalogService struct {
+	TargetName   string         `json:"targetName"`
+	Description  *string        `json:"description,omitempty"`
+	ServiceType  string         `json:"serviceType"`
+	ServiceTier  *string        `json:"serviceTier,omitempty"`
+	OwnedBy      string         `json:"ownedBy,omitempty"` // Target name of the owning group
+	ServiceLinks []*ServiceLink `json:"serviceLinks,omitempty"`
+}
+
+// CatalogDependency describes a dependency between two services within a ServiceCatalog.
+// DependentService depends on Service; both are service target names.
+type CatalogDependency struct {
+	Service          string `json:"service"`
+	DependentService string `json:"dependentService"`
+}
+
+// ImportServiceCatalogParams contains the options for the ImportServiceCatalog method.
+type ImportServiceCatalogParams struct {
+	// DryRun computes the diff without making any changes in xMatters.
+	DryRun bool
+	// Prune deletes services and service dependencies that are not part of the catalog.
+	Prune bool
+}
+
+// ServiceCatalogDiff contains the changes made, or that would be made in a dry run, by ImportServiceCatalog.
+type ServiceCatalogDiff struct {
+	CreatedServices     []string             `json:"createdServices"`
+	UpdatedServices     []string             `json:"updatedServices"`
+	DeletedServices     []string             `json:"deletedServices"`
+	CreatedDependencies []*CatalogDependency `json:"createdDependencies"`
+	DeletedDependencies []*CatalogDependency `json:"deletedDependencies"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Service Catalog Methods
+// -------------------------------------------------------------------------------------------------
+
+// ImportServiceCatalog reconciles the services and service dependencies in xMatters with the provided catalog.
+// Missing services and dependencies are created, drifted services are updated, and, when params.Prune is set,
+// services and dependencies absent from the catalog are deleted. It returns the diff of applied changes.
+func (xmatters *XMattersAPI) ImportServiceCatalog(catalog ServiceCatalog, params ImportServiceCatalogParams) (ServiceCatalogDiff, error) {
+	diff := ServiceCatalogDiff{}
+
+	// Retrieve the current services and dependencies
+	services, err := xmatters.GetServiceList(GetServicesParams{Embed: "serviceLinks"})
+	if err != nil {
+		return diff, err
+	}
+	dependencies, err := xmatters.GetServiceDependencyList(GetServiceDependenciesParams{})
+	if err != nil {
+		return diff, err
+	}
+
+	// Index the existing services by target name and ID
+	existingByName := make(map[string]*Service, len(services))
+	namesById := make(map[string]string, len(services))
+	for _, service := range services {
+		existingByName[stringValue(service.TargetName)] = service
+		namesById[stringValue(service.ID)] = stringValue(service.TargetName)
+	}
+
+	// Create or update each catalog service
+	wanted := make(map[string]bool, len(catalog.Services))
+	for _, entry := range catalog.Services {
+		wanted[entry.TargetName] = true
+		pushParams := PushServiceParams{
+			TargetName:   entry.TargetName,
+			Description:  nullableOrNull(entry.Description),
+			ServiceType:  entry.ServiceType,
+			ServiceTier:  nullableOrNull(entry.ServiceTier),
+			OwnedBy:      NewNull[*GroupReference](),
+			ServiceLinks: entry.ServiceLinks,
+		}
+		if entry.OwnedBy != "" {
+			pushParams.OwnedBy = NewNullable(NewGroupRefByName(entry.OwnedBy))
+		}
+
+		existing, ok := existingByName[entry.TargetName]
+		if ok {
+			if catalogServiceMatches(entry, existing) {
+				continue
+			}
+			pushParams.ID = stringValue(existing.ID)
+			diff.UpdatedServices = append(diff.UpdatedServices, entry.TargetName)
+		} else {
+			diff.CreatedServices = append(diff.CreatedServices, entry.TargetName)
+		}
+		if params.DryRun {
+			continue
+		}
+
+		result, err := xmatters.PushService(pushParams)
+		if err != nil {
+			return diff, err
+		}
+		existingByName[entry.TargetName] = &result
+		namesById[stringValue(result.ID)] = entry.TargetName
+	}
+
+	// Index the existing dependencies by the target names of their services
+	existingDeps := make(map[CatalogDependency]*ServiceDependency, len(dependencies))
+	for _, dependency := range dependencies {
+		if dependency.Service == nil || dependency.DependentService == nil {
+			continue
+		}
+		key := CatalogDependency{
+			Service:          namesById[stringValue(dependency.Service.ID)],
+			DependentService: namesById[stringValue(dependency.DependentService.ID)],
+		}
+		existingDeps[key] = dependency
+	}
+
+	// Create each missing catalog dependency
+	wantedDeps := make(map[CatalogDependency]bool, len(catalog.Dependencies))
+	for _, entry := range catalog.Dependencies {
+		wantedDeps[*entry] = true
+		if _, ok := existingDeps[*entry]; ok {
+			continue
+		}
+		service, serviceOk := existingByName[entry.Service]
+		dependent, dependentOk := existingByName[entry.DependentService]
+		if !params.DryRun && (!serviceOk || !dependentOk) {
+			return diff, newValidationError(fmt.Sprintf("dependency %s -> %s references an unknown service", entry.DependentService, entry.Service))
+		}
+		diff.CreatedDependencies = append(diff.CreatedDependencies, entry)
+		if params.DryRun {
+			continue
+		}
+
+		_, err := xmatters.PushServiceDependency(PushServiceDependencyParams{
+			ServiceID:          stringValue(service.ID),
+			DependentServiceID: stringValue(dependent.ID),
+		})
+		if err != nil {
+			return diff, err
+		}
+	}
+
+	// Without pruning, the import is complete
+	if !params.Prune {
+		return diff, nil
+	}
+
+	// Delete dependencies that are not part of the catalog, including those of services being deleted
+	for _, dependency := range dependencies {
+		if dependency.Service == nil || dependency.DependentService == nil {
+			continue
+		}
+		entry := CatalogDependency{
+			Service:          namesById[stringValue(dependency.Service.ID)],
+			DependentService: namesById[stringValue(dependency.DependentService.ID)],
+		}
+		if wantedDeps[entry] {
+			continue
+		}
+		diff.DeletedDependencies = append(diff.DeletedDependencies, &entry)
+		if params.DryRun {
+			continue
+		}
+		if err := xmatters.DeleteServiceDependency(stringValue(dependency.ID)); err != nil {
+			return diff, err
+		}
+	}
+
+	// Delete services that are not part of the catalog
+	for _, service := range services {
+		name := stringValue(service.TargetName)
+		if wanted[name] {
+			continue
+		}
+		diff.DeletedServices = append(diff.DeletedServices, name)
+		if params.DryRun {
+			continue
+		}
+		if err := xmatters.DeleteService(stringValue(service.ID)); err != nil {
+			return diff, err
+		}
+	}
+
+	// Return the applied changes
+	return diff, nil
+}
+
+// catalogServiceMatches reports whether an existing service already matches its catalog entry.
+func catalogServiceMatches(entry *CatalogService, existing *Service) bool {
+	if stringValue(entry.Description) != stringValue(existing.Description) ||
+		entry.ServiceType != stringValue(existing.ServiceType) ||
+		stringValue(entry.ServiceTier) != stringValue(existing.ServiceTier) {
+		return false
+	}
+
+	// Compare the owning group by target name
+	owner := ""
+	if existing.OwnedBy != nil {
+		owner = stringValue(existing.OwnedBy.TargetName)
+	}
+	if entry.OwnedBy != owner {
+		return false
+	}
+
+	// Compare the service links in order
+	if len(entry.ServiceLinks) != len(existing.ServiceLinks) {
+		return false
+	}
+	for i, link := range entry.ServiceLinks {
+		if stringValue(link.Label) != stringValue(existing.ServiceLinks[i].Label) ||
+			stringValue(link.URL) != stringValue(existing.ServiceLinks[i].URL) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,311 @@
+package xmatters
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Service Graph Structs
+// -------------------------------------------------------------------------------------------------
+
+// ServiceGraph represents the services in xMatters and the dependencies between them.
+// A ServiceDependency is read as "DependentService depends on Service", so the Service of a dependency
+// is upstream of its DependentService.
+type ServiceGraph struct {
+	services     map[string]*Service
+	dependencies []*ServiceDependency
+	upstream     map[string][]string // service ID -> IDs of the services it depends on
+	downstream   map[string][]string // service ID -> IDs of the services that depend on it
+}
+
+// -------------------------------------------------------------------------------------------------
+// Service Graph Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetServiceGraph loads all services and service dependencies from xMatters and returns them as a ServiceGraph.
+func (xmatters *XMattersAPI) GetServiceGraph() (*ServiceGraph, error) {
+	// Retrieve all services
+	services, err := xmatters.GetServiceList(GetServicesParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve all service dependencies
+	dependencies, err := xmatters.GetServiceDependencyList(GetServiceDependenciesParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the assembled graph
+	return NewServiceGraph(services, dependencies), nil
+}
+
+// NewServiceGraph builds a ServiceGraph from a list of services and service dependencies.
+// Services referenced by a dependency but missing from the services list are added using the reference details.
+func NewServiceGraph(services []*Service, dependencies []*ServiceDependency) *ServiceGraph {
+	graph := &ServiceGraph{
+		services:   make(map[string]*Service, len(services)),
+		upstream:   make(map[string][]string),
+		downstream: make(map[string][]string),
+	}
+
+	// Index the services by ID
+	for _, service := range services {
+		if service == nil || service.ID == nil {
+			continue
+		}
+		graph.services[*service.ID] = service
+	}
+
+	// Record each dependency edge in both directions
+	for _, dependency := range dependencies {
+		if dependency == nil || dependency.Service == nil || dependency.DependentService == nil {
+			continue
+		}
+		upstreamId := graph.addReference(dependency.Service)
+		downstreamId := graph.addReference(dependency.DependentService)
+		if upstreamId == "" || downstreamId == "" {
+			continue
+		}
+		graph.dependencies = append(graph.dependencies, dependency)
+		graph.upstream[downstreamId] = appendUnique(graph.upstream[downstreamId], upstreamId)
+		graph.downstream[upstreamId] = appendUnique(graph.downstream[upstreamId], downstreamId)
+	}
+
+	// Sort the adjacency lists so traversal order is deterministic
+	for id := range graph.upstream {
+		graph.sortIds(graph.upstream[id])
+	}
+	for id := range graph.downstream {
+		graph.sortIds(graph.downstream[id])
+	}
+
+	return graph
+}
+
+// Service returns the service with the given ID, or nil if it is not part of the graph.
+func (g *ServiceGraph) Service(serviceId string) *Service {
+	return g.services[serviceId]
+}
+
+// Services returns every service in the graph, sorted by target name.
+func (g *ServiceGraph) Services() []*Service {
+	ids := make([]string, 0, len(g.services))
+	for id := range g.services {
+		ids = append(ids, id)
+	}
+	g.sortIds(ids)
+	return g.lookup(ids)
+}
+
+// Dependencies returns every service dependency in the graph.
+func (g *ServiceGraph) Dependencies() []*ServiceDependency {
+	return g.dependencies
+}
+
+// DirectUpstream returns the services that the given service depends on directly.
+func (g *ServiceGraph) DirectUpstream(serviceId string) []*Service {
+	return g.lookup(g.upstream[serviceId])
+}
+
+// DirectDownstream returns the services that depend directly on the given service.
+func (g *ServiceGraph) DirectDownstream(serviceId string) []*Service {
+	return g.lookup(g.downstream[serviceId])
+}
+
+// Upstream returns every service that the given service depends on, directly or transitively.
+func (g *ServiceGraph) Upstream(serviceId string) []*Service {
+	return g.lookup(g.traverse(serviceId, g.upstream))
+}
+
+// Downstream returns every service that depends on the given service, directly or transitively.
+func (g *ServiceGraph) Downstream(serviceId string) []*Service {
+	return g.lookup(g.traverse(serviceId, g.downstream))
+}
+
+// Impact returns the services affected if the given service is down.
+// This is every service that depends on it, directly or transitively.
+func (g *ServiceGraph) Impact(serviceId string) []*Service {
+	return g.Downstream(serviceId)
+}
+
+// HasCycles reports whether the graph contains at least one dependency cycle.
+func (g *ServiceGraph) HasCycles() bool {
+	return len(g.Cycles()) > 0
+}
+
+// Cycles returns the dependency cycles found in the graph.
+// Each cycle is returned as the list of services along the cycle, following the direction of dependency.
+func (g *ServiceGraph) Cycles() [][]*Service {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[string]int, len(g.services))
+	var stack []string
+	var cycles [][]*Service
+
+	// Depth-first search following upstream edges; an edge back to an in-progress node closes a cycle
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = inProgress
+		stack = append(stack, id)
+		for _, next := range g.upstream[id] {
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case inProgress:
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == next {
+						cycles = append(cycles, g.lookup(append([]string{}, stack[i:]...)))
+						break
+					}
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = done
+	}
+
+	for _, service := range g.Services() {
+		if state[*service.ID] == unvisited {
+			visit(*service.ID)
+		}
+	}
+
+	return cycles
+}
+
+// ToDOT renders the graph in the Graphviz DOT language.
+// Each edge points from a service to a service it depends on.
+func (g *ServiceGraph) ToDOT() string {
+	var b strings.Builder
+	b.WriteString("digraph services {\n")
+	b.WriteString("  rankdir=LR;\n")
+
+	// Declare each service as a labelled node
+	services := g.Services()
+	for _, service := range services {
+		fmt.Fprintf(&b, "  %s [label=%s];\n", dotQuote(*service.ID), dotQuote(g.sortKey(*service.ID)))
+	}
+
+	// Declare each dependency as an edge
+	for _, service := range services {
+		for _, upstreamId := range g.upstream[*service.ID] {
+			fmt.Fprintf(&b, "  %s -> %s;\n", dotQuote(*service.ID), dotQuote(upstreamId))
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// ToMermaid renders the graph as a Mermaid flowchart.
+// Each edge points from a service to a service it depends on.
+func (g *ServiceGraph) ToMermaid() string {
+	var b strings.Builder
+	b.WriteString("graph LR\n")
+
+	// Mermaid node IDs are restricted, so each service is assigned a short sequential ID
+	services := g.Services()
+	nodeIds := make(map[string]string, len(services))
+	for i, service := range services {
+		nodeIds[*service.ID] = fmt.Sprintf("s%d", i)
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", nodeIds[*service.ID], mermaidEscape(g.sortKey(*service.ID)))
+	}
+
+	// Declare each dependency as an edge
+	for _, service := range services {
+		for _, upstreamId := range g.upstream[*service.ID] {
+			fmt.Fprintf(&b, "  %s --> %s\n", nodeIds[*service.ID], nodeIds[upstreamId])
+		}
+	}
+
+	return b.String()
+}
+
+// addReference ensures the referenced service is part of the graph and returns its ID.
+func (g *ServiceGraph) addReference(ref *ServiceReference) string {
+	if ref.ID == nil {
+		return ""
+	}
+	if _, ok := g.services[*ref.ID]; !ok {
+		g.services[*ref.ID] = &Service{ID: ref.ID, TargetName: ref.TargetName}
+	}
+	return *ref.ID
+}
+
+// traverse performs a breadth-first walk from the given service over the provided edges.
+// It returns the IDs of every reachable service, excluding the starting service.
+func (g *ServiceGraph) traverse(serviceId string, edges map[string][]string) []string {
+	visited := map[string]bool{serviceId: true}
+	queue := []string{serviceId}
+	var result []string
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range edges[current] {
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			result = append(result, next)
+			queue = append(queue, next)
+		}
+	}
+	return result
+}
+
+// lookup converts a list of service IDs into their Service objects.
+func (g *ServiceGraph) lookup(ids []string) []*Service {
+	services := make([]*Service, 0, len(ids))
+	for _, id := range ids {
+		if service, ok := g.services[id]; ok {
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
+// sortIds sorts service IDs in place by target name, falling back to the ID itself.
+func (g *ServiceGraph) sortIds(ids []string) {
+	sort.Slice(ids, func(i, j int) bool {
+		nameI, nameJ := g.sortKey(ids[i]), g.sortKey(ids[j])
+		if nameI != nameJ {
+			return nameI < nameJ
+		}
+		return ids[i] < ids[j]
+	})
+}
+
+// sortKey returns the value used to order a service within the graph.
+func (g *ServiceGraph) sortKey(serviceId string) string {
+	if service, ok := g.services[serviceId]; ok && service.TargetName != nil {
+		return *service.TargetName
+	}
+	return serviceId
+}
+
+// appendUnique appends the value to the list if it is not already present.
+func appendUnique(list []string, value string) []string {
+	for _, existing := range list {
+		if existing == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
+
+// dotQuote returns the value as a quoted DOT identifier.
+func dotQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r", "", "\n", `\n`).Replace(value) + `"`
+}
+
+// mermaidEscape escapes characters that would terminate a quoted Mermaid label, and the # that starts
+// an entity code, so a name such as "C#;" is not read as one.
+func mermaidEscape(value string) string {
+	return strings.NewReplacer(`"`, "#quot;", "#", "#35;", "\r", "", "\n", " ").Replace(value)
+}
@@ -0,0 +1,183 @@
+package xmatters
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testServiceGraph builds a graph from edges written as dependent/upstream pairs of service names,
+// using the names as the IDs and target names of the services.
+func testServiceGraph(names []string, edges [][2]string) *ServiceGraph {
+	services := make([]*Service, 0, len(names))
+	for _, name := range names {
+		services = append(services, &Service{ID: StringPtr(name), TargetName: StringPtr(name)})
+	}
+	dependencies := make([]*ServiceDependency, 0, len(edges))
+	for _, edge := range edges {
+		dependencies = append(dependencies, &ServiceDependency{
+			DependentService: &ServiceReference{ID: StringPtr(edge[0]), TargetName: StringPtr(edge[0])},
+			Service:          &ServiceReference{ID: StringPtr(edge[1]), TargetName: StringPtr(edge[1])},
+		})
+	}
+	return NewServiceGraph(services, dependencies)
+}
+
+// serviceNames returns the target names of the services.
+func serviceNames(services []*Service) []string {
+	names := []string{}
+	for _, service := range services {
+		names = append(names, stringValue(service.TargetName))
+	}
+	return names
+}
+
+func TestServiceGraphCycles(t *testing.T) {
+	tests := []struct {
+		name   string
+		names  []string
+		edges  [][2]string
+		cycles [][]string
+	}{
+		{name: "no dependencies", names: []string{"a", "b"}, cycles: [][]string{}},
+		{name: "chain", names: []string{"a", "b", "c"}, edges: [][2]string{{"a", "b"}, {"b", "c"}}, cycles: [][]string{}},
+		{name: "diamond", names: []string{"a", "b", "c", "d"}, edges: [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}}, cycles: [][]string{}},
+		{name: "self-loop", names: []string{"a"}, edges: [][2]string{{"a", "a"}}, cycles: [][]string{{"a"}}},
+		{name: "two services", names: []string{"a", "b"}, edges: [][2]string{{"a", "b"}, {"b", "a"}}, cycles: [][]string{{"a", "b"}}},
+		{name: "three services", names: []string{"a", "b", "c"}, edges: [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}}, cycles: [][]string{{"a", "b", "c"}}},
+		{name: "cycle below a chain", names: []string{"a", "b", "c"}, edges: [][2]string{{"a", "b"}, {"b", "c"}, {"c", "b"}}, cycles: [][]string{{"b", "c"}}},
+		{name: "repeated dependency", names: []string{"a", "b"}, edges: [][2]string{{"a", "b"}, {"a", "b"}}, cycles: [][]string{}},
+		{name: "service missing from the list", names: []string{"a"}, edges: [][2]string{{"a", "z"}, {"z", "a"}}, cycles: [][]string{{"a", "z"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := testServiceGraph(tt.names, tt.edges)
+			cycles := [][]string{}
+			for _, cycle := range graph.Cycles() {
+				cycles = append(cycles, serviceNames(cycle))
+			}
+			if !reflect.DeepEqual(cycles, tt.cycles) {
+				t.Errorf("Cycles() = %v, want %v", cycles, tt.cycles)
+			}
+			if graph.HasCycles() != (len(tt.cycles) > 0) {
+				t.Errorf("HasCycles() = %v, want %v", graph.HasCycles(), len(tt.cycles) > 0)
+			}
+		})
+	}
+}
+
+func TestServiceGraphTraversal(t *testing.T) {
+	tests := []struct {
+		name       string
+		names      []string
+		edges      [][2]string
+		service    string
+		upstream   []string
+		downstream []string
+	}{
+		{
+			name:       "chain",
+			names:      []string{"a", "b", "c"},
+			edges:      [][2]string{{"a", "b"}, {"b", "c"}},
+			service:    "b",
+			upstream:   []string{"c"},
+			downstream: []string{"a"},
+		},
+		{
+			name:       "transitive",
+			names:      []string{"a", "b", "c"},
+			edges:      [][2]string{{"a", "b"}, {"b", "c"}},
+			service:    "a",
+			upstream:   []string{"b", "c"},
+			downstream: []string{},
+		},
+		{
+			name:       "diamond is listed once",
+			names:      []string{"a", "b", "c", "d"},
+			edges:      [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}},
+			service:    "d",
+			upstream:   []string{},
+			downstream: []string{"b", "c", "a"},
+		},
+		{
+			name:       "cycle excludes the starting service",
+			names:      []string{"a", "b", "c"},
+			edges:      [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}},
+			service:    "a",
+			upstream:   []string{"b", "c"},
+			downstream: []string{"c", "b"},
+		},
+		{
+			name:       "self-loop",
+			names:      []string{"a"},
+			edges:      [][2]string{{"a", "a"}},
+			service:    "a",
+			upstream:   []string{},
+			downstream: []string{},
+		},
+		{
+			name:       "unknown service",
+			names:      []string{"a"},
+			service:    "missing",
+			upstream:   []string{},
+			downstream: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := testServiceGraph(tt.names, tt.edges)
+			if got := serviceNames(graph.Upstream(tt.service)); !reflect.DeepEqual(got, tt.upstream) {
+				t.Errorf("Upstream(%q) = %v, want %v", tt.service, got, tt.upstream)
+			}
+			if got := serviceNames(graph.Downstream(tt.service)); !reflect.DeepEqual(got, tt.downstream) {
+				t.Errorf("Downstream(%q) = %v, want %v", tt.service, got, tt.downstream)
+			}
+			if got := serviceNames(graph.Impact(tt.service)); !reflect.DeepEqual(got, tt.downstream) {
+				t.Errorf("Impact(%q) = %v, want %v", tt.service, got, tt.downstream)
+			}
+		})
+	}
+}
+
+func TestServiceGraphRendering(t *testing.T) {
+	tests := []struct {
+		name    string
+		service string
+		dot     string
+		mermaid string
+	}{
+		{name: "plain", service: "checkout", dot: `"checkout"`, mermaid: `["checkout"]`},
+		{name: "double quote", service: `say "hi"`, dot: `"say \"hi\""`, mermaid: `["say #quot;hi#quot;"]`},
+		{name: "closing bracket", service: "api [v2]", dot: `"api [v2]"`, mermaid: `["api [v2]"]`},
+		{name: "quote and bracket", service: `"]`, dot: `"\"]"`, mermaid: `["#quot;]"]`},
+		{name: "backslash", service: `a\b`, dot: `"a\\b"`, mermaid: `["a\b"]`},
+		{name: "newline", service: "a\r\nb", dot: `"a\nb"`, mermaid: `["a b"]`},
+		{name: "entity code", service: "C#;", dot: `"C#;"`, mermaid: `["C#35;;"]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := testServiceGraph([]string{tt.service}, nil)
+			wantDOT := "digraph services {\n  rankdir=LR;\n  " + tt.dot + " [label=" + tt.dot + "];\n}\n"
+			if got := graph.ToDOT(); got != wantDOT {
+				t.Errorf("ToDOT() = %q, want %q", got, wantDOT)
+			}
+			wantMermaid := "graph LR\n  s0" + tt.mermaid + "\n"
+			if got := graph.ToMermaid(); got != wantMermaid {
+				t.Errorf("ToMermaid() = %q, want %q", got, wantMermaid)
+			}
+		})
+	}
+}
+
+func TestServiceGraphRenderingEdges(t *testing.T) {
+	graph := testServiceGraph([]string{"web", "db"}, [][2]string{{"web", "db"}, {"web", "web"}})
+	wantDOT := "digraph services {\n  rankdir=LR;\n" +
+		"  \"db\" [label=\"db\"];\n  \"web\" [label=\"web\"];\n" +
+		"  \"web\" -> \"db\";\n  \"web\" -> \"web\";\n}\n"
+	if got := graph.ToDOT(); got != wantDOT {
+		t.Errorf("ToDOT() = %q, want %q", got, wantDOT)
+	}
+	wantMermaid := "graph LR\n  s0[\"db\"]\n  s1[\"web\"]\n  s1 --> s0\n  s1 --> s1\n"
+	if got := graph.ToMermaid(); got != wantMermaid {
+		t.Errorf("ToMermaid() = %q, want %q", got, wantMermaid)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
@@ -13,16 +14,56 @@ import (
 
 // Service represents a service in xMatters.
 type Service struct {
-	ID              *string         `json:"id"`
-	TargetName      *string         `json:"targetName,omitempty"`
-	RecipientType   *string         `json:"recipientType,omitempty"`
-	ServiceType     *string         `json:"serviceType,omitempty"`
-	ServiceTier     *string         `json:"serviceTier,omitempty"`
-	Description     *string         `json:"description,omitempty"`
-	ServiceLinks    []*ServiceLink  `json:"serviceLinks"`
-	OwnedBy         *GroupReference `json:"ownedBy,omitempty"`
-	ExternallyOwned *bool           `json:"externallyOwned,omitempty"`
-	Status          *string         `json:"status,omitempty"`
+	ID              *string         `json:"id" tfsdk:"id"`
+	TargetName      *string         `json:"targetName,omitempty" tfsdk:"target_name"`
+	RecipientType   *string         `json:"recipientType,omitempty" tfsdk:"recipient_type"`
+	ServiceType     *string         `json:"serviceType,omitempty" tfsdk:"service_type"`
+	ServiceTier     *string         `json:"serviceTier,omitempty" tfsdk:"service_tier"`
+	Description     *string         `json:"description,omitempty" tfsdk:"description"`
+	ServiceLinks    []*ServiceLink  `json:"serviceLinks" tfsdk:"service_links"`
+	OwnedBy         *GroupReference `json:"ownedBy,omitempty" tfsdk:"owned_by"`
+	ExternallyOwned *bool           `json:"externallyOwned,omitempty" tfsdk:"externally_owned"`
+	Status          *string         `json:"status,omitempty" tfsdk:"status"`
+}
+
+// ServiceTier represents the tier of a service in xMatters.
+type ServiceTier string
+
+const (
+	// ServiceTier1 is the most critical service tier.
+	ServiceTier1 ServiceTier = "TIER_1"
+	// ServiceTier2 is the intermediate service tier.
+	ServiceTier2 ServiceTier = "TIER_2"
+	// ServiceTier3 is the least critical service tier.
+	ServiceTier3 ServiceTier = "TIER_3"
+)
+
+// IsValid reports whether the ServiceTier is one of the tiers supported by xMatters.
+func (t ServiceTier) IsValid() bool {
+	switch t {
+	case ServiceTier1, ServiceTier2, ServiceTier3:
+		return true
+	}
+	return false
+}
+
+// ServiceStatus represents the status of a service in xMatters.
+type ServiceStatus string
+
+const (
+	// ServiceStatusActive indicates the service is active.
+	ServiceStatusActive ServiceStatus = "ACTIVE"
+	// ServiceStatusInactive indicates the service is inactive.
+	ServiceStatusInactive ServiceStatus = "INACTIVE"
+)
+
+// IsValid reports whether the ServiceStatus is one of the statuses supported by xMatters.
+func (s ServiceStatus) IsValid() bool {
+	switch s {
+	case ServiceStatusActive, ServiceStatusInactive:
+		return true
+	}
+	return false
 }
 
 // ServicePagination contains a paginated list of services.
@@ -32,6 +73,15 @@ type ServicePagination struct {
 	Services []*Service `json:"data,omitempty"`
 }
 
+// serviceJSON is the JSON representation of a Service, with its embedded collections nested within pagination objects.
+type serviceJSON struct {
+	serviceFields
+	ServiceLinks embeddedList[*ServiceLink] `json:"serviceLinks"`
+}
+
+// serviceFields has the fields of a Service without its methods, so decoding it does not call UnmarshalJSON.
+type serviceFields Service
+
 // ServiceLink represents an optional URL link with associated label assigned to an xMatters service.
 type ServiceLink struct {
 	Label *string `json:"label" tfsdk:"link_text"`
@@ -51,9 +101,9 @@ type ServiceLinksPagination struct {
 
 // ServiceDependency represents a service dependency relationship in xMatters.
 type ServiceDependency struct {
-	ID               *string           `json:"id"`
-	Service          *ServiceReference `json:"service"`
-	DependentService *ServiceReference `json:"dependentService"`
+	ID               *string           `json:"id" tfsdk:"id"`
+	Service          *ServiceReference `json:"service" tfsdk:"service"`
+	DependentService *ServiceReference `json:"dependentService" tfsdk:"dependent_service"`
 }
 
 // ServiceDependencyPagination contains a paginated list of service dependencies.
@@ -65,8 +115,8 @@ type ServiceDependencyPagination struct {
 
 // ServiceReference represents a shorthand version of a service in xMatters.
 type ServiceReference struct {
-	ID         *string `json:"id"`
-	TargetName *string `json:"targetName,omitempty"`
+	ID         *string `json:"id" tfsdk:"id"`
+	TargetName *string `json:"targetName,omitempty" tfsdk:"target_name"`
 }
 
 // -------------------------------------------------------------------------------------------------
@@ -75,26 +125,52 @@ type ServiceReference struct {
 
 // GetServicesParams contains available API query parameters for the GetServiceList method.
 type GetServicesParams struct {
-	Search  string `url:"search,omitempty"`
-	Fields  string `url:"fields,omitempty"`
-	Operand string `url:"operand,omitempty"`
-	OwnedBy string `url:"ownedBy,omitempty"`
+	Embed       string `url:"embed,omitempty"`
+	SearchQuery `url:"search,omitempty"`
+	OwnedBy     string `url:"ownedBy,omitempty"`
 }
 
 // PushServiceParams contains available API body parameters for the PushService method.
 type PushServiceParams struct {
-	ID           string          `json:"id,omitempty"`
-	TargetName   string          `json:"targetName"`
-	Description  *string         `json:"description"`
-	ServiceType  string          `json:"serviceType"`
-	ServiceTier  *string         `json:"serviceTier"`
-	OwnedBy      *GroupReference `json:"ownedBy"`
-	ServiceLinks []*ServiceLink  `json:"serviceLinks"`
+	ID           string                    `json:"id,omitempty"`
+	TargetName   string                    `json:"targetName"`
+	Description  Nullable[string]          `json:"description,omitempty"`
+	ServiceType  string                    `json:"serviceType"`
+	ServiceTier  Nullable[string]          `json:"serviceTier,omitempty"`
+	OwnedBy      Nullable[*GroupReference] `json:"ownedBy,omitempty"`
+	ServiceLinks []*ServiceLink            `json:"serviceLinks"`
+	Status       ServiceStatus             `json:"status,omitempty"`
+}
+
+// UpdateServiceParams contains the service fields to change with the UpdateService method.
+// Only the fields that are set are sent; all other fields keep their current values in xMatters.
+// A null Description or ServiceTier clears it, and a non-nil, empty ServiceLinks slice removes all links from the service.
+type UpdateServiceParams struct {
+	TargetName   string                    `json:"targetName,omitempty"`
+	Description  Nullable[string]          `json:"description,omitempty"`
+	ServiceType  string                    `json:"serviceType,omitempty"`
+	ServiceTier  Nullable[string]          `json:"serviceTier,omitempty"`
+	OwnedBy      Nullable[*GroupReference] `json:"ownedBy,omitempty"`
+	ServiceLinks []*ServiceLink            `json:"serviceLinks,omitempty"`
+	Status       ServiceStatus             `json:"status,omitempty"`
+}
+
+// serviceUpdate is the body of a request that modifies only the fields of a service set in its UpdateServiceParams.
+// ServiceLinks shadows the links of the params, so an empty slice is sent rather than omitted.
+type serviceUpdate struct {
+	ID string `json:"id"`
+	UpdateServiceParams
+	ServiceLinks *[]*ServiceLink `json:"serviceLinks,omitempty"`
+}
+
+// GetServiceDependenciesParams contains available API query parameters for the GetServiceDependencyList method.
+type GetServiceDependenciesParams struct {
+	Services string `url:"services,omitempty"`
 }
 
 // PushServiceDependencyParams contains available API body parameters for the PushServiceDependency method.
 type PushServiceDependencyParams struct {
-	ID                 string `json:"id"`
+	ID                 string `json:"id,omitempty"`
 	ServiceID          string `json:"serviceId"`
 	DependentServiceID string `json:"dependentServiceId"`
 }
@@ -106,35 +182,53 @@ type PushServiceDependencyParams struct {
 // Custom Unmarshaller for Service to handle embedded service links
 // This is necessary because the JSON structure for service links is nested within a pagination object.
 func (s *Service) UnmarshalJSON(data []byte) error {
-	// Define an alias to avoid recursion
-	type Alias Service
-	aux := &struct {
-		ServiceLinks struct {
-			Data []*ServiceLink `json:"data"`
-		} `json:"serviceLinks"`
-		*Alias
-	}{
-		Alias: (*Alias)(s),
-	}
-
-	// Unmarshal the JSON into the auxiliary struct
-	if err := json.Unmarshal(data, aux); err != nil {
+	var decoded serviceJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
 		return fmt.Errorf("failed to unmarshal Service: %w", err)
 	}
+	*s = *decoded.service()
+	return nil
+}
 
-	// Assign the extracted service links
-	s.ServiceLinks = aux.ServiceLinks.Data
+// Custom Marshaller for Service to nest embedded service links within pagination objects
+// This mirrors UnmarshalJSON, so a marshalled Service can be unmarshalled again without losing data.
+func (s Service) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		ServiceLinks *embeddedList[*ServiceLink] `json:"serviceLinks,omitempty"`
+		serviceFields
+	}{
+		ServiceLinks:  newEmbeddedList(s.ServiceLinks),
+		serviceFields: serviceFields(s),
+	})
+}
 
-	return nil
+// service returns the Service held by its JSON representation, taking its embedded collections out of their pagination objects.
+func (s *serviceJSON) service() *Service {
+	result := (*Service)(&s.serviceFields)
+	result.ServiceLinks = s.ServiceLinks.Data
+	return result
+}
+
+// String returns a compact, human-readable summary of the service, such as
+// "Service Checkout (BUSINESS_SERVICE, TIER_1, owned by Payments)".
+func (s Service) String() string {
+	owner := ""
+	if s.OwnedBy != nil && s.OwnedBy.TargetName != nil {
+		owner = "owned by " + *s.OwnedBy.TargetName
+	}
+	return formatSummary("Service", stringValue(s.TargetName), stringValue(s.ServiceType), stringValue(s.ServiceTier), owner)
 }
 
 // GetService retrieves a service in xMatters.
 // It requires the serviceId parameter to identify the specific service, and returns a Service object.
 // A URL parameter is added to the request URI to embed service links of the service in the response.
-func (xmatters *XMattersAPI) GetService(serviceId string) (Service, error) {
-	uri := buildURI(fmt.Sprintf("/services/%s", serviceId), struct {
-		Embed string `url:"embed"`
-	}{Embed: "serviceLinks"})
+// Optional GetOption values, such as WithEmbed, override the embedded objects and fields of the response.
+func (xmatters *XMattersAPI) GetService(serviceId string, opts ...GetOption) (Service, error) {
+	if err := validateIdentifier("service ID or target name", serviceId); err != nil {
+		return Service{}, err
+	}
+
+	uri := buildURI(fmt.Sprintf("/services/%s", pathSegment(serviceId)), parseGetOptions("serviceLinks", opts))
 
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
@@ -144,7 +238,7 @@ func (xmatters *XMattersAPI) GetService(serviceId string) (Service, error) {
 
 	// Unmarshal the response into a Service struct.
 	var result Service
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return Service{}, newUnmarshalError()
 	}
@@ -155,62 +249,125 @@ func (xmatters *XMattersAPI) GetService(serviceId string) (Service, error) {
 
 // GetServiceList retrieves a list of services in xMatters.
 // It accepts optional query parameters to filter the results and returns a slice of Service objects.
+// The OwnedBy parameter accepts a comma-separated list of group IDs or group target names;
+// target names are resolved to group IDs before the request is made.
 func (xmatters *XMattersAPI) GetServiceList(params GetServicesParams) ([]*Service, error) {
+	// Resolve any owner group target names to IDs
+	if params.OwnedBy != "" {
+		owners := strings.Split(params.OwnedBy, ",")
+		for i, owner := range owners {
+			owner = strings.TrimSpace(owner)
+			if IsUUID(owner) {
+				owners[i] = owner
+				continue
+			}
+			group, err := xmatters.GetGroup(owner)
+			if err != nil {
+				return []*Service{}, err
+			}
+			owners[i] = stringValue(group.ID)
+		}
+		params.OwnedBy = strings.Join(owners, ",")
+	}
+
 	uri := buildURI("/services", params) // The URI including any Query Parameters
 
 	// Use the GetServicePaginationSet method to get all paginated results
 	serviceList, err := xmatters.GetServicePaginationSet(uri)
 	if err != nil {
-		return []*Service{}, err
+		return serviceList, err
 	}
 
 	// Return the full list of Services.
 	return serviceList, nil
 }
 
-// GetServicePaginationSet is a recursive helper function that handles a paginated list of services.
-// It takes a URI as input and retrieves the paginated set from that URI.
-// It checks for additional pages and recursively fetches them until all pages are retrieved.
-func (xmatters *XMattersAPI) GetServicePaginationSet(uri string) ([]*Service, error) {
-	// Perform the API request with provided URI
-	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
-	if err != nil {
-		return []*Service{}, err
+// GetServicesOwnedByPersonsGroups retrieves the services owned by any group the person belongs to.
+// Group membership is resolved recursively, so services owned by a group that contains one of the
+// person's groups are included as well. It requires the personId parameter, which may be an ID or target name.
+func (xmatters *XMattersAPI) GetServicesOwnedByPersonsGroups(personId string) ([]*Service, error) {
+	// Resolve a target name to the person ID used by the members filter
+	if !IsUUID(personId) {
+		person, err := xmatters.GetPerson(personId)
+		if err != nil {
+			return []*Service{}, err
+		}
+		personId = stringValue(person.ID)
 	}
 
-	// Unmarshal the response into a ServicePagination struct.
-	var servicePagination ServicePagination
-	err = json.Unmarshal(resp, &servicePagination)
-	if err != nil {
-		return []*Service{}, newUnmarshalError()
+	// Walk up the group hierarchy, starting from the groups the person belongs to directly
+	var groupIds []string
+	visited := make(map[string]bool)
+	frontier := []string{personId}
+	for len(frontier) > 0 {
+		memberId := frontier[0]
+		frontier = frontier[1:]
+		groups, err := xmatters.GetGroupList(GetGroupsParams{Members: memberId})
+		if err != nil {
+			return []*Service{}, err
+		}
+		for _, group := range groups {
+			groupId := stringValue(group.ID)
+			if groupId == "" || visited[groupId] {
+				continue
+			}
+			visited[groupId] = true
+			groupIds = append(groupIds, groupId)
+			frontier = append(frontier, groupId)
+		}
 	}
 
-	// Assign services to be returned
-	serviceList := servicePagination.Services
-
-	// Check for additional paginated results
-	if servicePagination.Pagination.Links.Next != nil {
-		// Remove defaultBasePath (/api/xm/1) from the next URI
-		nextUri := strings.ReplaceAll(*servicePagination.Pagination.Links.Next, defaultBasePath, "")
-		// Use recursion to get the next set of results
-		nextSet, err := xmatters.GetServicePaginationSet(nextUri)
+	// Aggregate the services owned by each group, removing duplicates
+	serviceList := []*Service{}
+	seen := make(map[string]bool)
+	for _, groupId := range groupIds {
+		services, err := xmatters.GetServiceList(GetServicesParams{OwnedBy: groupId})
 		if err != nil {
 			return []*Service{}, err
 		}
-		serviceList = append(serviceList, nextSet...)
+		for _, service := range services {
+			if seen[stringValue(service.ID)] {
+				continue
+			}
+			seen[stringValue(service.ID)] = true
+			serviceList = append(serviceList, service)
+		}
 	}
 
-	// Return the fully concatenated list of services from all paginated results
+	// Return the aggregated list of services
 	return serviceList, nil
 }
 
+// GetServicePaginationSet retrieves a paginated list of services.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetServicePaginationSet(uri string) ([]*Service, error) {
+	return getConvertedPaginationSet(xmatters, uri, (*serviceJSON).service)
+}
+
 // PushService either creates a new service in xMatters or modifies an existing service.
 // It requires the PushServiceParams struct containing the service details.
+// The service tier and status, when provided, must be a valid ServiceTier and ServiceStatus.
 // It returns the created or modified Service object.
 // If the params.ID is provided it updates the existing service; otherwise, it creates a new one.
-func (xmatters *XMattersAPI) PushService(params PushServiceParams) (Service, error) {
+// With IfUnchanged, an update fails with ErrConflict when the service was changed since it was read.
+func (xmatters *XMattersAPI) PushService(params PushServiceParams, opts ...PushOption) (Service, error) {
+	// Validate the service tier and status locally so invalid values fail with a clear message
+	if err := validateServiceFields(params.ServiceTier, params.Status); err != nil {
+		return Service{}, err
+	}
+
 	uri := buildURI("/services", nil) // The URI including any Query Parameters
 
+	// Check that the service is unchanged since it was read
+	options := parsePushOptions(opts)
+	if options.externalKey != "" {
+		return Service{}, newValidationError("services do not have external keys")
+	}
+	if err := checkUnchanged(params.ID, options, xmatters.GetService, unchangedService); err != nil {
+		return Service{}, err
+	}
+
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
 	if err != nil {
@@ -219,7 +376,7 @@ func (xmatters *XMattersAPI) PushService(params PushServiceParams) (Service, err
 
 	// Unmarshal the response into a Service struct.
 	var result Service
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return Service{}, newUnmarshalError()
 	}
@@ -228,11 +385,73 @@ func (xmatters *XMattersAPI) PushService(params PushServiceParams) (Service, err
 	return result, nil
 }
 
+// UpdateService modifies only the specified fields of an existing service in xMatters.
+// It requires the serviceId parameter to identify the specific service and the UpdateServiceParams struct containing the changes.
+// Only the changed fields are sent, so concurrent changes to the other fields are kept. A target name is resolved
+// to the ID of the service first, as services are modified by ID. The service tier and status are validated only
+// when they are changed, so a service whose stored tier is not a valid ServiceTier can still be updated.
+// It returns the modified Service object.
+func (xmatters *XMattersAPI) UpdateService(serviceId string, params UpdateServiceParams) (Service, error) {
+	if err := validateIdentifier("service ID or target name", serviceId); err != nil {
+		return Service{}, err
+	}
+	if err := validateServiceFields(params.ServiceTier, params.Status); err != nil {
+		return Service{}, err
+	}
+	if !IsUUID(serviceId) {
+		current, err := xmatters.GetService(serviceId)
+		if err != nil {
+			return Service{}, err
+		}
+		serviceId = stringValue(current.ID)
+	}
+	return xmatters.updateService(serviceId, params)
+}
+
+// updateService sends the fields of params set for the service with the ID, without retrieving the service.
+func (xmatters *XMattersAPI) updateService(id string, params UpdateServiceParams) (Service, error) {
+	uri := buildURI("/services", nil) // The URI for modifying a Service in xMatters
+
+	body := serviceUpdate{ID: id, UpdateServiceParams: params}
+	if params.ServiceLinks != nil {
+		body.ServiceLinks = &params.ServiceLinks
+	}
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, body)
+	if err != nil {
+		return Service{}, err
+	}
+
+	// Unmarshal the response into a Service struct.
+	var result Service
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Service{}, newUnmarshalError()
+	}
+
+	// Return the modified Service object.
+	return result, nil
+}
+
+// TransferServiceOwnership assigns an existing service in xMatters to a new owning group.
+// It requires the serviceId parameter to identify the specific service and the target name of the new owner group.
+// It returns the modified Service object.
+func (xmatters *XMattersAPI) TransferServiceOwnership(serviceId, groupTargetName string) (Service, error) {
+	return xmatters.UpdateService(serviceId, UpdateServiceParams{
+		OwnedBy: NewNullable(&GroupReference{TargetName: &groupTargetName}),
+	})
+}
+
 // DeleteService deletes a service in xMatters.
 // It requires the serviceId parameter to identify the specific service to be deleted.
 // It returns an error if the deletion fails.
 func (xmatters *XMattersAPI) DeleteService(serviceId string) error {
-	uri := buildURI(fmt.Sprintf("/services/%s", serviceId), nil)
+	if err := validateIdentifier("service ID or target name", serviceId); err != nil {
+		return err
+	}
+
+	uri := buildURI(fmt.Sprintf("/services/%s", pathSegment(serviceId)), nil)
 
 	// Perform the API request.
 	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
@@ -244,6 +463,154 @@ func (xmatters *XMattersAPI) DeleteService(serviceId string) error {
 	return nil
 }
 
+// DeleteServiceCascade deletes a service in xMatters along with every service dependency that references it.
+// It requires the serviceId parameter to identify the specific service to be deleted, which may be its ID or target name.
+// When dryRun is true nothing is deleted and the dependencies that would be removed are returned.
+// It returns the removed service dependencies, or an error if any deletion fails.
+func (xmatters *XMattersAPI) DeleteServiceCascade(serviceId string, dryRun bool) ([]*ServiceDependency, error) {
+	// Resolve the service, as dependencies reference it by ID
+	service, err := xmatters.GetService(serviceId)
+	if err != nil {
+		return []*ServiceDependency{}, err
+	}
+	id := stringValue(service.ID)
+
+	// Retrieve all dependencies and keep those referencing the service on either side
+	dependencies, err := xmatters.GetServiceDependencyList(GetServiceDependenciesParams{})
+	if err != nil {
+		return []*ServiceDependency{}, err
+	}
+	referencing := []*ServiceDependency{}
+	for _, dependency := range dependencies {
+		if (dependency.Service != nil && stringValue(dependency.Service.ID) == id) ||
+			(dependency.DependentService != nil && stringValue(dependency.DependentService.ID) == id) {
+			referencing = append(referencing, dependency)
+		}
+	}
+
+	// Return the dependencies that would be removed without deleting anything
+	if dryRun {
+		return referencing, nil
+	}
+
+	// Remove the dependency edges before the service itself
+	for _, dependency := range referencing {
+		if err := xmatters.DeleteServiceDependency(stringValue(dependency.ID)); err != nil {
+			return referencing, err
+		}
+	}
+	if err := xmatters.DeleteService(id); err != nil {
+		return referencing, err
+	}
+
+	// Return the removed dependencies
+	return referencing, nil
+}
+
+// -------------------------------------------------------------------------------------------------
+// Service Link Methods
+// -------------------------------------------------------------------------------------------------
+
+// AddServiceLink adds a link to an existing service in xMatters.
+// If the service already has a link with the same URL, its label is replaced instead.
+// It returns the modified Service object.
+func (xmatters *XMattersAPI) AddServiceLink(serviceId string, link ServiceLink) (Service, error) {
+	if err := validateServiceLink(&link); err != nil {
+		return Service{}, err
+	}
+
+	// Retrieve the current links of the service
+	current, err := xmatters.GetService(serviceId)
+	if err != nil {
+		return Service{}, err
+	}
+
+	// Replace a link with a matching URL, otherwise append the new link
+	links := make([]*ServiceLink, 0, len(current.ServiceLinks)+1)
+	replaced := false
+	for _, existing := range current.ServiceLinks {
+		if stringValue(existing.URL) == *link.URL {
+			links = append(links, &link)
+			replaced = true
+			continue
+		}
+		links = append(links, existing)
+	}
+	if !replaced {
+		links = append(links, &link)
+	}
+
+	return xmatters.updateService(stringValue(current.ID), UpdateServiceParams{ServiceLinks: links})
+}
+
+// RemoveServiceLink removes the link with the given URL from an existing service in xMatters.
+// It returns the modified Service object, or an error if the service has no link with that URL.
+func (xmatters *XMattersAPI) RemoveServiceLink(serviceId, linkURL string) (Service, error) {
+	// Retrieve the current links of the service
+	current, err := xmatters.GetService(serviceId)
+	if err != nil {
+		return Service{}, err
+	}
+
+	// Keep every link except the one being removed
+	links := make([]*ServiceLink, 0, len(current.ServiceLinks))
+	for _, existing := range current.ServiceLinks {
+		if stringValue(existing.URL) != linkURL {
+			links = append(links, existing)
+		}
+	}
+	if len(links) == len(current.ServiceLinks) {
+		return Service{}, newValidationError(fmt.Sprintf("service %s has no link with URL %s", serviceId, linkURL))
+	}
+
+	return xmatters.updateService(stringValue(current.ID), UpdateServiceParams{ServiceLinks: links})
+}
+
+// ReplaceServiceLinks replaces all links of an existing service in xMatters with the provided links.
+// Passing an empty slice removes every link from the service.
+// It returns the modified Service object.
+func (xmatters *XMattersAPI) ReplaceServiceLinks(serviceId string, links []*ServiceLink) (Service, error) {
+	for _, link := range links {
+		if err := validateServiceLink(link); err != nil {
+			return Service{}, err
+		}
+	}
+	if links == nil {
+		links = []*ServiceLink{}
+	}
+
+	return xmatters.UpdateService(serviceId, UpdateServiceParams{ServiceLinks: links})
+}
+
+// validateServiceFields checks that a service tier and status, when they are set, are a valid ServiceTier and ServiceStatus.
+func validateServiceFields(tier Nullable[string], status ServiceStatus) error {
+	if tier, ok := tier.Get(); ok && !ServiceTier(tier).IsValid() {
+		return newValidationError(fmt.Sprintf("invalid service tier %q: must be one of %s, %s, %s", tier, ServiceTier1, ServiceTier2, ServiceTier3))
+	}
+	if status != "" && !status.IsValid() {
+		return newValidationError(fmt.Sprintf("invalid service status %q: must be one of %s, %s", status, ServiceStatusActive, ServiceStatusInactive))
+	}
+	return nil
+}
+
+// validateServiceLink checks that a service link has a label and an absolute HTTP or HTTPS URL.
+func validateServiceLink(link *ServiceLink) error {
+	if link == nil {
+		return newValidationError("service link must not be nil")
+	}
+	if link.Label == nil || strings.TrimSpace(*link.Label) == "" {
+		return newValidationError("service link label must not be empty")
+	}
+	if link.URL == nil {
+		return newValidationError("service link URL must not be empty")
+	}
+	parsed, err := url.ParseRequestURI(*link.URL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return newValidationError(fmt.Sprintf("service link URL %q must be an absolute http or https URL", *link.URL))
+	}
+	return nil
+}
+
 // -------------------------------------------------------------------------------------------------
 // Service Dependancy Methods
 // -------------------------------------------------------------------------------------------------
@@ -251,7 +618,11 @@ func (xmatters *XMattersAPI) DeleteService(serviceId string) error {
 // GetServiceDependency retrieves a service dependency in xMatters.
 // It requires the dependencyId parameter to identify the specific service dependency, and returns a ServiceDependency object.
 func (xmatters *XMattersAPI) GetServiceDependency(dependencyId string) (ServiceDependency, error) {
-	uri := buildURI(fmt.Sprintf("/service-dependencies/%s", dependencyId), nil) // The URI including any Query Parameters
+	if err := ValidateUUID("service dependency ID", dependencyId); err != nil {
+		return ServiceDependency{}, err
+	}
+
+	uri := buildURI(fmt.Sprintf("/service-dependencies/%s", pathSegment(dependencyId)), nil) // The URI including any Query Parameters
 
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
@@ -261,7 +632,7 @@ func (xmatters *XMattersAPI) GetServiceDependency(dependencyId string) (ServiceD
 
 	// Unmarshal the response into a ServiceDependency struct.
 	var result ServiceDependency
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return ServiceDependency{}, newUnmarshalError()
 	}
@@ -270,6 +641,28 @@ func (xmatters *XMattersAPI) GetServiceDependency(dependencyId string) (ServiceD
 	return result, err
 }
 
+// GetServiceDependencyList retrieves a list of service dependencies in xMatters.
+// It accepts optional query parameters to filter the results and returns a slice of ServiceDependency objects.
+func (xmatters *XMattersAPI) GetServiceDependencyList(params GetServiceDependenciesParams) ([]*ServiceDependency, error) {
+	uri := buildURI("/service-dependencies", params) // The URI including any Query Parameters
+
+	// Use the GetServiceDependencyPaginationSet method to get all paginated results
+	dependencyList, err := xmatters.GetServiceDependencyPaginationSet(uri)
+	if err != nil {
+		return dependencyList, err
+	}
+
+	// Return the full list of Service Dependencies.
+	return dependencyList, nil
+}
+
+// GetServiceDependencyPaginationSet retrieves a paginated list of service dependencies.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetServiceDependencyPaginationSet(uri string) ([]*ServiceDependency, error) {
+	return getPaginationSet[ServiceDependency](xmatters, uri)
+}
+
 // PushServiceDependency either creates a new service dependency in xMatters or modifies an existing service dependency.
 // It requires the PushServiceDependencyParams struct containing the service dependency details.
 // It returns the created or modified ServiceDependency object.
@@ -285,7 +678,7 @@ func (xmatters *XMattersAPI) PushServiceDependency(params PushServiceDependencyP
 
 	// Unmarshal the response into a ServiceDependency struct.
 	var result ServiceDependency
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return ServiceDependency{}, newUnmarshalError()
 	}
@@ -298,7 +691,11 @@ func (xmatters *XMattersAPI) PushServiceDependency(params PushServiceDependencyP
 // It requires the serviceDepId parameter to identify the specific service dependency to be deleted.
 // It returns an error if the deletion fails.
 func (xmatters *XMattersAPI) DeleteServiceDependency(serviceDepId string) error {
-	uri := buildURI(fmt.Sprintf("/service-dependencies/%s", serviceDepId), nil) // The URI including any Query Parameters
+	if err := ValidateUUID("service dependency ID", serviceDepId); err != nil {
+		return err
+	}
+
+	uri := buildURI(fmt.Sprintf("/service-dependencies/%s", pathSegment(serviceDepId)), nil) // The URI including any Query Parameters
 
 	// Perform the API request.
 	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
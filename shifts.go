@@ -1,14 +1,21 @@
 package xmatters
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
 type Shift struct {
-	ID         *string          `json:"id"`
-	Group      *GroupReference  `json:"group"`
-	Name       *string          `json:"name"`
-	Start      *string          `json:"start"`
-	End        *string          `json:"end"`
-	Timezone   *string          `json:"timezone"`
-	Recurrence *ShiftRecurrence `json:"recurrence"`
-	Members    []*ShiftMember   `json:"members"`
+	ID         *string          `json:"id" tfsdk:"id"`
+	Group      *GroupReference  `json:"group" tfsdk:"group"`
+	Name       *string          `json:"name" tfsdk:"name"`
+	Start      *Timestamp       `json:"start" tfsdk:"start"`
+	End        *Timestamp       `json:"end" tfsdk:"end"`
+	Timezone   *string          `json:"timezone" tfsdk:"timezone"`
+	Recurrence *ShiftRecurrence `json:"recurrence" tfsdk:"recurrence"`
+	Members    []*ShiftMember   `json:"members" tfsdk:"members"`
 }
 
 type ShiftPagination struct {
@@ -17,34 +24,151 @@ type ShiftPagination struct {
 }
 
 type ShiftRecurrence struct {
-	Frequency           *string   `json:"frequency"`
-	RepeatEvery         *int64    `json:"repeatEvery,omitempty"`
-	OnDays              []*string `json:"onDays,omitempty"`
-	On                  *string   `json:"on,omitempty"`
-	Months              []*string `json:"months,omitempty"`
-	DateOfMonth         *string   `json:"dateOfMonth,omitempty"`
-	DayOfWeekClassifier *string   `json:"dayOfWeekClassifier,omitempty"`
-	DayOfWeek           *string   `json:"dayOfWeek,omitempty"`
-	End                 *ShiftEnd `json:"end,omitempty"`
+	Frequency           *string   `json:"frequency" tfsdk:"frequency"`
+	RepeatEvery         *int64    `json:"repeatEvery,omitempty" tfsdk:"repeat_every"`
+	OnDays              []*string `json:"onDays,omitempty" tfsdk:"on_days"`
+	On                  *string   `json:"on,omitempty" tfsdk:"on"`
+	Months              []*string `json:"months,omitempty" tfsdk:"months"`
+	DateOfMonth         *string   `json:"dateOfMonth,omitempty" tfsdk:"date_of_month"`
+	DayOfWeekClassifier *string   `json:"dayOfWeekClassifier,omitempty" tfsdk:"day_of_week_classifier"`
+	DayOfWeek           *string   `json:"dayOfWeek,omitempty" tfsdk:"day_of_week"`
+	End                 *ShiftEnd `json:"end,omitempty" tfsdk:"end"`
 }
 
 type ShiftEnd struct {
-	EndBy       *string `json:"endBy"`
-	Date        *string `json:"date"`
-	Repetitions *int64  `json:"repititions"`
+	EndBy       *string `json:"endBy" tfsdk:"end_by"`
+	Date        *string `json:"date" tfsdk:"date"`
+	Repetitions *int64  `json:"repititions" tfsdk:"repetitions"`
 }
 
 type ShiftMember struct {
-	Recipient      *RecipientPointer `json:"recipient"`
-	Shift          *ReferenceById    `json:"shift"`
-	Position       *int64            `json:"position"`
-	Delay          *int64            `json:"delay"`
-	EscalationType *string           `json:"escalationType"`
-	InRotation     *bool             `json:"inRotation"`
+	Recipient      *RecipientPointer `json:"recipient" tfsdk:"recipient"`
+	Shift          *ReferenceById    `json:"shift" tfsdk:"shift"`
+	Position       *int64            `json:"position" tfsdk:"position"`
+	Delay          *int64            `json:"delay" tfsdk:"delay"`
+	EscalationType *string           `json:"escalationType" tfsdk:"escalation_type"`
+	InRotation     *bool             `json:"inRotation" tfsdk:"in_rotation"`
 }
 
 // RecipientPointer is a reference to a recipient.
 type RecipientPointer struct {
-	ID   *string `json:"id"`
-	Type *string `json:"recipientType"`
+	ID   *string `json:"id" tfsdk:"id"`
+	Type *string `json:"recipientType" tfsdk:"recipient_type"`
+}
+
+// PushShiftParams contains available API body parameters for the PushShift method.
+// Members reference their recipient by ID and recipient type; the Shift of each member is not sent.
+type PushShiftParams struct {
+	// Required Fields
+	Name  string     `json:"name"`
+	Start *Timestamp `json:"start"`
+	End   *Timestamp `json:"end"`
+	// Optional Fields
+	ID         string             `json:"id,omitempty"`
+	Timezone   string             `json:"timezone,omitempty"`
+	Recurrence *ShiftRecurrence   `json:"recurrence,omitempty"`
+	Members    []*PushShiftMember `json:"members,omitempty"`
+}
+
+// PushShiftMember is a member of a shift created or modified by PushShift.
+type PushShiftMember struct {
+	Recipient      *RecipientPointer `json:"recipient"`
+	Position       *int64            `json:"position,omitempty"`
+	Delay          *int64            `json:"delay,omitempty"`
+	EscalationType *string           `json:"escalationType,omitempty"`
+	InRotation     *bool             `json:"inRotation,omitempty"`
+}
+
+// ShiftMemberPagination contains a paginated list of shift members.
+// It extends the Pagination struct containing links to additional pages.
+type ShiftMemberPagination struct {
+	*Pagination
+	Members []*ShiftMember `json:"data"`
+}
+
+// Custom Unmarshaller for Shift to handle embedded members
+// This is necessary because the members are nested within a pagination object when embedded in a shift.
+func (s *Shift) UnmarshalJSON(data []byte) error {
+	// Define an alias to avoid recursion
+	type Alias Shift
+	aux := &struct {
+		Members json.RawMessage `json:"members"`
+		*Alias
+	}{
+		Alias: (*Alias)(s),
+	}
+
+	// Unmarshal the JSON into the auxiliary struct
+	if err := json.Unmarshal(data, aux); err != nil {
+		return fmt.Errorf("failed to unmarshal Shift: %w", err)
+	}
+
+	// Members are returned either as a plain list or nested within a pagination object
+	s.Members = nil
+	trimmed := bytes.TrimSpace(aux.Members)
+	if len(trimmed) == 0 || string(trimmed) == "null" {
+		return nil
+	}
+	if trimmed[0] == '[' {
+		return json.Unmarshal(trimmed, &s.Members)
+	}
+	var members ShiftMemberPagination
+	if err := json.Unmarshal(trimmed, &members); err != nil {
+		return fmt.Errorf("failed to unmarshal Shift members: %w", err)
+	}
+	s.Members = members.Members
+
+	return nil
+}
+
+// GetGroupShifts retrieves the shifts of a group in xMatters.
+// It requires the groupId parameter to identify the specific group, and returns a slice of Shift objects
+// including their members.
+func (xmatters *XMattersAPI) GetGroupShifts(groupId string) ([]*Shift, error) {
+	uri := buildURI(fmt.Sprintf("/groups/%s/shifts", pathSegment(groupId)), struct {
+		Embed string `url:"embed"`
+	}{Embed: "members"})
+
+	// Use the GetShiftPaginationSet method to get all paginated results
+	shiftList, err := xmatters.GetShiftPaginationSet(uri)
+	if err != nil {
+		return shiftList, err
+	}
+
+	// Return the full list of Shifts.
+	return shiftList, nil
+}
+
+// PushShift either creates a new shift in a group in xMatters or modifies an existing shift of the group.
+// It requires the groupId parameter to identify the group, and the PushShiftParams struct containing the shift details.
+// If the params.ID is provided it updates the existing shift; otherwise, it creates a new one.
+// It returns the created or modified Shift object.
+func (xmatters *XMattersAPI) PushShift(groupId string, params PushShiftParams) (Shift, error) {
+	if err := validateIdentifier("group ID or target name", groupId); err != nil {
+		return Shift{}, err
+	}
+	if params.Name == "" {
+		return Shift{}, newValidationError("a shift name is required")
+	}
+	uri := buildURI(fmt.Sprintf("/groups/%s/shifts", pathSegment(groupId)), nil)
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
+	if err != nil {
+		return Shift{}, err
+	}
+
+	// Unmarshal the response into a Shift struct.
+	var result Shift
+	if err := xmatters.decode(resp, &result); err != nil {
+		return Shift{}, newUnmarshalError()
+	}
+	return result, nil
+}
+
+// GetShiftPaginationSet retrieves a paginated list of shifts.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetShiftPaginationSet(uri string) ([]*Shift, error) {
+	return getPaginationSet[Shift](xmatters, uri)
 }
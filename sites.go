@@ -1,10 +1,8 @@
 package xmatters
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 )
 
 // -------------------------------------------------------------------------------------------------
@@ -13,21 +11,31 @@ import (
 
 // Site represents a site in xMatters.
 type Site struct {
-	Address1   *string  `json:"address1,omitempty"`
-	Address2   *string  `json:"address2,omitempty"`
-	City       *string  `json:"city,omitempty"`
-	Country    *string  `json:"country,omitempty"`
-	ID         *string  `json:"id"`
-	Language   *string  `json:"language,omitempty"`
-	Latitude   *float64 `json:"latitude,omitempty"`
-	Longitude  *float64 `json:"longitude,omitempty"`
-	Name       *string  `json:"name,omitempty"`
-	PostalCode *string  `json:"postalCode,omitempty"`
-	State      *string  `json:"state,omitempty"`
-	Status     *string  `json:"status,omitempty"`
-	Timezone   *string  `json:"timezone,omitempty"`
+	Address1   *string  `json:"address1,omitempty" tfsdk:"address1"`
+	Address2   *string  `json:"address2,omitempty" tfsdk:"address2"`
+	City       *string  `json:"city,omitempty" tfsdk:"city"`
+	Country    *string  `json:"country,omitempty" tfsdk:"country"`
+	ID         *string  `json:"id" tfsdk:"id"`
+	Language   *string  `json:"language,omitempty" tfsdk:"language"`
+	Latitude   *float64 `json:"latitude,omitempty" tfsdk:"latitude"`
+	Longitude  *float64 `json:"longitude,omitempty" tfsdk:"longitude"`
+	Name       *string  `json:"name,omitempty" tfsdk:"name"`
+	PostalCode *string  `json:"postalCode,omitempty" tfsdk:"postal_code"`
+	State      *string  `json:"state,omitempty" tfsdk:"state"`
+	Status     *string  `json:"status,omitempty" tfsdk:"status"`
+	Timezone   *string  `json:"timezone,omitempty" tfsdk:"timezone"`
 }
 
+// SiteStatus represents the status of a site in xMatters.
+type SiteStatus string
+
+const (
+	// SiteStatusActive indicates the site is active.
+	SiteStatusActive SiteStatus = "ACTIVE"
+	// SiteStatusInactive indicates the site is inactive.
+	SiteStatusInactive SiteStatus = "INACTIVE"
+)
+
 // SitePagination contains a paginated list of sites.
 // It extends the Pagination struct containing links to additional pages.
 type SitePagination struct {
@@ -41,12 +49,10 @@ type SitePagination struct {
 
 // GetSitesParams contains available API query parameters for the GetSiteList method.
 type GetSitesParams struct {
-	Search   string `url:"search,omitempty"`
-	Operand  string `url:"operand,omitempty"`
-	Fields   string `url:"fields,omitempty"`
-	Country  string `url:"country,omitempty"`
-	Geocoded *bool  `url:"geocoded,omitempty"`
-	Status   string `url:"status,omitempty"`
+	SearchQuery `url:"search,omitempty"`
+	Country     string `url:"country,omitempty"`
+	Geocoded    *bool  `url:"geocoded,omitempty"`
+	Status      string `url:"status,omitempty"`
 }
 
 // GetSiteParams contains available API body parameters for the PushSite method.
@@ -57,15 +63,39 @@ type PushSiteParams struct {
 	Language string `json:"language"`
 	Timezone string `json:"timezone"`
 	// Optional Fields
-	Address1   *string  `json:"address1"`
-	Address2   *string  `json:"address2"`
-	City       *string  `json:"city"`
-	ID         string   `json:"id,omitempty"`
-	Latitude   *float64 `json:"latitude"`
-	Longitude  *float64 `json:"longitude"`
-	PostalCode *string  `json:"postalCode"`
-	State      *string  `json:"state"`
-	Status     string   `json:"status,omitempty"`
+	Address1   Nullable[string]  `json:"address1,omitempty"`
+	Address2   Nullable[string]  `json:"address2,omitempty"`
+	City       Nullable[string]  `json:"city,omitempty"`
+	ID         string            `json:"id,omitempty"`
+	Latitude   Nullable[float64] `json:"latitude,omitempty"`
+	Longitude  Nullable[float64] `json:"longitude,omitempty"`
+	PostalCode Nullable[string]  `json:"postalCode,omitempty"`
+	State      Nullable[string]  `json:"state,omitempty"`
+	Status     string            `json:"status,omitempty"`
+}
+
+// UpdateSiteParams contains the site fields to change with the UpdateSite method.
+// Only the fields that are set are sent; all other fields keep their current values in xMatters.
+// A null optional field, such as Address2, clears it.
+type UpdateSiteParams struct {
+	Name       string            `json:"name,omitempty"`
+	Country    string            `json:"country,omitempty"`
+	Language   string            `json:"language,omitempty"`
+	Timezone   string            `json:"timezone,omitempty"`
+	Address1   Nullable[string]  `json:"address1,omitempty"`
+	Address2   Nullable[string]  `json:"address2,omitempty"`
+	City       Nullable[string]  `json:"city,omitempty"`
+	Latitude   Nullable[float64] `json:"latitude,omitempty"`
+	Longitude  Nullable[float64] `json:"longitude,omitempty"`
+	PostalCode Nullable[string]  `json:"postalCode,omitempty"`
+	State      Nullable[string]  `json:"state,omitempty"`
+	Status     SiteStatus        `json:"status,omitempty"`
+}
+
+// siteUpdate is the body of a request that modifies only the fields of a site set in its UpdateSiteParams.
+type siteUpdate struct {
+	ID string `json:"id"`
+	UpdateSiteParams
 }
 
 // -------------------------------------------------------------------------------------------------
@@ -73,9 +103,15 @@ type PushSiteParams struct {
 // -------------------------------------------------------------------------------------------------
 
 // GetSite retrieves a site in xMatters.
-// It requires the siteId parameter to identify the specific site, and returns a Site object.
-func (xmatters *XMattersAPI) GetSite(siteId string) (Site, error) {
-	uri := buildURI(fmt.Sprintf("/sites/%s", siteId), nil)
+// It requires the siteId parameter, the ID of the site, and returns a Site object.
+// Sites cannot be retrieved by name; use GetSiteList with a search on the name instead.
+// Optional GetOption values, such as WithEmbed, override the embedded objects and fields of the response.
+func (xmatters *XMattersAPI) GetSite(siteId string, opts ...GetOption) (Site, error) {
+	if err := validateIdentifier("site ID", siteId); err != nil {
+		return Site{}, err
+	}
+
+	uri := buildURI(fmt.Sprintf("/sites/%s", pathSegment(siteId)), parseGetOptions("", opts))
 
 	// Perform the API request.
 	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
@@ -85,7 +121,7 @@ func (xmatters *XMattersAPI) GetSite(siteId string) (Site, error) {
 
 	// Unmarshal the response into a Site struct.
 	var result Site
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return Site{}, newUnmarshalError()
 	}
@@ -102,47 +138,18 @@ func (xmatters *XMattersAPI) GetSiteList(params GetSitesParams) ([]*Site, error)
 	// Use the GetSitePaginationSet method to get all paginated results
 	siteList, err := xmatters.GetSitePaginationSet(uri)
 	if err != nil {
-		return []*Site{}, err
+		return siteList, err
 	}
 
 	// Return the full list of Sites.
 	return siteList, nil
 }
 
-// GetSitePaginationSet is a recursive helper function that handles a paginated list of sites.
-// It takes a URI as input and retrieves the paginated set from that URI.
-// It checks for additional pages and recursively fetches them until all pages are retrieved.
+// GetSitePaginationSet retrieves a paginated list of sites.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
 func (xmatters *XMattersAPI) GetSitePaginationSet(uri string) ([]*Site, error) {
-	// Perform the API request with provided URI
-	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
-	if err != nil {
-		return []*Site{}, err
-	}
-
-	// Unmarshal the response into a SitePagination struct.
-	var sitePagination SitePagination
-	err = json.Unmarshal(resp, &sitePagination)
-	if err != nil {
-		return []*Site{}, newUnmarshalError()
-	}
-
-	// Assign first page of sites to be returned
-	siteList := sitePagination.Sites
-
-	// Check for additional paginated results
-	if sitePagination.Pagination.Links.Next != nil {
-		// Remove defaultBasePath (/api/xm/1) from the next URI
-		nextUri := strings.ReplaceAll(*sitePagination.Pagination.Links.Next, defaultBasePath, "")
-		// Use recursion to get the next set of results
-		nextSet, err := xmatters.GetSitePaginationSet(nextUri)
-		if err != nil {
-			return []*Site{}, err
-		}
-		siteList = append(siteList, nextSet...)
-	}
-
-	// Return the fully concatenated list of sites from all paginated results
-	return siteList, nil
+	return getPaginationSet[Site](xmatters, uri)
 }
 
 // PushSite either creates a new site in xMatters or modifies an existing site.
@@ -160,7 +167,7 @@ func (xmatters *XMattersAPI) PushSite(params PushSiteParams) (Site, error) {
 
 	// Unmarshal the response into a Site struct.
 	var result Site
-	err = json.Unmarshal(resp, &result)
+	err = xmatters.decode(resp, &result)
 	if err != nil {
 		return Site{}, newUnmarshalError()
 	}
@@ -169,11 +176,77 @@ func (xmatters *XMattersAPI) PushSite(params PushSiteParams) (Site, error) {
 	return result, nil
 }
 
+// UpdateSite modifies only the specified fields of an existing site in xMatters.
+// It requires the siteId parameter, the ID of the site, and the UpdateSiteParams struct containing the changes.
+// Only the changed fields are sent, so concurrent changes to the other fields are kept.
+// It returns the modified Site object.
+func (xmatters *XMattersAPI) UpdateSite(siteId string, params UpdateSiteParams) (Site, error) {
+	if err := validateIdentifier("site ID", siteId); err != nil {
+		return Site{}, err
+	}
+
+	uri := buildURI("/sites", nil) // The URI for modifying a Site in xMatters
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, siteUpdate{ID: siteId, UpdateSiteParams: params})
+	if err != nil {
+		return Site{}, err
+	}
+
+	// Unmarshal the response into a Site struct.
+	var result Site
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Site{}, newUnmarshalError()
+	}
+
+	// Return the modified Site object.
+	return result, nil
+}
+
+// ActivateSite sets the status of an existing site in xMatters to ACTIVE.
+// It requires the siteId parameter to identify the specific site, and returns the modified Site object.
+func (xmatters *XMattersAPI) ActivateSite(siteId string) (Site, error) {
+	return xmatters.SetSiteStatus(siteId, SiteStatusActive)
+}
+
+// DeactivateSite sets the status of an existing site in xMatters to INACTIVE.
+// It requires the siteId parameter to identify the specific site, and returns the modified Site object.
+func (xmatters *XMattersAPI) DeactivateSite(siteId string) (Site, error) {
+	return xmatters.SetSiteStatus(siteId, SiteStatusInactive)
+}
+
+// SetSiteStatus changes the status of an existing site in xMatters without modifying any other fields.
+// It requires the siteId parameter to identify the specific site and the desired SiteStatus.
+// It returns the modified Site object.
+func (xmatters *XMattersAPI) SetSiteStatus(siteId string, status SiteStatus) (Site, error) {
+	return xmatters.UpdateSite(siteId, UpdateSiteParams{Status: status})
+}
+
+// getSiteByName searches for a site by name and returns the site whose name matches exactly,
+// or a 404 XMattersError if there is none.
+func (xmatters *XMattersAPI) getSiteByName(name string) (Site, error) {
+	sites, err := xmatters.GetSiteList(GetSitesParams{SearchQuery: NewSearchQuery(name).InFields(SearchName)})
+	if err != nil {
+		return Site{}, err
+	}
+	for _, site := range sites {
+		if stringValue(site.Name) == name {
+			return *site, nil
+		}
+	}
+	return Site{}, XMattersError{Code: 404, Message: fmt.Sprintf("Could not find a site with name %s", name), Reason: "Not Found"}
+}
+
 // DeleteSite deletes a site in xMatters.
 // It requires the siteId parameter to identify the specific site to be deleted.
 // It returns an error if the deletion fails.
 func (xmatters *XMattersAPI) DeleteSite(siteId *string) error {
-	uri := buildURI(fmt.Sprintf("/sites/%s", *siteId), nil)
+	if err := validateIdentifier("site ID", stringValue(siteId)); err != nil {
+		return err
+	}
+
+	uri := buildURI(fmt.Sprintf("/sites/%s", pathSegment(stringValue(siteId))), nil)
 
 	// Perform the API request.
 	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
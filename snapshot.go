@@ -0,0 +1,238 @@
+package xmatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Snapshot Structs
+// -------------------------------------------------------------------------------------------------
+
+// Snapshot is a point-in-time export of the configuration of an xMatters instance.
+// Group rosters and shifts are keyed by the ID of the group they belong to.
+type Snapshot struct {
+	ExportedAt          string                    `json:"exportedAt"`
+	Sites               []*Site                   `json:"sites,omitempty"`
+	People              []*Person                 `json:"people,omitempty"`
+	Devices             []*Device                 `json:"devices,omitempty"`
+	Groups              []*Group                  `json:"groups,omitempty"`
+	Rosters             map[string][]*GroupMember `json:"rosters,omitempty"`
+	Shifts              map[string][]*Shift       `json:"shifts,omitempty"`
+	Services            []*Service                `json:"services,omitempty"`
+	ServiceDependencies []*ServiceDependency      `json:"serviceDependencies,omitempty"`
+	DynamicTeams        []*DynamicTeam            `json:"dynamicTeams,omitempty"`
+}
+
+// SnapshotResource identifies a type of resource included in a Snapshot.
+type SnapshotResource string
+
+// Resource types that can be included in a Snapshot.
+const (
+	SnapshotSites               SnapshotResource = "sites"
+	SnapshotPeople              SnapshotResource = "people"
+	SnapshotDevices             SnapshotResource = "devices"
+	SnapshotGroups              SnapshotResource = "groups"
+	SnapshotRosters             SnapshotResource = "rosters"
+	SnapshotShifts              SnapshotResource = "shifts"
+	SnapshotServices            SnapshotResource = "services"
+	SnapshotServiceDependencies SnapshotResource = "serviceDependencies"
+	SnapshotDynamicTeams        SnapshotResource = "dynamicTeams"
+)
+
+// AllSnapshotResources lists every resource type, in the order they are exported.
+var AllSnapshotResources = []SnapshotResource{
+	SnapshotSites,
+	SnapshotPeople,
+	SnapshotDevices,
+	SnapshotGroups,
+	SnapshotRosters,
+	SnapshotShifts,
+	SnapshotServices,
+	SnapshotServiceDependencies,
+	SnapshotDynamicTeams,
+}
+
+// SnapshotFormat is the encoding used when writing or reading a Snapshot.
+type SnapshotFormat string
+
+// Supported snapshot encodings.
+const (
+	SnapshotJSON SnapshotFormat = "json"
+	SnapshotYAML SnapshotFormat = "yaml"
+)
+
+// ExportProgress reports the progress of an Exporter after each resource type is exported.
+type ExportProgress struct {
+	Resource SnapshotResource
+	Count    int
+}
+
+// Exporter walks the resources of an xMatters instance and assembles them into a Snapshot.
+type Exporter struct {
+	// Resources limits the export to the listed resource types. All resource types are exported when empty.
+	// Rosters and shifts are exported per group, so they require groups to be exported as well;
+	// Export returns a validation error otherwise.
+	Resources []SnapshotResource
+	// Progress, if set, is called after each resource type has been exported.
+	Progress func(ExportProgress)
+
+	xmatters *XMattersAPI
+}
+
+// -------------------------------------------------------------------------------------------------
+// Snapshot Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewExporter creates an Exporter that reads from the provided client.
+func NewExporter(xmatters *XMattersAPI) *Exporter {
+	return &Exporter{xmatters: xmatters}
+}
+
+// Export retrieves the selected resources from xMatters and returns them as a Snapshot.
+func (e *Exporter) Export() (*Snapshot, error) {
+	snapshot := &Snapshot{ExportedAt: time.Now().UTC().Format(time.RFC3339)}
+	wanted := e.wanted()
+	if (wanted[SnapshotRosters] || wanted[SnapshotShifts]) && !wanted[SnapshotGroups] {
+		return nil, newValidationError("exporting rosters or shifts requires groups to be exported as well")
+	}
+	progress := e.xmatters.startProgress("Export", len(wanted))
+	var err error
+
+	if wanted[SnapshotSites] {
+		if snapshot.Sites, err = e.xmatters.GetSiteList(GetSitesParams{}); err != nil {
+			return nil, err
+		}
+		e.report(progress, SnapshotSites, len(snapshot.Sites))
+	}
+	if wanted[SnapshotPeople] {
+		if snapshot.People, err = e.xmatters.GetPersonList(GetPeopleParams{Embed: "roles,supervisors"}); err != nil {
+			return nil, err
+		}
+		e.report(progress, SnapshotPeople, len(snapshot.People))
+	}
+	if wanted[SnapshotDevices] {
+		if snapshot.Devices, err = e.xmatters.GetDeviceList(GetDevicesParams{Embed: "timeframes"}); err != nil {
+			return nil, err
+		}
+		e.report(progress, SnapshotDevices, len(snapshot.Devices))
+	}
+	if wanted[SnapshotGroups] {
+		if snapshot.Groups, err = e.xmatters.GetGroupList(GetGroupsParams{Embed: "supervisors,observers"}); err != nil {
+			return nil, err
+		}
+		e.report(progress, SnapshotGroups, len(snapshot.Groups))
+	}
+	if wanted[SnapshotRosters] {
+		snapshot.Rosters = make(map[string][]*GroupMember, len(snapshot.Groups))
+		count := 0
+		for _, group := range snapshot.Groups {
+			roster, err := e.xmatters.GetGroupRoster(stringValue(group.ID))
+			if err != nil {
+				return nil, err
+			}
+			snapshot.Rosters[stringValue(group.ID)] = roster.Members
+			count += len(roster.Members)
+		}
+		e.report(progress, SnapshotRosters, count)
+	}
+	if wanted[SnapshotShifts] {
+		snapshot.Shifts = make(map[string][]*Shift, len(snapshot.Groups))
+		count := 0
+		for _, group := range snapshot.Groups {
+			shifts, err := e.xmatters.GetGroupShifts(stringValue(group.ID))
+			if err != nil {
+				return nil, err
+			}
+			snapshot.Shifts[stringValue(group.ID)] = shifts
+			count += len(shifts)
+		}
+		e.report(progress, SnapshotShifts, count)
+	}
+	if wanted[SnapshotServices] {
+		if snapshot.Services, err = e.xmatters.GetServiceList(GetServicesParams{Embed: "serviceLinks"}); err != nil {
+			return nil, err
+		}
+		e.report(progress, SnapshotServices, len(snapshot.Services))
+	}
+	if wanted[SnapshotServiceDependencies] {
+		if snapshot.ServiceDependencies, err = e.xmatters.GetServiceDependencyList(GetServiceDependenciesParams{}); err != nil {
+			return nil, err
+		}
+		e.report(progress, SnapshotServiceDependencies, len(snapshot.ServiceDependencies))
+	}
+	if wanted[SnapshotDynamicTeams] {
+		if snapshot.DynamicTeams, err = e.xmatters.GetDynamicTeamList(); err != nil {
+			return nil, err
+		}
+		e.report(progress, SnapshotDynamicTeams, len(snapshot.DynamicTeams))
+	}
+
+	return snapshot, nil
+}
+
+// ExportTo exports a Snapshot and writes it to w in the requested format.
+func (e *Exporter) ExportTo(w io.Writer, format SnapshotFormat) (*Snapshot, error) {
+	snapshot, err := e.Export()
+	if err != nil {
+		return nil, err
+	}
+	if err := snapshot.Write(w, format); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Write encodes the Snapshot to w in the requested format.
+// YAML output uses the same field names as the JSON output.
+func (s *Snapshot) Write(w io.Writer, format SnapshotFormat) error {
+	switch format {
+	case SnapshotJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(s)
+	case SnapshotYAML:
+		// Round-trip through JSON so the YAML keys match the JSON field names
+		jsonBytes, err := json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		var generic interface{}
+		if err := json.Unmarshal(jsonBytes, &generic); err != nil {
+			return err
+		}
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(generic); err != nil {
+			return err
+		}
+		return encoder.Close()
+	default:
+		return fmt.Errorf("unsupported snapshot format %q", format)
+	}
+}
+
+// wanted returns the set of resource types to export.
+func (e *Exporter) wanted() map[SnapshotResource]bool {
+	resources := e.Resources
+	if len(resources) == 0 {
+		resources = AllSnapshotResources
+	}
+	wanted := make(map[SnapshotResource]bool, len(resources))
+	for _, resource := range resources {
+		wanted[resource] = true
+	}
+	return wanted
+}
+
+// report calls the Progress callback, if set, and advances the progress of the export by one resource type.
+func (e *Exporter) report(progress *progressTracker, resource SnapshotResource, count int) {
+	progress.advance(1)
+	if e.Progress != nil {
+		e.Progress(ExportProgress{Resource: resource, Count: count})
+	}
+}
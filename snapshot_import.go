@@ -0,0 +1,920 @@
+package xmatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Snapshot Import Structs
+// -------------------------------------------------------------------------------------------------
+
+// ImportAction describes the change an Importer made, or would make in a dry run, to a single object.
+type ImportAction string
+
+// Changes reported by an Importer.
+const (
+	ImportCreate ImportAction = "CREATE"
+	ImportUpdate ImportAction = "UPDATE"
+)
+
+// ImportChange describes a single object created or updated by an Importer.
+// Key is the human-readable identifier used to match the object between instances, such as its target name.
+type ImportChange struct {
+	Resource SnapshotResource `json:"resource"`
+	Key      string           `json:"key"`
+	Action   ImportAction     `json:"action"`
+}
+
+// ImportError describes a single object an Importer failed to import.
+type ImportError struct {
+	Resource SnapshotResource `json:"resource"`
+	Key      string           `json:"key"`
+	Err      error            `json:"-"`
+}
+
+// Error implements the error interface.
+func (e *ImportError) Error() string {
+	return fmt.Sprintf("%s %q: %v", e.Resource, e.Key, e.Err)
+}
+
+// ImportReport contains the changes and per-object errors of an import.
+// Skipped lists the snapshot resource types that cannot be imported, such as dynamic teams.
+type ImportReport struct {
+	Changes []*ImportChange    `json:"changes"`
+	Errors  []*ImportError     `json:"errors"`
+	Skipped []SnapshotResource `json:"skipped,omitempty"`
+}
+
+// Importer replays a Snapshot into an xMatters instance.
+// Objects are matched between instances by their human-readable identifiers rather than their IDs:
+// sites by name, people, groups, and services by target name, devices by owner and device name, and shifts
+// by group and shift name.
+// References between objects are remapped to the IDs of the matching objects in the target instance.
+type Importer struct {
+	// DryRun computes the changes without making them.
+	DryRun bool
+	// Resources limits the import to the listed resource types. All importable resource types are imported when empty.
+	Resources []SnapshotResource
+	// ResolveNames lets references in the snapshot name objects that exist in the target instance but not in
+	// the snapshot, such as a site referenced by a person. The reference holds the human-readable identifier of
+	// the object, such as the name of the site, in place of its ID.
+	ResolveNames bool
+
+	xmatters *XMattersAPI
+}
+
+// importableResources lists the resource types an Importer can replay, in the order they must be applied.
+var importableResources = []SnapshotResource{
+	SnapshotSites,
+	SnapshotPeople,
+	SnapshotDevices,
+	SnapshotGroups,
+	SnapshotRosters,
+	SnapshotShifts,
+	SnapshotServices,
+	SnapshotServiceDependencies,
+}
+
+// importState holds the target instance and the source to target ID mappings while an import runs.
+type importState struct {
+	report   *ImportReport
+	target   *Snapshot
+	sites    map[string]string
+	people   map[string]string
+	devices  map[string]string
+	groups   map[string]string
+	services map[string]string
+}
+
+// -------------------------------------------------------------------------------------------------
+// Snapshot Import Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewImporter creates an Importer that writes to the provided client.
+func NewImporter(xmatters *XMattersAPI) *Importer {
+	return &Importer{xmatters: xmatters}
+}
+
+// ReadSnapshot decodes a Snapshot written by Snapshot.Write.
+func ReadSnapshot(r io.Reader, format SnapshotFormat) (*Snapshot, error) {
+	var snapshot Snapshot
+	switch format {
+	case SnapshotJSON:
+		if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+			return nil, err
+		}
+	case SnapshotYAML:
+		// Round-trip through JSON so the YAML keys are read using the JSON field names
+		var generic interface{}
+		if err := yaml.NewDecoder(r).Decode(&generic); err != nil {
+			return nil, err
+		}
+		jsonBytes, err := json.Marshal(generic)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(jsonBytes, &snapshot); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported snapshot format %q", format)
+	}
+	return &snapshot, nil
+}
+
+// Import replays the snapshot into the target instance, creating missing objects and updating drifted ones.
+// Resource types are applied in dependency order: sites, people and then their supervisors, devices, groups,
+// rosters, shifts, services, and service dependencies. Failures for individual objects are collected in the report and the import
+// continues; an error is only returned if the current state of the target instance cannot be read.
+func (i *Importer) Import(snapshot *Snapshot) (*ImportReport, error) {
+	report := &ImportReport{Changes: []*ImportChange{}, Errors: []*ImportError{}}
+	wanted := i.wanted()
+
+	// Note the snapshot resources that cannot be imported
+	if len(snapshot.DynamicTeams) > 0 {
+		report.Skipped = append(report.Skipped, SnapshotDynamicTeams)
+	}
+
+	// Read the current state of the target instance
+	targetResources := []SnapshotResource{SnapshotSites, SnapshotPeople, SnapshotDevices, SnapshotGroups, SnapshotServices, SnapshotServiceDependencies}
+	if wanted[SnapshotRosters] {
+		targetResources = append(targetResources, SnapshotRosters)
+	}
+	if wanted[SnapshotShifts] {
+		targetResources = append(targetResources, SnapshotShifts)
+	}
+	target, err := (&Exporter{Resources: targetResources, xmatters: i.xmatters}).Export()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &importState{
+		report:   report,
+		target:   target,
+		sites:    make(map[string]string),
+		people:   make(map[string]string),
+		devices:  make(map[string]string),
+		groups:   make(map[string]string),
+		services: make(map[string]string),
+	}
+	state.matchExisting(snapshot)
+	if i.ResolveNames {
+		state.matchNames()
+	}
+
+	// Apply each resource type in dependency order, reporting progress after each type
+	steps := []struct {
+		resource SnapshotResource
+		apply    func(*importState, *Snapshot)
+	}{
+		{SnapshotSites, i.importSites},
+		{SnapshotPeople, i.importPeople},
+		{SnapshotDevices, i.importDevices},
+		{SnapshotGroups, i.importGroups},
+		{SnapshotRosters, i.importRosters},
+		{SnapshotShifts, i.importShifts},
+		{SnapshotServices, i.importServices},
+		{SnapshotServiceDependencies, i.importServiceDependencies},
+	}
+	var total int
+	for _, step := range steps {
+		if wanted[step.resource] {
+			total++
+		}
+	}
+	progress := i.xmatters.startProgress("Import", total)
+	for _, step := range steps {
+		if wanted[step.resource] {
+			step.apply(state, snapshot)
+			progress.advance(1)
+		}
+	}
+
+	return report, nil
+}
+
+// importSites creates or updates each site in the snapshot.
+func (i *Importer) importSites(state *importState, snapshot *Snapshot) {
+	existing := make(map[string]*Site, len(state.target.Sites))
+	for _, site := range state.target.Sites {
+		existing[stringValue(site.Name)] = site
+	}
+	for _, site := range snapshot.Sites {
+		key := stringValue(site.Name)
+		desired := sitePushParams(site)
+		// IDs of the source instance are replaced by the IDs of matching objects, or left empty to create them
+		desired.ID = ""
+		current, ok := existing[key]
+		var currentParams *PushSiteParams
+		if ok {
+			params := sitePushParams(current)
+			currentParams = &params
+			desired.ID = params.ID
+		}
+		state.sites[stringValue(site.ID)] = i.apply(state, SnapshotSites, key, desired, currentParams, func() (*string, error) {
+			result, err := i.xmatters.PushSite(desired)
+			return result.ID, err
+		}, state.sites[stringValue(site.ID)])
+	}
+}
+
+// importPeople creates or updates each person in the snapshot.
+func (i *Importer) importPeople(state *importState, snapshot *Snapshot) {
+	existing := make(map[string]*Person, len(state.target.People))
+	for _, person := range state.target.People {
+		existing[stringValue(person.TargetName)] = person
+	}
+	for _, person := range snapshot.People {
+		key := stringValue(person.TargetName)
+		desired, err := personPushParams(person, state.remap(state.sites), state.remap(state.people))
+		if err != nil {
+			state.fail(SnapshotPeople, key, err)
+			continue
+		}
+		// Supervisors may be people that are imported later, so they are set by importSupervisors
+		desired.Supervisors = nil
+		desired.ID = ""
+		var currentParams *PushPersonParams
+		if current, ok := existing[key]; ok {
+			params, err := personPushParams(current, identity, identity)
+			if err != nil {
+				state.fail(SnapshotPeople, key, err)
+				continue
+			}
+			params.Supervisors = nil
+			currentParams = &params
+			desired.ID = params.ID
+		}
+		state.people[stringValue(person.ID)] = i.apply(state, SnapshotPeople, key, desired, currentParams, func() (*string, error) {
+			result, err := i.xmatters.PushPerson(desired)
+			return result.ID, err
+		}, state.people[stringValue(person.ID)])
+	}
+	i.importSupervisors(state, snapshot, existing)
+}
+
+// importSupervisors replaces the supervisors of each person in the snapshot once every person has been imported.
+// People exported without their supervisors keep the supervisors they have in the target instance.
+func (i *Importer) importSupervisors(state *importState, snapshot *Snapshot, existing map[string]*Person) {
+	for _, person := range snapshot.People {
+		if person.Supervisors == nil {
+			continue
+		}
+		key := stringValue(person.TargetName)
+		personId, ok := state.people[stringValue(person.ID)]
+		if !ok {
+			// The person failed to import
+			continue
+		}
+
+		// Remap each supervisor to the target instance
+		desired := []string{}
+		var err error
+		for _, supervisor := range person.Supervisors {
+			supervisorId, mapped := state.people[stringValue(supervisor.ID)]
+			if !mapped {
+				err = fmt.Errorf("supervisor %s does not exist in the target instance", stringValue(supervisor.TargetName))
+				break
+			}
+			desired = append(desired, supervisorId)
+		}
+		if err != nil {
+			state.fail(SnapshotPeople, key, err)
+			continue
+		}
+
+		// Compare the supervisors as sets
+		current := []string{}
+		target, exists := existing[key]
+		if exists {
+			for _, supervisor := range target.Supervisors {
+				current = append(current, stringValue(supervisor.ID))
+			}
+		}
+		sort.Strings(desired)
+		sort.Strings(current)
+		if reflect.DeepEqual(desired, current) {
+			continue
+		}
+		if exists && !state.changed(SnapshotPeople, key) {
+			state.report.Changes = append(state.report.Changes, &ImportChange{Resource: SnapshotPeople, Key: key, Action: ImportUpdate})
+		}
+		if i.DryRun || isDryRunId(personId) {
+			continue
+		}
+		_, err = i.xmatters.Request(http.MethodPost, buildURI("/people", nil), ContentJSON, personSupervisorsParams{ID: personId, Supervisors: desired})
+		if err != nil {
+			state.fail(SnapshotPeople, key, err)
+		}
+	}
+}
+
+// importDevices creates or updates each device in the snapshot.
+func (i *Importer) importDevices(state *importState, snapshot *Snapshot) {
+	existing := make(map[string]*Device, len(state.target.Devices))
+	for _, device := range state.target.Devices {
+		existing[deviceKey(device)] = device
+	}
+	for _, device := range snapshot.Devices {
+		key := deviceKey(device)
+		desired, err := devicePushParams(device, state.remap(state.people))
+		if err != nil {
+			state.fail(SnapshotDevices, key, err)
+			continue
+		}
+		desired.ID = ""
+		var currentParams *PushDeviceParams
+		if current, ok := existing[key]; ok {
+			params, err := devicePushParams(current, identity)
+			if err != nil {
+				state.fail(SnapshotDevices, key, err)
+				continue
+			}
+			currentParams = &params
+			desired.ID = params.ID
+		}
+		state.devices[stringValue(device.ID)] = i.apply(state, SnapshotDevices, key, desired, currentParams, func() (*string, error) {
+			result, err := i.xmatters.PushDevice(desired)
+			return result.ID, err
+		}, state.devices[stringValue(device.ID)])
+	}
+}
+
+// importGroups creates or updates each group in the snapshot.
+func (i *Importer) importGroups(state *importState, snapshot *Snapshot) {
+	existing := make(map[string]*Group, len(state.target.Groups))
+	for _, group := range state.target.Groups {
+		existing[stringValue(group.TargetName)] = group
+	}
+	for _, group := range snapshot.Groups {
+		key := stringValue(group.TargetName)
+		desired, err := groupPushParams(group, state.remap(state.sites), state.remap(state.people))
+		if err != nil {
+			state.fail(SnapshotGroups, key, err)
+			continue
+		}
+		desired.ID = ""
+		var currentParams *PushGroupParams
+		if current, ok := existing[key]; ok {
+			params, err := groupPushParams(current, identity, identity)
+			if err != nil {
+				state.fail(SnapshotGroups, key, err)
+				continue
+			}
+			currentParams = &params
+			desired.ID = params.ID
+		}
+		state.groups[stringValue(group.ID)] = i.apply(state, SnapshotGroups, key, desired, currentParams, func() (*string, error) {
+			result, err := i.xmatters.PushGroup(desired)
+			return result.ID, err
+		}, state.groups[stringValue(group.ID)])
+	}
+}
+
+// importRosters replaces the roster of each group in the snapshot.
+func (i *Importer) importRosters(state *importState, snapshot *Snapshot) {
+	for _, group := range snapshot.Groups {
+		key := stringValue(group.TargetName)
+		members, ok := snapshot.Rosters[stringValue(group.ID)]
+		if !ok {
+			continue
+		}
+		groupId, ok := state.groups[stringValue(group.ID)]
+		if !ok {
+			state.fail(SnapshotRosters, key, fmt.Errorf("group %s does not exist in the target instance", key))
+			continue
+		}
+
+		// Remap each member to the target instance
+		desired := []*GroupMember{}
+		var err error
+		for _, member := range members {
+			var ids map[string]string
+			switch stringValue(member.MemberType) {
+			case "PERSON":
+				ids = state.people
+			case "GROUP":
+				ids = state.groups
+			case "DEVICE":
+				ids = state.devices
+			}
+			id, mapped := ids[stringValue(member.ID)]
+			if !mapped {
+				err = fmt.Errorf("%s member %s does not exist in the target instance", stringValue(member.MemberType), stringValue(member.ID))
+				break
+			}
+			desired = append(desired, &GroupMember{ID: StringPtr(id), MemberType: member.MemberType})
+		}
+		if err != nil {
+			state.fail(SnapshotRosters, key, err)
+			continue
+		}
+
+		// Compare the rosters as sets of members
+		var current []*GroupMember
+		existing, exists := state.target.Rosters[groupId]
+		if exists {
+			current = existing
+		}
+		if exists && len(current) == len(desired) && rosterContainsAll(current, desired) {
+			continue
+		}
+		action := ImportCreate
+		if exists {
+			action = ImportUpdate
+		}
+		state.report.Changes = append(state.report.Changes, &ImportChange{Resource: SnapshotRosters, Key: key, Action: action})
+		if i.DryRun || isDryRunId(groupId) {
+			continue
+		}
+		if _, err := i.xmatters.PushGroupRoster(groupId, desired); err != nil {
+			state.fail(SnapshotRosters, key, err)
+		}
+	}
+}
+
+// importShifts creates or updates each shift in the snapshot, matched by name within its group.
+// Shifts of the target instance that are not in the snapshot are left unchanged.
+func (i *Importer) importShifts(state *importState, snapshot *Snapshot) {
+	for _, group := range snapshot.Groups {
+		shifts, ok := snapshot.Shifts[stringValue(group.ID)]
+		if !ok {
+			continue
+		}
+		groupName := stringValue(group.TargetName)
+		groupId, ok := state.groups[stringValue(group.ID)]
+		if !ok {
+			state.fail(SnapshotShifts, groupName, fmt.Errorf("group %s does not exist in the target instance", groupName))
+			continue
+		}
+		existing := make(map[string]*Shift)
+		for _, shift := range state.target.Shifts[groupId] {
+			existing[stringValue(shift.Name)] = shift
+		}
+
+		for _, shift := range shifts {
+			key := groupName + "|" + stringValue(shift.Name)
+			desired, err := shiftPushParams(shift, state.remap(state.people), state.remap(state.groups), state.remap(state.devices))
+			if err != nil {
+				state.fail(SnapshotShifts, key, err)
+				continue
+			}
+			desired.ID = ""
+			var currentParams *PushShiftParams
+			if current, ok := existing[stringValue(shift.Name)]; ok {
+				params, err := shiftPushParams(current, identity, identity, identity)
+				if err != nil {
+					state.fail(SnapshotShifts, key, err)
+					continue
+				}
+				currentParams = &params
+				desired.ID = params.ID
+			}
+			i.apply(state, SnapshotShifts, key, desired, currentParams, func() (*string, error) {
+				result, err := i.xmatters.PushShift(groupId, desired)
+				return result.ID, err
+			}, desired.ID)
+		}
+	}
+}
+
+// importServices creates or updates each service in the snapshot.
+func (i *Importer) importServices(state *importState, snapshot *Snapshot) {
+	existing := make(map[string]*Service, len(state.target.Services))
+	for _, service := range state.target.Services {
+		existing[stringValue(service.TargetName)] = service
+	}
+	for _, service := range snapshot.Services {
+		key := stringValue(service.TargetName)
+		desired, err := servicePushParams(service, state.remap(state.groups))
+		if err != nil {
+			state.fail(SnapshotServices, key, err)
+			continue
+		}
+		desired.ID = ""
+		var currentParams *PushServiceParams
+		if current, ok := existing[key]; ok {
+			params, err := servicePushParams(current, identity)
+			if err != nil {
+				state.fail(SnapshotServices, key, err)
+				continue
+			}
+			currentParams = &params
+			desired.ID = params.ID
+		}
+		state.services[stringValue(service.ID)] = i.apply(state, SnapshotServices, key, desired, currentParams, func() (*string, error) {
+			result, err := i.xmatters.PushService(desired)
+			return result.ID, err
+		}, state.services[stringValue(service.ID)])
+	}
+}
+
+// importServiceDependencies creates each service dependency in the snapshot that is missing from the target.
+func (i *Importer) importServiceDependencies(state *importState, snapshot *Snapshot) {
+	existing := make(map[[2]string]bool, len(state.target.ServiceDependencies))
+	for _, dependency := range state.target.ServiceDependencies {
+		if dependency.Service != nil && dependency.DependentService != nil {
+			existing[[2]string{stringValue(dependency.Service.ID), stringValue(dependency.DependentService.ID)}] = true
+		}
+	}
+	for _, dependency := range snapshot.ServiceDependencies {
+		if dependency.Service == nil || dependency.DependentService == nil {
+			continue
+		}
+		key := fmt.Sprintf("%s -> %s", stringValue(dependency.DependentService.TargetName), stringValue(dependency.Service.TargetName))
+		serviceId, serviceOk := state.services[stringValue(dependency.Service.ID)]
+		dependentId, dependentOk := state.services[stringValue(dependency.DependentService.ID)]
+		if !serviceOk || !dependentOk {
+			state.fail(SnapshotServiceDependencies, key, fmt.Errorf("dependency references a service that does not exist in the target instance"))
+			continue
+		}
+		if existing[[2]string{serviceId, dependentId}] {
+			continue
+		}
+		state.report.Changes = append(state.report.Changes, &ImportChange{Resource: SnapshotServiceDependencies, Key: key, Action: ImportCreate})
+		if i.DryRun || isDryRunId(serviceId) || isDryRunId(dependentId) {
+			continue
+		}
+		_, err := i.xmatters.PushServiceDependency(PushServiceDependencyParams{ServiceID: serviceId, DependentServiceID: dependentId})
+		if err != nil {
+			state.fail(SnapshotServiceDependencies, key, err)
+		}
+	}
+}
+
+// apply records the change needed to make the target object match the desired parameters and, unless
+// running dry, pushes it. It returns the target ID of the object, or a placeholder ID for objects that
+// would be created in a dry run so later references to them can still be resolved.
+func (i *Importer) apply(state *importState, resource SnapshotResource, key string, desired, current interface{}, push func() (*string, error), matchedId string) string {
+	action := ImportCreate
+	if !reflect.ValueOf(current).IsNil() {
+		if jsonEqual(desired, current) {
+			return matchedId
+		}
+		action = ImportUpdate
+	}
+	state.report.Changes = append(state.report.Changes, &ImportChange{Resource: resource, Key: key, Action: action})
+
+	if i.DryRun {
+		if matchedId != "" {
+			return matchedId
+		}
+		return dryRunIdPrefix + key
+	}
+	id, err := push()
+	if err != nil {
+		state.fail(resource, key, err)
+		return matchedId
+	}
+	return stringValue(id)
+}
+
+// wanted returns the set of resource types to import.
+func (i *Importer) wanted() map[SnapshotResource]bool {
+	resources := i.Resources
+	if len(resources) == 0 {
+		resources = importableResources
+	}
+	wanted := make(map[SnapshotResource]bool, len(resources))
+	for _, resource := range resources {
+		wanted[resource] = true
+	}
+	return wanted
+}
+
+// matchExisting maps the IDs of snapshot objects to the IDs of the matching objects in the target instance.
+func (state *importState) matchExisting(snapshot *Snapshot) {
+	sites := make(map[string]string)
+	for _, site := range state.target.Sites {
+		sites[stringValue(site.Name)] = stringValue(site.ID)
+	}
+	for _, site := range snapshot.Sites {
+		if id, ok := sites[stringValue(site.Name)]; ok {
+			state.sites[stringValue(site.ID)] = id
+		}
+	}
+
+	people := make(map[string]string)
+	for _, person := range state.target.People {
+		people[stringValue(person.TargetName)] = stringValue(person.ID)
+	}
+	for _, person := range snapshot.People {
+		if id, ok := people[stringValue(person.TargetName)]; ok {
+			state.people[stringValue(person.ID)] = id
+		}
+	}
+
+	devices := make(map[string]string)
+	for _, device := range state.target.Devices {
+		devices[deviceKey(device)] = stringValue(device.ID)
+	}
+	for _, device := range snapshot.Devices {
+		if id, ok := devices[deviceKey(device)]; ok {
+			state.devices[stringValue(device.ID)] = id
+		}
+	}
+
+	groups := make(map[string]string)
+	for _, group := range state.target.Groups {
+		groups[stringValue(group.TargetName)] = stringValue(group.ID)
+	}
+	for _, group := range snapshot.Groups {
+		if id, ok := groups[stringValue(group.TargetName)]; ok {
+			state.groups[stringValue(group.ID)] = id
+		}
+	}
+
+	services := make(map[string]string)
+	for _, service := range state.target.Services {
+		services[stringValue(service.TargetName)] = stringValue(service.ID)
+	}
+	for _, service := range snapshot.Services {
+		if id, ok := services[stringValue(service.TargetName)]; ok {
+			state.services[stringValue(service.ID)] = id
+		}
+	}
+}
+
+// matchNames maps the human-readable identifier of every object in the target instance to its ID,
+// unless a snapshot object already uses that identifier as its ID.
+func (state *importState) matchNames() {
+	addName := func(ids map[string]string, key, id string) {
+		if _, ok := ids[key]; !ok && key != "" {
+			ids[key] = id
+		}
+	}
+	for _, site := range state.target.Sites {
+		addName(state.sites, stringValue(site.Name), stringValue(site.ID))
+	}
+	for _, person := range state.target.People {
+		addName(state.people, stringValue(person.TargetName), stringValue(person.ID))
+	}
+	for _, device := range state.target.Devices {
+		addName(state.devices, deviceKey(device), stringValue(device.ID))
+	}
+	for _, group := range state.target.Groups {
+		addName(state.groups, stringValue(group.TargetName), stringValue(group.ID))
+	}
+	for _, service := range state.target.Services {
+		addName(state.services, stringValue(service.TargetName), stringValue(service.ID))
+	}
+}
+
+// remap returns a function that maps a source ID to its target ID using the provided mapping.
+func (state *importState) remap(ids map[string]string) func(string) (string, bool) {
+	return func(id string) (string, bool) {
+		target, ok := ids[id]
+		return target, ok
+	}
+}
+
+// changed reports whether a change to the object was already recorded.
+func (state *importState) changed(resource SnapshotResource, key string) bool {
+	for _, change := range state.report.Changes {
+		if change.Resource == resource && change.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// fail records a per-object import error.
+func (state *importState) fail(resource SnapshotResource, key string, err error) {
+	state.report.Errors = append(state.report.Errors, &ImportError{Resource: resource, Key: key, Err: err})
+}
+
+// dryRunIdPrefix marks placeholder IDs assigned to objects that would be created in a dry run.
+const dryRunIdPrefix = "dry-run:"
+
+// isDryRunId reports whether the ID is a dry run placeholder.
+func isDryRunId(id string) bool {
+	return len(id) >= len(dryRunIdPrefix) && id[:len(dryRunIdPrefix)] == dryRunIdPrefix
+}
+
+// identity maps an ID to itself; it is used when building parameters from objects already in the target instance.
+func identity(id string) (string, bool) {
+	return id, true
+}
+
+// sitePushParams builds the parameters that recreate a site.
+func sitePushParams(site *Site) PushSiteParams {
+	return PushSiteParams{
+		ID:         stringValue(site.ID),
+		Name:       stringValue(site.Name),
+		Country:    stringValue(site.Country),
+		Language:   stringValue(site.Language),
+		Timezone:   stringValue(site.Timezone),
+		Address1:   nullableOrNull(site.Address1),
+		Address2:   nullableOrNull(site.Address2),
+		City:       nullableOrNull(site.City),
+		Latitude:   nullableOrNull(site.Latitude),
+		Longitude:  nullableOrNull(site.Longitude),
+		PostalCode: nullableOrNull(site.PostalCode),
+		State:      nullableOrNull(site.State),
+		Status:     stringValue(site.Status),
+	}
+}
+
+// personPushParams builds the parameters that recreate a person, remapping their site and supervisors.
+func personPushParams(person *Person, sites, people func(string) (string, bool)) (PushPersonParams, error) {
+	params := PushPersonParams{
+		ID:              stringValue(person.ID),
+		TargetName:      stringValue(person.TargetName),
+		FirstName:       stringValue(person.FirstName),
+		LastName:        stringValue(person.LastName),
+		LicenseType:     LicenseType(stringValue(person.LicenseType)),
+		Language:        stringValue(person.Language),
+		Timezone:        stringValue(person.Timezone),
+		WebLogin:        stringValue(person.WebLogin),
+		Status:          Status(stringValue(person.Status)),
+		PhoneLogin:      nullableOrNull(person.PhoneLogin),
+		ExternalKey:     nullableOrNull(person.ExternalKey),
+		ExternallyOwned: nullableOrNull(person.ExternallyOwned),
+		Roles:           []*string{},
+	}
+	if person.Site != nil && person.Site.ID != nil {
+		siteId, ok := sites(*person.Site.ID)
+		if !ok {
+			return params, fmt.Errorf("site %s does not exist in the target instance", *person.Site.ID)
+		}
+		params.Site = siteId
+	}
+	for _, role := range person.Roles {
+		params.Roles = append(params.Roles, role.Name)
+	}
+	sort.Slice(params.Roles, func(a, b int) bool { return stringValue(params.Roles[a]) < stringValue(params.Roles[b]) })
+	if person.Supervisors != nil {
+		// Supervisors are only set when they were embedded, so an update does not remove them
+		params.Supervisors = []*string{}
+	}
+	for _, supervisor := range person.Supervisors {
+		// Supervisors that have not been imported yet are left out rather than failing the person
+		if supervisorId, ok := people(stringValue(supervisor.ID)); ok {
+			params.Supervisors = append(params.Supervisors, StringPtr(supervisorId))
+		}
+	}
+	return params, nil
+}
+
+// devicePushParams builds the parameters that recreate a device, remapping its owner.
+func devicePushParams(device *Device, people func(string) (string, bool)) (PushDeviceParams, error) {
+	params := PushDeviceParams{
+		ID:                stringValue(device.ID),
+		DeviceType:        stringValue(device.DeviceType),
+		Name:              stringValue(device.Name),
+		Sequence:          device.Sequence,
+		PriorityThreshold: stringValue(device.PriorityThreshold),
+		TestStatus:        stringValue(device.TestStatus),
+		Timeframes:        device.Timeframes,
+		Country:           stringValue(device.Country),
+		DefaultDevice:     device.DefaultDevice,
+		Delay:             nullableOrNull(device.Delay),
+		EmailAddress:      stringValue(device.EmailAddress),
+		ExternalKey:       nullableOrNull(device.ExternalKey),
+		ExternallyOwned:   nullableOrNull(device.ExternallyOwned),
+		PhoneNumber:       stringValue(device.PhoneNumber),
+		Status:            stringValue(device.Status),
+		TwoWayDevice:      nullableOrNull(device.TwoWayDevice),
+	}
+	if device.Owner == nil || device.Owner.ID == nil {
+		return params, fmt.Errorf("device has no owner")
+	}
+	ownerId, ok := people(*device.Owner.ID)
+	if !ok {
+		return params, fmt.Errorf("owner %s does not exist in the target instance", stringValue(device.Owner.TargetName))
+	}
+	params.Owner = ownerId
+	return params, nil
+}
+
+// groupPushParams builds the parameters that recreate a group, remapping its site and supervisors.
+func groupPushParams(group *Group, sites, people func(string) (string, bool)) (PushGroupParams, error) {
+	params := PushGroupParams{
+		ID:                stringValue(group.ID),
+		TargetName:        stringValue(group.TargetName),
+		AllowDuplicates:   group.AllowDuplicates,
+		Description:       stringValue(group.Description),
+		ExternalKey:       nullableOrNull(group.ExternalKey),
+		ExternallyOwned:   nullableOrNull(group.ExternallyOwned),
+		GroupType:         GroupType(stringValue(group.GroupType)),
+		ObservedByAll:     group.ObservedByAll,
+		Observers:         group.Observers,
+		Status:            Status(stringValue(group.Status)),
+		UseDefaultDevices: group.UseDefaultDevices,
+	}
+	if group.Site != nil && group.Site.ID != nil {
+		siteId, ok := sites(*group.Site.ID)
+		if !ok {
+			return params, fmt.Errorf("site %s does not exist in the target instance", *group.Site.ID)
+		}
+		params.Site = siteId
+	}
+	for _, supervisor := range group.Supervisors {
+		supervisorId, ok := people(stringValue(supervisor.ID))
+		if !ok {
+			return params, fmt.Errorf("supervisor %s does not exist in the target instance", stringValue(supervisor.ID))
+		}
+		params.Supervisors = append(params.Supervisors, NewReferenceById(supervisorId))
+	}
+	// Supervisors are compared as a set
+	sort.Slice(params.Supervisors, func(a, b int) bool {
+		return stringValue(params.Supervisors[a].ID) < stringValue(params.Supervisors[b].ID)
+	})
+	return params, nil
+}
+
+// shiftPushParams builds the parameters that recreate a shift, remapping its members by their recipient type.
+func shiftPushParams(shift *Shift, people, groups, devices func(string) (string, bool)) (PushShiftParams, error) {
+	params := PushShiftParams{
+		ID:         stringValue(shift.ID),
+		Name:       stringValue(shift.Name),
+		Start:      shift.Start,
+		End:        shift.End,
+		Timezone:   stringValue(shift.Timezone),
+		Recurrence: shift.Recurrence,
+		Members:    []*PushShiftMember{},
+	}
+	for _, member := range shift.Members {
+		if member.Recipient == nil {
+			continue
+		}
+		var ids func(string) (string, bool)
+		switch stringValue(member.Recipient.Type) {
+		case "PERSON":
+			ids = people
+		case "GROUP":
+			ids = groups
+		case "DEVICE":
+			ids = devices
+		default:
+			return params, fmt.Errorf("shift member %s has unknown recipient type %q", stringValue(member.Recipient.ID), stringValue(member.Recipient.Type))
+		}
+		id, ok := ids(stringValue(member.Recipient.ID))
+		if !ok {
+			return params, fmt.Errorf("%s member %s does not exist in the target instance", stringValue(member.Recipient.Type), stringValue(member.Recipient.ID))
+		}
+		params.Members = append(params.Members, &PushShiftMember{
+			Recipient:      &RecipientPointer{ID: StringPtr(id), Type: member.Recipient.Type},
+			Position:       member.Position,
+			Delay:          member.Delay,
+			EscalationType: member.EscalationType,
+			InRotation:     member.InRotation,
+		})
+	}
+	return params, nil
+}
+
+// servicePushParams builds the parameters that recreate a service, remapping its owning group.
+func servicePushParams(service *Service, groups func(string) (string, bool)) (PushServiceParams, error) {
+	params := PushServiceParams{
+		ID:           stringValue(service.ID),
+		TargetName:   stringValue(service.TargetName),
+		Description:  nullableOrNull(service.Description),
+		ServiceType:  stringValue(service.ServiceType),
+		ServiceTier:  nullableOrNull(service.ServiceTier),
+		OwnedBy:      NewNull[*GroupReference](),
+		ServiceLinks: service.ServiceLinks,
+		Status:       ServiceStatus(stringValue(service.Status)),
+	}
+	if service.OwnedBy != nil && service.OwnedBy.ID != nil {
+		groupId, ok := groups(*service.OwnedBy.ID)
+		if !ok {
+			return params, fmt.Errorf("owning group %s does not exist in the target instance", stringValue(service.OwnedBy.TargetName))
+		}
+		params.OwnedBy = NewNullable(&GroupReference{ID: StringPtr(groupId)})
+	}
+	return params, nil
+}
+
+// deviceKey returns the value used to match a device between instances.
+func deviceKey(device *Device) string {
+	owner := ""
+	if device.Owner != nil {
+		owner = stringValue(device.Owner.TargetName)
+	}
+	return owner + "|" + stringValue(device.Name)
+}
+
+// rosterContainsAll reports whether every member of want is in have.
+func rosterContainsAll(have, want []*GroupMember) bool {
+	for _, member := range want {
+		if !ContainsMember(*member, have) {
+			return false
+		}
+	}
+	return true
+}
+
+// jsonEqual reports whether two values encode to the same JSON document.
+func jsonEqual(a, b interface{}) bool {
+	aBytes, aErr := json.Marshal(a)
+	bBytes, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aBytes) == string(bBytes)
+}
@@ -0,0 +1,217 @@
+package xmatters
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Subscription Structs
+// -------------------------------------------------------------------------------------------------
+
+// Subscription represents a subscription in xMatters.
+// A subscription notifies its subscribers whenever an event is sent from its form and matches its criteria.
+type Subscription struct {
+	ID          *string                `json:"id"`
+	Name        *string                `json:"name"`
+	Description *string                `json:"description,omitempty"`
+	Form        *FormReference         `json:"form,omitempty"`
+	Owner       *PersonReference       `json:"owner,omitempty"`
+	Criteria    map[string]interface{} `json:"criteria,omitempty"`
+	NotifyOwner *bool                  `json:"notifyOwner,omitempty"`
+	Created     *Timestamp             `json:"created,omitempty"`
+}
+
+// SubscriptionPagination contains a paginated list of subscriptions.
+// It extends the Pagination struct containing links to additional pages.
+type SubscriptionPagination struct {
+	*Pagination
+	Subscriptions []*Subscription `json:"data"`
+}
+
+// SubscriberPagination contains a paginated list of subscribers.
+// It extends the Pagination struct containing links to additional pages.
+type SubscriberPagination struct {
+	*Pagination
+	Subscribers []*PersonReference `json:"data"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// GetSubscriptionsParams contains available API query parameters for the GetSubscriptionList method.
+type GetSubscriptionsParams struct {
+	Search string `url:"search,omitempty"`
+	Form   string `url:"form,omitempty"`
+	Owner  string `url:"owner,omitempty"`
+}
+
+// PushSubscriptionParams contains available API body parameters for the PushSubscription method.
+type PushSubscriptionParams struct {
+	// Required Fields
+	Name string        `json:"name"`
+	Form ReferenceById `json:"form"`
+	// Optional Fields
+	ID          string                 `json:"id,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Owner       *ReferenceById         `json:"owner,omitempty"`
+	Criteria    map[string]interface{} `json:"criteria,omitempty"`
+	NotifyOwner *bool                  `json:"notifyOwner,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Subscription Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetSubscription retrieves a subscription in xMatters.
+// It requires the subscriptionId parameter to identify the specific subscription, and returns a Subscription object.
+func (xmatters *XMattersAPI) GetSubscription(subscriptionId string) (Subscription, error) {
+	uri := buildURI(fmt.Sprintf("/subscriptions/%s", pathSegment(subscriptionId)), nil)
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodGet, uri, ContentJSON, nil)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	// Unmarshal the response into a Subscription struct.
+	var result Subscription
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Subscription{}, newUnmarshalError()
+	}
+
+	// Return the returned Subscription object.
+	return result, nil
+}
+
+// GetSubscriptionList retrieves a list of subscriptions in xMatters.
+// It accepts optional query parameters to filter the results and returns a slice of Subscription objects.
+func (xmatters *XMattersAPI) GetSubscriptionList(params GetSubscriptionsParams) ([]*Subscription, error) {
+	uri := buildURI("/subscriptions", params) // The URI including any Query Parameters
+
+	// Use the GetSubscriptionPaginationSet method to get all paginated results
+	subscriptionList, err := xmatters.GetSubscriptionPaginationSet(uri)
+	if err != nil {
+		return subscriptionList, err
+	}
+
+	// Return the full list of Subscriptions.
+	return subscriptionList, nil
+}
+
+// GetSubscriptionPaginationSet retrieves a paginated list of subscriptions.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetSubscriptionPaginationSet(uri string) ([]*Subscription, error) {
+	return getPaginationSet[Subscription](xmatters, uri)
+}
+
+// PushSubscription either creates a new subscription in xMatters or modifies an existing subscription.
+// It requires the PushSubscriptionParams struct containing the subscription details.
+// It returns the created or modified Subscription object.
+// If the params.ID is provided it updates the existing subscription; otherwise, it creates a new one.
+func (xmatters *XMattersAPI) PushSubscription(params PushSubscriptionParams) (Subscription, error) {
+	uri := buildURI("/subscriptions", nil) // The URI including any Query Parameters
+
+	// Perform the API request.
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, params)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	// Unmarshal the response into a Subscription struct.
+	var result Subscription
+	err = xmatters.decode(resp, &result)
+	if err != nil {
+		return Subscription{}, newUnmarshalError()
+	}
+
+	// Return the returned Subscription object.
+	return result, nil
+}
+
+// DeleteSubscription deletes a subscription in xMatters.
+// It requires the subscriptionId parameter to identify the specific subscription to be deleted.
+// It returns an error if the deletion fails.
+func (xmatters *XMattersAPI) DeleteSubscription(subscriptionId string) error {
+	uri := buildURI(fmt.Sprintf("/subscriptions/%s", pathSegment(subscriptionId)), nil)
+
+	// Perform the API request.
+	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
+	if err != nil {
+		return err
+	}
+
+	// Return
+	return nil
+}
+
+// GetSubscribers retrieves the people subscribed to a subscription in xMatters.
+// It requires the subscriptionId parameter to identify the specific subscription, and returns a slice of PersonReference objects.
+func (xmatters *XMattersAPI) GetSubscribers(subscriptionId string) ([]*PersonReference, error) {
+	uri := buildURI(fmt.Sprintf("/subscriptions/%s/subscribers", pathSegment(subscriptionId)), nil)
+
+	// Use the GetSubscriberPaginationSet method to get all paginated results
+	subscriberList, err := xmatters.GetSubscriberPaginationSet(uri)
+	if err != nil {
+		return subscriberList, err
+	}
+
+	// Return the full list of Subscribers.
+	return subscriberList, nil
+}
+
+// AddSubscriber subscribes a person to a subscription in xMatters.
+// It requires the subscriptionId and personId parameters.
+func (xmatters *XMattersAPI) AddSubscriber(subscriptionId, personId string) error {
+	uri := buildURI(fmt.Sprintf("/subscriptions/%s/subscribers", pathSegment(subscriptionId)), nil)
+	body := struct {
+		Person ReferenceById `json:"person"`
+	}{Person: ReferenceById{ID: &personId}}
+
+	// Perform the API request.
+	_, err := xmatters.Request(http.MethodPost, uri, ContentJSON, body)
+	if err != nil {
+		return err
+	}
+
+	// Return
+	return nil
+}
+
+// AddSubscribers subscribes several people to a subscription in xMatters.
+// It stops at the first failure and returns the IDs of the people that were subscribed before it.
+func (xmatters *XMattersAPI) AddSubscribers(subscriptionId string, personIds []string) ([]string, error) {
+	subscribed := []string{}
+	for _, personId := range personIds {
+		if err := xmatters.AddSubscriber(subscriptionId, personId); err != nil {
+			return subscribed, err
+		}
+		subscribed = append(subscribed, personId)
+	}
+	return subscribed, nil
+}
+
+// RemoveSubscriber unsubscribes a person from a subscription in xMatters.
+// It requires the subscriptionId and personId parameters.
+func (xmatters *XMattersAPI) RemoveSubscriber(subscriptionId, personId string) error {
+	uri := buildURI(fmt.Sprintf("/subscriptions/%s/subscribers/%s", pathSegment(subscriptionId), pathSegment(personId)), nil)
+
+	// Perform the API request.
+	_, err := xmatters.Request(http.MethodDelete, uri, ContentJSON, nil)
+	if err != nil {
+		return err
+	}
+
+	// Return
+	return nil
+}
+
+// GetSubscriberPaginationSet retrieves a paginated list of subscribers.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetSubscriberPaginationSet(uri string) ([]*PersonReference, error) {
+	return getPaginationSet[PersonReference](xmatters, uri)
+}
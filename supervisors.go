@@ -0,0 +1,182 @@
+package xmatters
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// defaultSupervisoryRoles are the default xMatters roles that allow a person to supervise other people.
+var defaultSupervisoryRoles = []string{"Person Supervisor", "Company Supervisor", "Full Access User"}
+
+// -------------------------------------------------------------------------------------------------
+// Supervisor Structs
+// -------------------------------------------------------------------------------------------------
+
+// personSupervisorsParams is the body of a request that modifies only the supervisors of a person.
+type personSupervisorsParams struct {
+	ID          string   `json:"id"`
+	Supervisors []string `json:"supervisors"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Supervisor Methods
+// -------------------------------------------------------------------------------------------------
+
+// SetPersonSupervisors replaces the supervisors of a person in xMatters with the people named by their target names.
+// Before making the change it checks that every supervisor exists, is not the person, holds one of the roles
+// that can supervise people, and is not supervised by the person directly or indirectly, since that would create a
+// supervision cycle. Every problem found is returned in a single error, with each cycle listed as the chain of
+// supervisors that closes it, and no change is made. An empty list removes the supervisors of the person.
+// It returns the modified Person object.
+func (xmatters *XMattersAPI) SetPersonSupervisors(personId string, supervisorTargetNames []string) (Person, error) {
+	person, err := xmatters.GetPerson(personId, WithEmbed("supervisors"))
+	if err != nil {
+		return Person{}, err
+	}
+	personName := stringValue(person.TargetName)
+
+	// Look up and check each supervisor
+	var errs []error
+	supervisorIds := []string{}
+	chains := &supervisorChains{xmatters: xmatters, people: map[string]*Person{stringValue(person.ID): &person}}
+	seen := make(map[string]bool)
+	for _, targetName := range supervisorTargetNames {
+		targetName = strings.TrimSpace(targetName)
+		if targetName == "" || seen[strings.ToLower(targetName)] {
+			continue
+		}
+		seen[strings.ToLower(targetName)] = true
+
+		supervisor, err := xmatters.GetPerson(targetName, WithEmbed("roles,supervisors"))
+		if isNotFound(err) {
+			errs = append(errs, newValidationError(fmt.Sprintf("supervisor %q does not exist", targetName)))
+			continue
+		}
+		if err != nil {
+			return Person{}, err
+		}
+		supervisorId := stringValue(supervisor.ID)
+		if supervisorId == stringValue(person.ID) {
+			errs = append(errs, newValidationError(fmt.Sprintf("%s cannot supervise themselves", personName)))
+			continue
+		}
+		if !hasSupervisoryRole(&supervisor, xmatters.supervisoryRolesOrDefault()) {
+			errs = append(errs, newValidationError(fmt.Sprintf("supervisor %q has none of the roles that can supervise people (%s)",
+				targetName, strings.Join(xmatters.supervisoryRolesOrDefault(), ", "))))
+			continue
+		}
+		chains.people[supervisorId] = &supervisor
+
+		// A supervisor that the person supervises, directly or indirectly, would close a cycle
+		chain, err := chains.find(supervisorId, stringValue(person.ID))
+		if err != nil {
+			return Person{}, err
+		}
+		if chain != nil {
+			errs = append(errs, newValidationError(fmt.Sprintf("%s cannot supervise %s, as that would create a supervision cycle: %s",
+				targetName, personName, strings.Join(append(chain, stringValue(supervisor.TargetName)), " supervises "))))
+			continue
+		}
+		supervisorIds = append(supervisorIds, supervisorId)
+	}
+	if len(errs) > 0 {
+		return Person{}, errors.Join(errs...)
+	}
+
+	// Perform the API request.
+	uri := buildURI("/people", nil)
+	resp, err := xmatters.Request(http.MethodPost, uri, ContentJSON, personSupervisorsParams{ID: stringValue(person.ID), Supervisors: supervisorIds})
+	if err != nil {
+		return Person{}, err
+	}
+
+	// Unmarshal the response into a Person struct.
+	var result Person
+	if err := xmatters.decode(resp, &result); err != nil {
+		return Person{}, newUnmarshalError()
+	}
+	return result, nil
+}
+
+// supervisoryRolesOrDefault returns the roles that allow a person to supervise other people, as set by
+// WithSupervisoryRoles, or the default xMatters roles.
+func (xmatters *XMattersAPI) supervisoryRolesOrDefault() []string {
+	if xmatters.supervisoryRoles == nil {
+		return defaultSupervisoryRoles
+	}
+	return xmatters.supervisoryRoles
+}
+
+// hasSupervisoryRole reports whether the person holds one of the supervisory roles, matched without regard to case.
+func hasSupervisoryRole(person *Person, supervisoryRoles []string) bool {
+	for _, role := range person.Roles {
+		for _, supervisory := range supervisoryRoles {
+			if strings.EqualFold(stringValue(role.Name), supervisory) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// supervisorChains follows the supervisors of people, caching the people it retrieves.
+type supervisorChains struct {
+	xmatters *XMattersAPI
+	people   map[string]*Person
+}
+
+// find searches the supervisors of a person, and their supervisors in turn, for the ancestor.
+// It returns the target names of the chain from the ancestor down to the person, each supervising the next,
+// or nil when the ancestor does not supervise the person.
+func (c *supervisorChains) find(personId, ancestorId string) ([]string, error) {
+	// Search breadth first, remembering who each visited person supervises to rebuild the chain
+	supervises := map[string]string{personId: ""}
+	queue := []string{personId}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		person, err := c.person(id)
+		if err != nil {
+			return nil, err
+		}
+		for _, supervisor := range person.Supervisors {
+			supervisorId := stringValue(supervisor.ID)
+			if _, visited := supervises[supervisorId]; visited || supervisorId == "" {
+				continue
+			}
+			supervises[supervisorId] = id
+			if supervisorId == ancestorId {
+				chain := []string{}
+				for current := supervisorId; current != personId; current = supervises[current] {
+					chain = append(chain, c.name(current))
+				}
+				return chain, nil
+			}
+			queue = append(queue, supervisorId)
+		}
+	}
+	return nil, nil
+}
+
+// person returns a person with their supervisors, retrieving them if they were not retrieved before.
+func (c *supervisorChains) person(id string) (*Person, error) {
+	if person, ok := c.people[id]; ok {
+		return person, nil
+	}
+	person, err := c.xmatters.GetPerson(id, WithEmbed("supervisors"))
+	if err != nil {
+		return nil, err
+	}
+	c.people[id] = &person
+	return &person, nil
+}
+
+// name returns the target name of a retrieved person, or their ID when they were not retrieved.
+func (c *supervisorChains) name(id string) string {
+	if person, ok := c.people[id]; ok && person.TargetName != nil {
+		return *person.TargetName
+	}
+	return id
+}
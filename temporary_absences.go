@@ -0,0 +1,61 @@
+package xmatters
+
+// -------------------------------------------------------------------------------------------------
+// Temporary Absence Structs
+// -------------------------------------------------------------------------------------------------
+
+// TemporaryAbsence represents a period during which a person is removed from, or replaced in, on-call shifts.
+// AbsenceType is VACATION when the member is simply skipped, or REPLACEMENT when another person covers for them.
+type TemporaryAbsence struct {
+	ID          *string          `json:"id"`
+	AbsenceType *string          `json:"absenceType"`
+	Member      *PersonReference `json:"member"`
+	Start       *Timestamp       `json:"start"`
+	End         *Timestamp       `json:"end"`
+	Group       *GroupReference  `json:"group,omitempty"`
+	Replacement *PersonReference `json:"replacement,omitempty"`
+}
+
+// TemporaryAbsencePagination contains a paginated list of temporary absences.
+// It extends the Pagination struct containing links to additional pages.
+type TemporaryAbsencePagination struct {
+	*Pagination
+	Absences []*TemporaryAbsence `json:"data"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Method Parameter Structs
+// -------------------------------------------------------------------------------------------------
+
+// GetTemporaryAbsencesParams contains available API query parameters for the GetTemporaryAbsenceList method.
+type GetTemporaryAbsencesParams struct {
+	Members string     `url:"members,omitempty"`
+	From    *Timestamp `url:"from,omitempty"`
+	To      *Timestamp `url:"to,omitempty"`
+}
+
+// -------------------------------------------------------------------------------------------------
+// Temporary Absence Methods
+// -------------------------------------------------------------------------------------------------
+
+// GetTemporaryAbsenceList retrieves a list of temporary absences in xMatters.
+// It accepts optional query parameters to filter the results and returns a slice of TemporaryAbsence objects.
+func (xmatters *XMattersAPI) GetTemporaryAbsenceList(params GetTemporaryAbsencesParams) ([]*TemporaryAbsence, error) {
+	uri := buildURI("/temporary-absences", params)
+
+	// Use the GetTemporaryAbsencePaginationSet method to get all paginated results
+	absenceList, err := xmatters.GetTemporaryAbsencePaginationSet(uri)
+	if err != nil {
+		return absenceList, err
+	}
+
+	// Return the full list of TemporaryAbsences.
+	return absenceList, nil
+}
+
+// GetTemporaryAbsencePaginationSet retrieves a paginated list of temporary absences.
+// It takes a URI as input, retrieves the paginated set from that URI, and follows the next links until all pages
+// are retrieved or the MaxPages or MaxItems limit of the client is reached.
+func (xmatters *XMattersAPI) GetTemporaryAbsencePaginationSet(uri string) ([]*TemporaryAbsence, error) {
+	return getPaginationSet[TemporaryAbsence](xmatters, uri)
+}
@@ -0,0 +1,125 @@
+package xmatters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Timestamp Structs
+// -------------------------------------------------------------------------------------------------
+
+// TimestampFormat is the ISO-8601 layout xMatters uses for dates and times, such as "2024-03-01T14:30:00.000Z".
+const TimestampFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// timestampFallbackFormat is the ISO-8601 layout with an offset without a colon, which ParseTimestamp also accepts.
+const timestampFallbackFormat = "2006-01-02T15:04:05.999-0700"
+
+// Timestamp is a point in time that marshals to and from the ISO-8601 format used by xMatters.
+// It embeds time.Time, so all of its methods are available; timestamps are always held in UTC.
+// A Timestamp can also be used as a query parameter, where it is encoded in the same format.
+type Timestamp struct {
+	time.Time
+}
+
+// -------------------------------------------------------------------------------------------------
+// Timestamp Methods
+// -------------------------------------------------------------------------------------------------
+
+// NewTimestamp returns a pointer to a Timestamp for the provided time, or nil for the zero time.
+// The nil result lets optional time fields and query parameters be set directly from a time.Time.
+func NewTimestamp(t time.Time) *Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return &Timestamp{Time: t.UTC()}
+}
+
+// ParseTimestamp parses an ISO-8601 date and time, with or without fractional seconds.
+// Offsets without a colon, such as "2024-03-01T14:30:00.000+0000", are also accepted, as some
+// xMatters endpoints return them.
+func ParseTimestamp(value string) (Timestamp, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		var fallbackErr error
+		if t, fallbackErr = time.Parse(timestampFallbackFormat, value); fallbackErr != nil {
+			return Timestamp{}, err
+		}
+	}
+	return Timestamp{Time: t.UTC()}, nil
+}
+
+// TimeRange returns the From and To query parameters for the provided time range.
+// A zero time leaves that end of the range open.
+func TimeRange(from, to time.Time) (*Timestamp, *Timestamp) {
+	return NewTimestamp(from), NewTimestamp(to)
+}
+
+// LastRange returns the From and To query parameters for the range ending now and lasting the provided duration.
+func LastRange(d time.Duration) (*Timestamp, *Timestamp) {
+	now := time.Now()
+	return TimeRange(now.Add(-d), now)
+}
+
+// Equal reports whether t and other represent the same instant.
+func (t Timestamp) Equal(other Timestamp) bool {
+	return t.Time.Equal(other.Time)
+}
+
+// String returns the timestamp in the xMatters ISO-8601 format, or an empty string for the zero time.
+func (t Timestamp) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(TimestampFormat)
+}
+
+// MarshalJSON encodes the timestamp as an ISO-8601 string, or null for the zero time.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decodes an ISO-8601 string into the timestamp. Null and empty strings leave it zero.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == "" {
+		*t = Timestamp{}
+		return nil
+	}
+	parsed, err := ParseTimestamp(value)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %q: %w", value, err)
+	}
+	*t = parsed
+	return nil
+}
+
+// EncodeValues adds the timestamp to query parameters under the given key, omitting the zero time.
+// It implements the query.Encoder interface used to build request URIs.
+func (t Timestamp) EncodeValues(key string, v *url.Values) error {
+	if !t.IsZero() {
+		v.Set(key, t.String())
+	}
+	return nil
+}
+
+// timeValue returns the time a Timestamp pointer holds, or the zero time if it is nil.
+func timeValue(t *Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.Time
+}
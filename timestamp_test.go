@@ -0,0 +1,106 @@
+package xmatters
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "2024-03-01T14:30:00.000Z", want: time.Date(2024, 3, 1, 14, 30, 0, 0, time.UTC)},
+		{value: "2024-03-01T14:30:00Z", want: time.Date(2024, 3, 1, 14, 30, 0, 0, time.UTC)},
+		{value: "2024-03-01T14:30:00.123456789Z", want: time.Date(2024, 3, 1, 14, 30, 0, 123456789, time.UTC)},
+		{value: "2024-03-01T14:30:00.000+02:00", want: time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)},
+		{value: "2024-03-01T14:30:00.000+0000", want: time.Date(2024, 3, 1, 14, 30, 0, 0, time.UTC)},
+		{value: "2024-03-01T14:30:00.250-0500", want: time.Date(2024, 3, 1, 19, 30, 0, 250000000, time.UTC)},
+		{value: "2024-03-01T14:30:00+0530", want: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)},
+		{value: "2024-03-01T14:30:00", wantErr: true},
+		{value: "2024-03-01", wantErr: true},
+		{value: "2024-03-01T14:30:00.000+00", wantErr: true},
+		{value: "not a timestamp", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseTimestamp(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Time.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("got %v, want %v in UTC", got.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimestampJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{name: "colon offset", json: `"2024-03-01T14:30:00.000+01:00"`, want: "2024-03-01T13:30:00.000Z"},
+		{name: "offset without a colon", json: `"2024-03-01T14:30:00.000+0100"`, want: "2024-03-01T13:30:00.000Z"},
+		{name: "null", json: `null`, want: ""},
+		{name: "empty string", json: `""`, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Timestamp
+			if err := json.Unmarshal([]byte(tt.json), &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("got %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+
+	var invalid Timestamp
+	if err := json.Unmarshal([]byte(`"2024-03-01 14:30"`), &invalid); err == nil {
+		t.Error("got no error for an invalid timestamp")
+	}
+}
+
+func TestTimestampRoundTrip(t *testing.T) {
+	original := Timestamp{Time: time.Date(2024, 3, 1, 14, 30, 0, 5000000, time.UTC)}
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"2024-03-01T14:30:00.005Z"` {
+		t.Errorf("got %s", data)
+	}
+	var decoded Timestamp
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(original) {
+		t.Errorf("got %v, want %v", decoded, original)
+	}
+
+	zero, err := json.Marshal(Timestamp{})
+	if err != nil || string(zero) != "null" {
+		t.Errorf("got %s, %v, want null", zero, err)
+	}
+
+	values := url.Values{}
+	if err := (Timestamp{}).EncodeValues("from", &values); err != nil || values.Has("from") {
+		t.Errorf("the zero time was encoded as %q", values.Get("from"))
+	}
+	if err := original.EncodeValues("from", &values); err != nil || values.Get("from") != "2024-03-01T14:30:00.005Z" {
+		t.Errorf("got %q", values.Get("from"))
+	}
+}
@@ -0,0 +1,88 @@
+package webhooks
+
+import (
+	"errors"
+	"io"
+	"net/http"
+)
+
+// maxBodySize limits the size of callback payloads read by the Handler.
+const maxBodySize = 1 << 20
+
+// Handler is an http.Handler that parses xMatters callbacks and dispatches them to the matching function.
+// Callbacks without a matching function are acknowledged and ignored. A function returning an error
+// causes the Handler to respond with 500 Internal Server Error so xMatters records the failure.
+type Handler struct {
+	OnEventStatus    func(*EventStatusCallback) error
+	OnDeliveryStatus func(*DeliveryStatusCallback) error
+	OnResponse       func(*ResponseCallback) error
+	OnEscalation     func(*EscalationCallback) error
+	OnDeviceRefresh  func(*DeviceRefreshCallback) error
+	// OnError is called when a callback cannot be read or parsed, or when a function returns an error.
+	OnError func(error)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Read and parse the callback payload
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		h.fail(w, err, http.StatusBadRequest)
+		return
+	}
+	callback, err := Parse(body)
+	if err != nil {
+		h.fail(w, err, http.StatusBadRequest)
+		return
+	}
+
+	// Dispatch the callback to the matching function
+	if err := h.dispatch(callback); err != nil {
+		h.fail(w, err, http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch calls the function registered for the callback type, if any.
+func (h *Handler) dispatch(callback Callback) error {
+	switch c := callback.(type) {
+	case *EventStatusCallback:
+		if h.OnEventStatus != nil {
+			return h.OnEventStatus(c)
+		}
+	case *DeliveryStatusCallback:
+		if h.OnDeliveryStatus != nil {
+			return h.OnDeliveryStatus(c)
+		}
+	case *ResponseCallback:
+		if h.OnResponse != nil {
+			return h.OnResponse(c)
+		}
+	case *EscalationCallback:
+		if h.OnEscalation != nil {
+			return h.OnEscalation(c)
+		}
+	case *DeviceRefreshCallback:
+		if h.OnDeviceRefresh != nil {
+			return h.OnDeviceRefresh(c)
+		}
+	default:
+		return errors.New("webhooks: unsupported callback type")
+	}
+	return nil
+}
+
+// fail reports the error and writes the status code to the response.
+func (h *Handler) fail(w http.ResponseWriter, err error, status int) {
+	if h.OnError != nil {
+		h.OnError(err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
@@ -0,0 +1,171 @@
+// Package webhooks provides typed payloads and an http.Handler for xMatters outbound integration callbacks.
+//
+// xMatters outbound integrations post a JSON document to a configured URL when something happens to an event,
+// such as a status change, a notification delivery, a response, or an escalation. This package parses those
+// documents into typed structs so services consuming the callbacks do not need to hand-roll the schemas.
+//
+// Usage:
+//
+//	handler := &webhooks.Handler{
+//	    OnResponse: func(callback *webhooks.ResponseCallback) error {
+//	        log.Printf("%s responded %q to %s", callback.Recipient, callback.Response, callback.EventIdentifier)
+//	        return nil
+//	    },
+//	}
+//	http.Handle("/xmatters/callbacks", handler)
+package webhooks
+
+//go:generate go run ../internal/modelgen -dir . -out zz_generated_models.go -accessors zz_generated_accessors.go
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// -------------------------------------------------------------------------------------------------
+// Callback Structs
+// -------------------------------------------------------------------------------------------------
+
+// CallbackType identifies the kind of outbound integration callback sent by xMatters.
+type CallbackType string
+
+const (
+	// CallbackEventStatus is sent when an event is created, suspended, resumed, or terminated.
+	CallbackEventStatus CallbackType = "EVENT_STATUS"
+	// CallbackDeliveryStatus is sent when the delivery status of a notification changes.
+	CallbackDeliveryStatus CallbackType = "DELIVERY_STATUS"
+	// CallbackResponse is sent when a recipient responds to a notification.
+	CallbackResponse CallbackType = "RESPONSE"
+	// CallbackEscalation is sent when an event escalates to the next recipients.
+	CallbackEscalation CallbackType = "ESCALATION"
+	// CallbackDeviceRefresh is sent when a recipient's device is added, changed, or removed.
+	CallbackDeviceRefresh CallbackType = "DEVICE_REFRESH"
+)
+
+// Callback is implemented by every typed callback payload.
+type Callback interface {
+	CallbackType() CallbackType
+}
+
+// Recipient identifies the person, group, or device a callback refers to.
+type Recipient struct {
+	ID            string `json:"id,omitempty"`
+	TargetName    string `json:"targetName,omitempty"`
+	RecipientType string `json:"recipientType,omitempty"`
+}
+
+// Device identifies the device a notification was sent to.
+type Device struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	DeviceType string `json:"deviceType,omitempty"`
+}
+
+// EventStatusCallback is the payload of an event status callback.
+type EventStatusCallback struct {
+	EventIdentifier string                 `json:"eventIdentifier"`
+	Status          string                 `json:"status"`
+	Username        string                 `json:"username,omitempty"`
+	Date            string                 `json:"date,omitempty"`
+	EventProperties map[string]interface{} `json:"eventProperties,omitempty"`
+}
+
+// DeliveryStatusCallback is the payload of a notification delivery status callback.
+type DeliveryStatusCallback struct {
+	EventIdentifier string                 `json:"eventIdentifier"`
+	DeliveryStatus  string                 `json:"deliveryStatus"`
+	Recipient       string                 `json:"recipient,omitempty"`
+	Device          string                 `json:"device,omitempty"`
+	Message         string                 `json:"message,omitempty"`
+	Date            string                 `json:"date,omitempty"`
+	EventProperties map[string]interface{} `json:"eventProperties,omitempty"`
+}
+
+// ResponseCallback is the payload of a notification response callback.
+type ResponseCallback struct {
+	EventIdentifier string                 `json:"eventIdentifier"`
+	Response        string                 `json:"response"`
+	Recipient       string                 `json:"recipient,omitempty"`
+	Device          string                 `json:"device,omitempty"`
+	Annotation      string                 `json:"annotation,omitempty"`
+	Date            string                 `json:"date,omitempty"`
+	EventProperties map[string]interface{} `json:"eventProperties,omitempty"`
+}
+
+// EscalationCallback is the payload of an event escalation callback.
+type EscalationCallback struct {
+	EventIdentifier string                 `json:"eventIdentifier"`
+	EscalationType  string                 `json:"escalationType"`
+	From            []*Recipient           `json:"from,omitempty"`
+	To              []*Recipient           `json:"to,omitempty"`
+	Date            string                 `json:"date,omitempty"`
+	EventProperties map[string]interface{} `json:"eventProperties,omitempty"`
+}
+
+// DeviceRefreshCallback is the payload of a device refresh callback.
+type DeviceRefreshCallback struct {
+	Action    string     `json:"action"`
+	Recipient *Recipient `json:"recipient,omitempty"`
+	Device    *Device    `json:"deviceInfo,omitempty"`
+	Date      string     `json:"date,omitempty"`
+}
+
+// CallbackType returns CallbackEventStatus.
+func (*EventStatusCallback) CallbackType() CallbackType { return CallbackEventStatus }
+
+// CallbackType returns CallbackDeliveryStatus.
+func (*DeliveryStatusCallback) CallbackType() CallbackType { return CallbackDeliveryStatus }
+
+// CallbackType returns CallbackResponse.
+func (*ResponseCallback) CallbackType() CallbackType { return CallbackResponse }
+
+// CallbackType returns CallbackEscalation.
+func (*EscalationCallback) CallbackType() CallbackType { return CallbackEscalation }
+
+// CallbackType returns CallbackDeviceRefresh.
+func (*DeviceRefreshCallback) CallbackType() CallbackType { return CallbackDeviceRefresh }
+
+// -------------------------------------------------------------------------------------------------
+// Parsing
+// -------------------------------------------------------------------------------------------------
+
+// ErrUnknownCallback is returned when a payload does not match any known callback type.
+var ErrUnknownCallback = errors.New("webhooks: unrecognized xMatters callback payload")
+
+// Parse decodes an outbound integration callback and returns the matching typed payload.
+// The callback type is detected from the fields present in the payload.
+func Parse(data []byte) (Callback, error) {
+	// Decode the top-level fields to detect the callback type
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	var callback Callback
+	switch {
+	case has(fields, "escalationType"):
+		callback = &EscalationCallback{}
+	case has(fields, "response"):
+		callback = &ResponseCallback{}
+	case has(fields, "deliveryStatus"):
+		callback = &DeliveryStatusCallback{}
+	case has(fields, "deviceInfo"):
+		callback = &DeviceRefreshCallback{}
+	case has(fields, "status") && has(fields, "eventIdentifier"):
+		callback = &EventStatusCallback{}
+	default:
+		return nil, ErrUnknownCallback
+	}
+
+	// Decode the full payload into the typed struct
+	if err := json.Unmarshal(data, callback); err != nil {
+		return nil, err
+	}
+	return callback, nil
+}
+
+// has reports whether the field is present and not null.
+func has(fields map[string]json.RawMessage, name string) bool {
+	value, ok := fields[name]
+	return ok && string(value) != "null"
+}
@@ -0,0 +1,283 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package webhooks
+
+// GetEventIdentifier returns the EventIdentifier field of x, or its zero value if it or x is nil.
+func (x *DeliveryStatusCallback) GetEventIdentifier() string {
+	if x != nil {
+		return x.EventIdentifier
+	}
+	return ""
+}
+
+// GetDeliveryStatus returns the DeliveryStatus field of x, or its zero value if it or x is nil.
+func (x *DeliveryStatusCallback) GetDeliveryStatus() string {
+	if x != nil {
+		return x.DeliveryStatus
+	}
+	return ""
+}
+
+// GetRecipient returns the Recipient field of x, or its zero value if it or x is nil.
+func (x *DeliveryStatusCallback) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+// GetDevice returns the Device field of x, or its zero value if it or x is nil.
+func (x *DeliveryStatusCallback) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+// GetMessage returns the Message field of x, or its zero value if it or x is nil.
+func (x *DeliveryStatusCallback) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetDate returns the Date field of x, or its zero value if it or x is nil.
+func (x *DeliveryStatusCallback) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// GetEventProperties returns the EventProperties field of x, or its zero value if it or x is nil.
+func (x *DeliveryStatusCallback) GetEventProperties() map[string]interface{} {
+	if x != nil {
+		return x.EventProperties
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Device) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Device) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetDeviceType returns the DeviceType field of x, or its zero value if it or x is nil.
+func (x *Device) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+// GetAction returns the Action field of x, or its zero value if it or x is nil.
+func (x *DeviceRefreshCallback) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// GetRecipient returns the Recipient field of x, or its zero value if it or x is nil.
+func (x *DeviceRefreshCallback) GetRecipient() *Recipient {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+// GetDevice returns the Device field of x, or its zero value if it or x is nil.
+func (x *DeviceRefreshCallback) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+// GetDate returns the Date field of x, or its zero value if it or x is nil.
+func (x *DeviceRefreshCallback) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// GetEventIdentifier returns the EventIdentifier field of x, or its zero value if it or x is nil.
+func (x *EscalationCallback) GetEventIdentifier() string {
+	if x != nil {
+		return x.EventIdentifier
+	}
+	return ""
+}
+
+// GetEscalationType returns the EscalationType field of x, or its zero value if it or x is nil.
+func (x *EscalationCallback) GetEscalationType() string {
+	if x != nil {
+		return x.EscalationType
+	}
+	return ""
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *EscalationCallback) GetFrom() []*Recipient {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *EscalationCallback) GetTo() []*Recipient {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// GetDate returns the Date field of x, or its zero value if it or x is nil.
+func (x *EscalationCallback) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// GetEventProperties returns the EventProperties field of x, or its zero value if it or x is nil.
+func (x *EscalationCallback) GetEventProperties() map[string]interface{} {
+	if x != nil {
+		return x.EventProperties
+	}
+	return nil
+}
+
+// GetEventIdentifier returns the EventIdentifier field of x, or its zero value if it or x is nil.
+func (x *EventStatusCallback) GetEventIdentifier() string {
+	if x != nil {
+		return x.EventIdentifier
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *EventStatusCallback) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetUsername returns the Username field of x, or its zero value if it or x is nil.
+func (x *EventStatusCallback) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// GetDate returns the Date field of x, or its zero value if it or x is nil.
+func (x *EventStatusCallback) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// GetEventProperties returns the EventProperties field of x, or its zero value if it or x is nil.
+func (x *EventStatusCallback) GetEventProperties() map[string]interface{} {
+	if x != nil {
+		return x.EventProperties
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Recipient) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *Recipient) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+// GetRecipientType returns the RecipientType field of x, or its zero value if it or x is nil.
+func (x *Recipient) GetRecipientType() string {
+	if x != nil {
+		return x.RecipientType
+	}
+	return ""
+}
+
+// GetEventIdentifier returns the EventIdentifier field of x, or its zero value if it or x is nil.
+func (x *ResponseCallback) GetEventIdentifier() string {
+	if x != nil {
+		return x.EventIdentifier
+	}
+	return ""
+}
+
+// GetResponse returns the Response field of x, or its zero value if it or x is nil.
+func (x *ResponseCallback) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+// GetRecipient returns the Recipient field of x, or its zero value if it or x is nil.
+func (x *ResponseCallback) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+// GetDevice returns the Device field of x, or its zero value if it or x is nil.
+func (x *ResponseCallback) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+// GetAnnotation returns the Annotation field of x, or its zero value if it or x is nil.
+func (x *ResponseCallback) GetAnnotation() string {
+	if x != nil {
+		return x.Annotation
+	}
+	return ""
+}
+
+// GetDate returns the Date field of x, or its zero value if it or x is nil.
+func (x *ResponseCallback) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// GetEventProperties returns the EventProperties field of x, or its zero value if it or x is nil.
+func (x *ResponseCallback) GetEventProperties() map[string]interface{} {
+	if x != nil {
+		return x.EventProperties
+	}
+	return nil
+}
@@ -27,30 +27,49 @@
 //	fmt.Println(users)
 package xmatters
 
+//go:generate go run ./internal/modelgen -dir . -out zz_generated_models.go -accessors zz_generated_accessors.go
+
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/motemen/go-loghttp"
-	"golang.org/x/time/rate"
 )
 
 const (
 	// xMatters_go constants
 	defaultBasePath    = "/api/xm/1"
 	ContentJSON        = "application/json"
+	ContentZIP         = "application/zip"
 	StatusOK           = 200
 	StatusCreated      = 201
+	StatusAccepted     = 202
 	StatusNoContent    = 204
 	StatusUnauthorized = 401
+
+	// maxPooledBufferSize is the capacity above which buffers are not returned to the buffer pool
+	maxPooledBufferSize = 4 << 20
+)
+
+var (
+	// bufferPool holds the buffers that response bodies are read into
+	bufferPool = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
+	// uuidPattern matches the canonical textual representation of a UUID
+	uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
 )
 
 var (
@@ -69,9 +88,25 @@ type XMattersAPI struct {
 	UserAgent   *string
 	headers     http.Header
 	httpClient  *http.Client
-	rateLimiter *rate.Limiter
+	transport   *http.Transport
+	dialer      *net.Dialer
+	scheduler   *scheduler
+	inFlight    chan struct{}
 	retryPolicy RetryPolicy
 	Debug       *bool
+	maxPages    int
+	maxItems    int
+
+	decodeWarnings DecodeWarningHandler
+	strictNumbers  bool
+	progress       ProgressReporter
+	priority       Priority
+	holidays       *HolidayCalendar
+
+	dedupKeyProperty string
+	capabilityRoles  CapabilityRoles
+	supervisoryRoles []string
+	syncOverlap      *time.Duration
 }
 
 // RetryPolicy specifies number of retries and min/max retry delays
@@ -89,12 +124,20 @@ func newClient(hostname string, opts ...Option) (*XMattersAPI, error) {
 	retryClient := retryablehttp.NewClient()
 	retryClient.HTTPClient.Transport = &loghttp.Transport{}
 
+	// The default HTTP client sends its requests through a pooled transport that connection pool options,
+	// such as WithMaxIdleConnsPerHost, tune after the client is created.
+	transport, dialer := newDefaultTransport()
+	defaultClient := retryablehttp.NewClient()
+	defaultClient.HTTPClient.Transport = transport
+
 	// Initialize the XMattersAPI client with the base URL, user agent, and HTTP client.
 	// The headers field is initialized as an empty http.Header map.
 	xmatters := &XMattersAPI{
 		BaseURL:    StringPtr(fmt.Sprintf("https://%v%v", hostname, defaultBasePath)),
 		UserAgent:  StringPtr(fmt.Sprintf("xmatters-go/%v", Version)),
-		httpClient: retryablehttp.NewClient().StandardClient(),
+		httpClient: defaultClient.StandardClient(),
+		transport:  transport,
+		dialer:     dialer,
 		headers:    make(http.Header),
 	}
 
@@ -157,6 +200,37 @@ func NewWithToken(hostname, token *string, opts ...Option) (*XMattersAPI, error)
 // Request performs an HTTP request with the specified method, URI, content type, and request body.
 // It returns the response body as a byte slice or an error, if any.
 func (xmatters *XMattersAPI) Request(httpMethod, uri, contentType string, body interface{}) ([]byte, error) {
+	respBody, err := xmatters.do(httpMethod, uri, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	defer putBuffer(respBody)
+
+	// Copy the response out of the pooled buffer, as the caller keeps the returned slice
+	return bytes.Clone(respBody.Bytes()), nil
+}
+
+// do performs an HTTP request like Request, but reads the response body into a pooled buffer.
+// The caller must return the buffer with putBuffer once it is no longer used.
+func (xmatters *XMattersAPI) do(httpMethod, uri, contentType string, body interface{}) (*bytes.Buffer, error) {
+	return xmatters.doURL(httpMethod, *xmatters.BaseURL+uri, contentType, body)
+}
+
+// doURL performs an HTTP request like do, to a full URL rather than a URI relative to the base URL.
+// The URL must be on the xMatters instance of the client, as the request carries the client's credentials.
+func (xmatters *XMattersAPI) doURL(httpMethod, requestURL, contentType string, body interface{}) (*bytes.Buffer, error) {
+	respBody := getBuffer()
+	if err := xmatters.send(httpMethod, requestURL, contentType, "", body, respBody); err != nil {
+		putBuffer(respBody)
+		return nil, err
+	}
+	return respBody, nil
+}
+
+// send performs an HTTP request to a full URL and copies the body of a successful response to w, so large
+// responses can be streamed rather than read into memory. The Content-Type and Accept headers are only set
+// when contentType and accept are not empty. The response of a failed request is returned as an error.
+func (xmatters *XMattersAPI) send(httpMethod, requestURL, contentType, accept string, body interface{}, w io.Writer) error {
 	// Initialize the request body and error variable
 	var reqBody io.Reader
 	var err error
@@ -172,57 +246,113 @@ func (xmatters *XMattersAPI) Request(httpMethod, uri, contentType string, body i
 			var jsonBody []byte
 			jsonBody, err = json.Marshal(body)
 			if err != nil {
-				return nil, fmt.Errorf("error marshalling body to JSON: %w", err)
+				return fmt.Errorf("error marshalling body to JSON: %w", err)
 			}
 			reqBody = bytes.NewReader(jsonBody)
 		}
 	}
 
 	// Create the HTTP request with the specified method, URI, and request body
-	request, err := http.NewRequest(httpMethod, *xmatters.BaseURL+uri, reqBody)
+	request, err := http.NewRequest(httpMethod, requestURL, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("HTTP request creation failed: %w", err)
+		return fmt.Errorf("HTTP request creation failed: %w", err)
 	}
 
 	// Set necessary headers
 	requestHeaders := make(http.Header)
-	requestHeaders.Set("Content-Type", contentType)
+	if contentType != "" {
+		requestHeaders.Set("Content-Type", contentType)
+	}
+	if accept != "" {
+		requestHeaders.Set("Accept", accept)
+	}
 	requestHeaders.Set("User-Agent", *xmatters.UserAgent)
 	copyHeader(requestHeaders, xmatters.headers)
 	request.Header = requestHeaders
 
+	// Wait for the rate limiter, if one is configured, so concurrent callers share the request rate,
+	// and then for a free request slot, if the requests in flight are limited. Waiting requests are sent
+	// in order of priority; the slot is taken last so a request waiting its turn does not hold it.
+	if xmatters.scheduler != nil {
+		if err := xmatters.scheduler.wait(context.Background(), xmatters.priority); err != nil {
+			return fmt.Errorf("rate limiter wait failed: %w", err)
+		}
+	}
+	release := xmatters.acquire()
+	defer release()
+
 	// Perform the request.
 	response, err := xmatters.httpClient.Do(request)
 	if err != nil {
-		return nil, fmt.Errorf("HTTP request failed: %w", err)
+		return fmt.Errorf("HTTP request failed: %w", err)
 	}
 	defer response.Body.Close()
 
 	// Return error if no body content is returned
 	if response.StatusCode == StatusNoContent {
-		return nil, ErrNoContent // Return a generic 204 xMattersError struct
+		return ErrNoContent // Return a generic 204 xMattersError struct
 	}
 
 	// If the response status code is 401, return an unauthorized error.
 	if response.StatusCode == StatusUnauthorized {
-		return nil, ErrInavlidCredentials
+		return ErrInavlidCredentials
+	}
+
+	// If the response status code is not 200, 201 or 202, return an error.
+	if response.StatusCode != StatusOK && response.StatusCode != StatusCreated && response.StatusCode != StatusAccepted {
+		errBody := getBuffer()
+		defer putBuffer(errBody)
+		if _, err := errBody.ReadFrom(response.Body); err != nil {
+			return fmt.Errorf("unable to read request body: %w", err)
+		}
+		return newXMattersError(errBody.Bytes())
+	}
+
+	// Copy the response body, growing a buffer from the content length when it is known.
+	if buf, ok := w.(*bytes.Buffer); ok && response.ContentLength > 0 && response.ContentLength <= maxPooledBufferSize {
+		buf.Grow(int(response.ContentLength))
+	}
+	if _, err := io.Copy(w, response.Body); err != nil {
+		return fmt.Errorf("unable to read request body: %w", err)
 	}
+	return nil
+}
 
-	// Read the response body.
-	respBody, err := io.ReadAll(response.Body)
+// getJSON performs a GET request and decodes the JSON response into v.
+// Unlike Request, the response is decoded straight from a pooled buffer without being copied,
+// which avoids an allocation per page when retrieving large paginated lists.
+// Request errors are returned as-is, and a response that cannot be decoded returns an unmarshal error
+// naming the exported function that was called.
+func (xmatters *XMattersAPI) getJSON(uri string, v interface{}) error {
+	respBody, err := xmatters.do(http.MethodGet, uri, ContentJSON, nil)
 	if err != nil {
-		return nil, fmt.Errorf("unable to read request body: %w", err)
+		return err
 	}
+	defer putBuffer(respBody)
 
-	// If the response status code is not 200 or 201, return an error.
-	if response.StatusCode != StatusOK && response.StatusCode != StatusCreated {
-		return nil, newXMattersError(respBody)
+	if err := xmatters.decode(respBody.Bytes(), v); err != nil {
+		return newUnmarshalErrorIn(getExportedCallerName(2))
 	}
+	return nil
+}
 
-	return respBody, nil
+// getBuffer returns an empty buffer from the buffer pool.
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+// putBuffer returns a buffer to the buffer pool. Buffers that grew beyond maxPooledBufferSize are dropped,
+// so a single large response does not keep its memory alive in the pool.
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	buf.Reset()
+	bufferPool.Put(buf)
 }
 
 // buildURI assembles the base path and queries for API requests.
+// The path must already be escaped; identifiers are escaped with pathSegment when the path is built.
 func buildURI(path string, options interface{}) string {
 	v, _ := query.Values(options)
 	groupsAttr := v.Get("groups")
@@ -233,7 +363,32 @@ func buildURI(path string, options interface{}) string {
 		rawQuery += "&groups=" + groupsAttr
 	}
 
-	return (&url.URL{Path: path, RawQuery: rawQuery}).String()
+	// Keep the escaped path as-is so escaped slashes in identifiers are not decoded
+	unescaped, err := url.PathUnescape(path)
+	if err != nil {
+		unescaped = path
+	}
+	return (&url.URL{Path: unescaped, RawPath: path, RawQuery: rawQuery}).String()
+}
+
+// newDefaultTransport returns the pooled transport of the default HTTP client and the dialer it connects with.
+// The settings match the pooled transport retryablehttp uses by default.
+func newDefaultTransport() (*http.Transport, *net.Dialer) {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   runtime.GOMAXPROCS(0) + 1,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     true,
+	}
+	return transport, dialer
 }
 
 // copyHeader copies the headers from the source http.Header to the target http.Header.
@@ -244,7 +399,17 @@ func copyHeader(target, source http.Header) {
 	}
 }
 
-// Helper function to get a string pointer
-func StringPtr(value string) *string {
-	return &value
+// Helper function to format a compact summary of a resource, such as "Person jsmith (John Smith, ACTIVE)".
+// Empty details are omitted.
+func formatSummary(kind, name string, details ...string) string {
+	present := make([]string, 0, len(details))
+	for _, detail := range details {
+		if detail != "" {
+			present = append(present, detail)
+		}
+	}
+	if len(present) == 0 {
+		return fmt.Sprintf("%s %s", kind, name)
+	}
+	return fmt.Sprintf("%s %s (%s)", kind, name, strings.Join(present, ", "))
 }
@@ -0,0 +1,7127 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package xmatters
+
+import (
+	"time"
+)
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Attachment) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Attachment) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetContentType returns the ContentType field of x, or its zero value if it or x is nil.
+func (x *Attachment) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+// GetSize returns the Size field of x, or its zero value if it or x is nil.
+func (x *Attachment) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Audit) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetType returns the Type field of x, or its zero value if it or x is nil.
+func (x *Audit) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+// GetAt returns the At field of x, or its zero value if it or x is nil.
+func (x *Audit) GetAt() Timestamp {
+	if x != nil && x.At != nil {
+		return *x.At
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetBy returns the By field of x, or its zero value if it or x is nil.
+func (x *Audit) GetBy() *PersonReference {
+	if x != nil {
+		return x.By
+	}
+	return nil
+}
+
+// GetEvent returns the Event field of x, or its zero value if it or x is nil.
+func (x *Audit) GetEvent() *EventReference {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// GetAnnotation returns the Annotation field of x, or its zero value if it or x is nil.
+func (x *Audit) GetAnnotation() *EventAnnotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+// GetResponse returns the Response field of x, or its zero value if it or x is nil.
+func (x *Audit) GetResponse() *UserDeliveryResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// GetNotification returns the Notification field of x, or its zero value if it or x is nil.
+func (x *Audit) GetNotification() *DeliveryNotification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// GetPerson returns the Person field of x, or its zero value if it or x is nil.
+func (x *Audit) GetPerson() *PersonReference {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+// GetRecipient returns the Recipient field of x, or its zero value if it or x is nil.
+func (x *Audit) GetRecipient() *RecipientReference {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+// GetDeliveryStatus returns the DeliveryStatus field of x, or its zero value if it or x is nil.
+func (x *Audit) GetDeliveryStatus() string {
+	if x != nil && x.DeliveryStatus != nil {
+		return *x.DeliveryStatus
+	}
+	return ""
+}
+
+// GetMessage returns the Message field of x, or its zero value if it or x is nil.
+func (x *Audit) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+// GetSource returns the Source field of x, or its zero value if it or x is nil.
+func (x *Audit) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return ""
+}
+
+// GetDetails returns the Details field of x, or its zero value if it or x is nil.
+func (x *Audit) GetDetails() map[string]interface{} {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// GetAudits returns the Audits field of x, or its zero value if it or x is nil.
+func (x *AuditPagination) GetAudits() []*Audit {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+// GetUser returns the User field of x, or its zero value if it or x is nil.
+func (x *Capabilities) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+// GetRoles returns the Roles field of x, or its zero value if it or x is nil.
+func (x *Capabilities) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// GetGranted returns the Granted field of x, or its zero value if it or x is nil.
+func (x *Capabilities) GetGranted() map[Capability]bool {
+	if x != nil {
+		return x.Granted
+	}
+	return nil
+}
+
+// GetService returns the Service field of x, or its zero value if it or x is nil.
+func (x *CatalogDependency) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+// GetDependentService returns the DependentService field of x, or its zero value if it or x is nil.
+func (x *CatalogDependency) GetDependentService() string {
+	if x != nil {
+		return x.DependentService
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *CatalogService) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *CatalogService) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetServiceType returns the ServiceType field of x, or its zero value if it or x is nil.
+func (x *CatalogService) GetServiceType() string {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ""
+}
+
+// GetServiceTier returns the ServiceTier field of x, or its zero value if it or x is nil.
+func (x *CatalogService) GetServiceTier() string {
+	if x != nil && x.ServiceTier != nil {
+		return *x.ServiceTier
+	}
+	return ""
+}
+
+// GetOwnedBy returns the OwnedBy field of x, or its zero value if it or x is nil.
+func (x *CatalogService) GetOwnedBy() string {
+	if x != nil {
+		return x.OwnedBy
+	}
+	return ""
+}
+
+// GetServiceLinks returns the ServiceLinks field of x, or its zero value if it or x is nil.
+func (x *CatalogService) GetServiceLinks() []*ServiceLink {
+	if x != nil {
+		return x.ServiceLinks
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *ChangeEvent) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetSummary returns the Summary field of x, or its zero value if it or x is nil.
+func (x *ChangeEvent) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *ChangeEvent) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetChangeType returns the ChangeType field of x, or its zero value if it or x is nil.
+func (x *ChangeEvent) GetChangeType() string {
+	if x != nil && x.ChangeType != nil {
+		return *x.ChangeType
+	}
+	return ""
+}
+
+// GetSource returns the Source field of x, or its zero value if it or x is nil.
+func (x *ChangeEvent) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return ""
+}
+
+// GetExternalURL returns the ExternalURL field of x, or its zero value if it or x is nil.
+func (x *ChangeEvent) GetExternalURL() string {
+	if x != nil && x.ExternalURL != nil {
+		return *x.ExternalURL
+	}
+	return ""
+}
+
+// GetServices returns the Services field of x, or its zero value if it or x is nil.
+func (x *ChangeEvent) GetServices() []*ServiceReference {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// GetProperties returns the Properties field of x, or its zero value if it or x is nil.
+func (x *ChangeEvent) GetProperties() map[string]interface{} {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// GetOccurred returns the Occurred field of x, or its zero value if it or x is nil.
+func (x *ChangeEvent) GetOccurred() Timestamp {
+	if x != nil && x.Occurred != nil {
+		return *x.Occurred
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetCreated returns the Created field of x, or its zero value if it or x is nil.
+func (x *ChangeEvent) GetCreated() Timestamp {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetChangeEvents returns the ChangeEvents field of x, or its zero value if it or x is nil.
+func (x *ChangeEventPagination) GetChangeEvents() []*ChangeEvent {
+	if x != nil {
+		return x.ChangeEvents
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *ChangeEventStatusParams) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *ChangeEventStatusParams) GetStatus() EventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetDefault returns the Default field of x, or its zero value if it or x is nil.
+func (x *ClientConfig) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+// GetProfiles returns the Profiles field of x, or its zero value if it or x is nil.
+func (x *ClientConfig) GetProfiles() map[string]*ClientProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+// GetHostname returns the Hostname field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+// GetInstance returns the Instance field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+// GetEnvironment returns the Environment field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+// GetRegion returns the Region field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+// GetAuthType returns the AuthType field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetAuthType() string {
+	if x != nil {
+		return x.AuthType
+	}
+	return ""
+}
+
+// GetToken returns the Token field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// GetUsername returns the Username field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// GetPassword returns the Password field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// GetRateLimit returns the RateLimit field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetRateLimit() float64 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+// GetMaxRetries returns the MaxRetries field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetMaxRetries() int {
+	if x != nil && x.MaxRetries != nil {
+		return *x.MaxRetries
+	}
+	return 0
+}
+
+// GetMinRetryDelay returns the MinRetryDelay field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetMinRetryDelay() int {
+	if x != nil {
+		return x.MinRetryDelay
+	}
+	return 0
+}
+
+// GetMaxRetryDelay returns the MaxRetryDelay field of x, or its zero value if it or x is nil.
+func (x *ClientProfile) GetMaxRetryDelay() int {
+	if x != nil {
+		return x.MaxRetryDelay
+	}
+	return 0
+}
+
+// GetType returns the Type field of x, or its zero value if it or x is nil.
+func (x *Conference) GetType() ConferenceType {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// GetBridgeID returns the BridgeID field of x, or its zero value if it or x is nil.
+func (x *Conference) GetBridgeID() string {
+	if x != nil && x.BridgeID != nil {
+		return *x.BridgeID
+	}
+	return ""
+}
+
+// GetBridgeNumber returns the BridgeNumber field of x, or its zero value if it or x is nil.
+func (x *Conference) GetBridgeNumber() string {
+	if x != nil && x.BridgeNumber != nil {
+		return *x.BridgeNumber
+	}
+	return ""
+}
+
+// GetStart returns the Start field of x, or its zero value if it or x is nil.
+func (x *CoveragePeriod) GetStart() time.Time {
+	if x != nil {
+		return x.Start
+	}
+	var zero time.Time
+	return zero
+}
+
+// GetEnd returns the End field of x, or its zero value if it or x is nil.
+func (x *CoveragePeriod) GetEnd() time.Time {
+	if x != nil {
+		return x.End
+	}
+	var zero time.Time
+	return zero
+}
+
+// GetMembers returns the Members field of x, or its zero value if it or x is nil.
+func (x *CoveragePeriod) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// GetGroup returns the Group field of x, or its zero value if it or x is nil.
+func (x *CoverageReport) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *CoverageReport) GetFrom() time.Time {
+	if x != nil {
+		return x.From
+	}
+	var zero time.Time
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *CoverageReport) GetTo() time.Time {
+	if x != nil {
+		return x.To
+	}
+	var zero time.Time
+	return zero
+}
+
+// GetGaps returns the Gaps field of x, or its zero value if it or x is nil.
+func (x *CoverageReport) GetGaps() []*CoveragePeriod {
+	if x != nil {
+		return x.Gaps
+	}
+	return nil
+}
+
+// GetSinglePerson returns the SinglePerson field of x, or its zero value if it or x is nil.
+func (x *CoverageReport) GetSinglePerson() []*CoveragePeriod {
+	if x != nil {
+		return x.SinglePerson
+	}
+	return nil
+}
+
+// GetSummary returns the Summary field of x, or its zero value if it or x is nil.
+func (x *CreateIncidentParams) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *CreateIncidentParams) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetSeverity returns the Severity field of x, or its zero value if it or x is nil.
+func (x *CreateIncidentParams) GetSeverity() IncidentSeverity {
+	if x != nil && x.Severity != nil {
+		return *x.Severity
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *CreateIncidentParams) GetStatus() IncidentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// GetRequestID returns the RequestID field of x, or its zero value if it or x is nil.
+func (x *CreateIncidentParams) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
+	}
+	return ""
+}
+
+// GetImpactedServices returns the ImpactedServices field of x, or its zero value if it or x is nil.
+func (x *CreateIncidentParams) GetImpactedServices() []*ReferenceById {
+	if x != nil {
+		return x.ImpactedServices
+	}
+	return nil
+}
+
+// GetPath returns the Path field of x, or its zero value if it or x is nil.
+func (x *DecodeWarning) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// GetType returns the Type field of x, or its zero value if it or x is nil.
+func (x *DecodeWarning) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// GetValue returns the Value field of x, or its zero value if it or x is nil.
+func (x *DecodeWarning) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// GetDropped returns the Dropped field of x, or its zero value if it or x is nil.
+func (x *DecodeWarning) GetDropped() bool {
+	if x != nil {
+		return x.Dropped
+	}
+	return false
+}
+
+// GetPersonID returns the PersonID field of x, or its zero value if it or x is nil.
+func (x *DeletePlan) GetPersonID() string {
+	if x != nil {
+		return x.PersonID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *DeletePlan) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+// GetSteps returns the Steps field of x, or its zero value if it or x is nil.
+func (x *DeletePlan) GetSteps() []*DeletePlanStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// GetWarnings returns the Warnings field of x, or its zero value if it or x is nil.
+func (x *DeletePlan) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// GetAction returns the Action field of x, or its zero value if it or x is nil.
+func (x *DeletePlanStep) GetAction() DeletePlanAction {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// GetObjectID returns the ObjectID field of x, or its zero value if it or x is nil.
+func (x *DeletePlanStep) GetObjectID() string {
+	if x != nil {
+		return x.ObjectID
+	}
+	return ""
+}
+
+// GetMemberID returns the MemberID field of x, or its zero value if it or x is nil.
+func (x *DeletePlanStep) GetMemberID() string {
+	if x != nil {
+		return x.MemberID
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *DeletePlanStep) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// GetDone returns the Done field of x, or its zero value if it or x is nil.
+func (x *DeletePlanStep) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *DeliveryNotification) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetDevice returns the Device field of x, or its zero value if it or x is nil.
+func (x *DeliveryNotification) GetDevice() *DeviceReference {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+// GetDeliveryStatus returns the DeliveryStatus field of x, or its zero value if it or x is nil.
+func (x *DeliveryNotification) GetDeliveryStatus() string {
+	if x != nil && x.DeliveryStatus != nil {
+		return *x.DeliveryStatus
+	}
+	return ""
+}
+
+// GetCreated returns the Created field of x, or its zero value if it or x is nil.
+func (x *DeliveryNotification) GetCreated() Timestamp {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetDelivered returns the Delivered field of x, or its zero value if it or x is nil.
+func (x *DeliveryNotification) GetDelivered() Timestamp {
+	if x != nil && x.Delivered != nil {
+		return *x.Delivered
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Device) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *Device) GetTargetName() string {
+	if x != nil && x.TargetName != nil {
+		return *x.TargetName
+	}
+	return ""
+}
+
+// GetCountry returns the Country field of x, or its zero value if it or x is nil.
+func (x *Device) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+// GetDefaultDevice returns the DefaultDevice field of x, or its zero value if it or x is nil.
+func (x *Device) GetDefaultDevice() bool {
+	if x != nil && x.DefaultDevice != nil {
+		return *x.DefaultDevice
+	}
+	return false
+}
+
+// GetDelay returns the Delay field of x, or its zero value if it or x is nil.
+func (x *Device) GetDelay() int32 {
+	if x != nil && x.Delay != nil {
+		return *x.Delay
+	}
+	return 0
+}
+
+// GetDeviceType returns the DeviceType field of x, or its zero value if it or x is nil.
+func (x *Device) GetDeviceType() string {
+	if x != nil && x.DeviceType != nil {
+		return *x.DeviceType
+	}
+	return ""
+}
+
+// GetEmailAddress returns the EmailAddress field of x, or its zero value if it or x is nil.
+func (x *Device) GetEmailAddress() string {
+	if x != nil && x.EmailAddress != nil {
+		return *x.EmailAddress
+	}
+	return ""
+}
+
+// GetExternalKey returns the ExternalKey field of x, or its zero value if it or x is nil.
+func (x *Device) GetExternalKey() string {
+	if x != nil && x.ExternalKey != nil {
+		return *x.ExternalKey
+	}
+	return ""
+}
+
+// GetExternallyOwned returns the ExternallyOwned field of x, or its zero value if it or x is nil.
+func (x *Device) GetExternallyOwned() bool {
+	if x != nil && x.ExternallyOwned != nil {
+		return *x.ExternallyOwned
+	}
+	return false
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Device) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetOwner returns the Owner field of x, or its zero value if it or x is nil.
+func (x *Device) GetOwner() *PersonReference {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+// GetPhoneNumber returns the PhoneNumber field of x, or its zero value if it or x is nil.
+func (x *Device) GetPhoneNumber() string {
+	if x != nil && x.PhoneNumber != nil {
+		return *x.PhoneNumber
+	}
+	return ""
+}
+
+// GetPIN returns the PIN field of x, or its zero value if it or x is nil.
+func (x *Device) GetPIN() string {
+	if x != nil && x.PIN != nil {
+		return *x.PIN
+	}
+	return ""
+}
+
+// GetPriorityThreshold returns the PriorityThreshold field of x, or its zero value if it or x is nil.
+func (x *Device) GetPriorityThreshold() string {
+	if x != nil && x.PriorityThreshold != nil {
+		return *x.PriorityThreshold
+	}
+	return ""
+}
+
+// GetSequence returns the Sequence field of x, or its zero value if it or x is nil.
+func (x *Device) GetSequence() int32 {
+	if x != nil && x.Sequence != nil {
+		return *x.Sequence
+	}
+	return 0
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *Device) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// GetTestStatus returns the TestStatus field of x, or its zero value if it or x is nil.
+func (x *Device) GetTestStatus() string {
+	if x != nil && x.TestStatus != nil {
+		return *x.TestStatus
+	}
+	return ""
+}
+
+// GetTimeframes returns the Timeframes field of x, or its zero value if it or x is nil.
+func (x *Device) GetTimeframes() []*DeviceTimeframe {
+	if x != nil {
+		return x.Timeframes
+	}
+	return nil
+}
+
+// GetTwoWayDevice returns the TwoWayDevice field of x, or its zero value if it or x is nil.
+func (x *Device) GetTwoWayDevice() bool {
+	if x != nil && x.TwoWayDevice != nil {
+		return *x.TwoWayDevice
+	}
+	return false
+}
+
+// GetDevices returns the Devices field of x, or its zero value if it or x is nil.
+func (x *DevicePagination) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *DeviceReference) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *DeviceReference) GetTargetName() string {
+	if x != nil && x.TargetName != nil {
+		return *x.TargetName
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *DeviceReference) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetDeviceType returns the DeviceType field of x, or its zero value if it or x is nil.
+func (x *DeviceReference) GetDeviceType() string {
+	if x != nil && x.DeviceType != nil {
+		return *x.DeviceType
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *DeviceTimeframe) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetStartTime returns the StartTime field of x, or its zero value if it or x is nil.
+func (x *DeviceTimeframe) GetStartTime() string {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return ""
+}
+
+// GetDurationInMinutes returns the DurationInMinutes field of x, or its zero value if it or x is nil.
+func (x *DeviceTimeframe) GetDurationInMinutes() int32 {
+	if x != nil && x.DurationInMinutes != nil {
+		return *x.DurationInMinutes
+	}
+	return 0
+}
+
+// GetDays returns the Days field of x, or its zero value if it or x is nil.
+func (x *DeviceTimeframe) GetDays() []*string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// GetExcludeHolidays returns the ExcludeHolidays field of x, or its zero value if it or x is nil.
+func (x *DeviceTimeframe) GetExcludeHolidays() bool {
+	if x != nil && x.ExcludeHolidays != nil {
+		return *x.ExcludeHolidays
+	}
+	return false
+}
+
+// GetData returns the Data field of x, or its zero value if it or x is nil.
+func (x *DeviceTimeframePagination) GetData() []*DeviceTimeframe {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetResource returns the Resource field of x, or its zero value if it or x is nil.
+func (x *DriftItem) GetResource() SnapshotResource {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+// GetKey returns the Key field of x, or its zero value if it or x is nil.
+func (x *DriftItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// GetFields returns the Fields field of x, or its zero value if it or x is nil.
+func (x *DriftItem) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// GetMissing returns the Missing field of x, or its zero value if it or x is nil.
+func (x *DriftReport) GetMissing() []*DriftItem {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+// GetExtra returns the Extra field of x, or its zero value if it or x is nil.
+func (x *DriftReport) GetExtra() []*DriftItem {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+// GetDiffering returns the Differing field of x, or its zero value if it or x is nil.
+func (x *DriftReport) GetDiffering() []*DriftItem {
+	if x != nil {
+		return x.Differing
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *DynamicTeam) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *DynamicTeam) GetTargetName() string {
+	if x != nil && x.TargetName != nil {
+		return *x.TargetName
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *DynamicTeam) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetResponseCount returns the ResponseCount field of x, or its zero value if it or x is nil.
+func (x *DynamicTeam) GetResponseCount() int64 {
+	if x != nil && x.ResponseCount != nil {
+		return *x.ResponseCount
+	}
+	return 0
+}
+
+// GetResponseCountThreshold returns the ResponseCountThreshold field of x, or its zero value if it or x is nil.
+func (x *DynamicTeam) GetResponseCountThreshold() string {
+	if x != nil && x.ResponseCountThreshold != nil {
+		return *x.ResponseCountThreshold
+	}
+	return ""
+}
+
+// GetUseEmergencyDevice returns the UseEmergencyDevice field of x, or its zero value if it or x is nil.
+func (x *DynamicTeam) GetUseEmergencyDevice() bool {
+	if x != nil && x.UseEmergencyDevice != nil {
+		return *x.UseEmergencyDevice
+	}
+	return false
+}
+
+// GetObservedByAll returns the ObservedByAll field of x, or its zero value if it or x is nil.
+func (x *DynamicTeam) GetObservedByAll() bool {
+	if x != nil && x.ObservedByAll != nil {
+		return *x.ObservedByAll
+	}
+	return false
+}
+
+// GetCriteria returns the Criteria field of x, or its zero value if it or x is nil.
+func (x *DynamicTeam) GetCriteria() []*DynamicTeamCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+// GetCriterionType returns the CriterionType field of x, or its zero value if it or x is nil.
+func (x *DynamicTeamCriterion) GetCriterionType() string {
+	if x != nil && x.CriterionType != nil {
+		return *x.CriterionType
+	}
+	return ""
+}
+
+// GetField returns the Field field of x, or its zero value if it or x is nil.
+func (x *DynamicTeamCriterion) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
+	}
+	return ""
+}
+
+// GetOperand returns the Operand field of x, or its zero value if it or x is nil.
+func (x *DynamicTeamCriterion) GetOperand() string {
+	if x != nil && x.Operand != nil {
+		return *x.Operand
+	}
+	return ""
+}
+
+// GetValue returns the Value field of x, or its zero value if it or x is nil.
+func (x *DynamicTeamCriterion) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+// GetDynamicTeams returns the DynamicTeams field of x, or its zero value if it or x is nil.
+func (x *DynamicTeamPagination) GetDynamicTeams() []*DynamicTeam {
+	if x != nil {
+		return x.DynamicTeams
+	}
+	return nil
+}
+
+// GetRecipients returns the Recipients field of x, or its zero value if it or x is nil.
+func (x *EngageIncidentParams) GetRecipients() []*EventRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+// GetMessage returns the Message field of x, or its zero value if it or x is nil.
+func (x *EngageIncidentParams) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+// GetPriority returns the Priority field of x, or its zero value if it or x is nil.
+func (x *EngageIncidentParams) GetPriority() EventPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Event) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetEventID returns the EventID field of x, or its zero value if it or x is nil.
+func (x *Event) GetEventID() string {
+	if x != nil && x.EventID != nil {
+		return *x.EventID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Event) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *Event) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// GetPriority returns the Priority field of x, or its zero value if it or x is nil.
+func (x *Event) GetPriority() string {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return ""
+}
+
+// GetIncident returns the Incident field of x, or its zero value if it or x is nil.
+func (x *Event) GetIncident() string {
+	if x != nil && x.Incident != nil {
+		return *x.Incident
+	}
+	return ""
+}
+
+// GetRequestID returns the RequestID field of x, or its zero value if it or x is nil.
+func (x *Event) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
+	}
+	return ""
+}
+
+// GetCreated returns the Created field of x, or its zero value if it or x is nil.
+func (x *Event) GetCreated() Timestamp {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTerminated returns the Terminated field of x, or its zero value if it or x is nil.
+func (x *Event) GetTerminated() Timestamp {
+	if x != nil && x.Terminated != nil {
+		return *x.Terminated
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetSubmitter returns the Submitter field of x, or its zero value if it or x is nil.
+func (x *Event) GetSubmitter() *PersonReference {
+	if x != nil {
+		return x.Submitter
+	}
+	return nil
+}
+
+// GetPlan returns the Plan field of x, or its zero value if it or x is nil.
+func (x *Event) GetPlan() *PlanReference {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// GetForm returns the Form field of x, or its zero value if it or x is nil.
+func (x *Event) GetForm() *FormReference {
+	if x != nil {
+		return x.Form
+	}
+	return nil
+}
+
+// GetBypassPhoneIntro returns the BypassPhoneIntro field of x, or its zero value if it or x is nil.
+func (x *Event) GetBypassPhoneIntro() bool {
+	if x != nil && x.BypassPhoneIntro != nil {
+		return *x.BypassPhoneIntro
+	}
+	return false
+}
+
+// GetExpirationInMinutes returns the ExpirationInMinutes field of x, or its zero value if it or x is nil.
+func (x *Event) GetExpirationInMinutes() int64 {
+	if x != nil && x.ExpirationInMinutes != nil {
+		return *x.ExpirationInMinutes
+	}
+	return 0
+}
+
+// GetOverrideDeviceRestrictions returns the OverrideDeviceRestrictions field of x, or its zero value if it or x is nil.
+func (x *Event) GetOverrideDeviceRestrictions() bool {
+	if x != nil && x.OverrideDeviceRestrictions != nil {
+		return *x.OverrideDeviceRestrictions
+	}
+	return false
+}
+
+// GetRequirePhonePassword returns the RequirePhonePassword field of x, or its zero value if it or x is nil.
+func (x *Event) GetRequirePhonePassword() bool {
+	if x != nil && x.RequirePhonePassword != nil {
+		return *x.RequirePhonePassword
+	}
+	return false
+}
+
+// GetConference returns the Conference field of x, or its zero value if it or x is nil.
+func (x *Event) GetConference() *Conference {
+	if x != nil {
+		return x.Conference
+	}
+	return nil
+}
+
+// GetProperties returns the Properties field of x, or its zero value if it or x is nil.
+func (x *Event) GetProperties() map[string]interface{} {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// GetAnnotations returns the Annotations field of x, or its zero value if it or x is nil.
+func (x *Event) GetAnnotations() []*EventAnnotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// GetResponseOptions returns the ResponseOptions field of x, or its zero value if it or x is nil.
+func (x *Event) GetResponseOptions() []*ResponseOption {
+	if x != nil {
+		return x.ResponseOptions
+	}
+	return nil
+}
+
+// GetRecipients returns the Recipients field of x, or its zero value if it or x is nil.
+func (x *Event) GetRecipients() []*RecipientReference {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+// GetTargetedRecipients returns the TargetedRecipients field of x, or its zero value if it or x is nil.
+func (x *Event) GetTargetedRecipients() []*RecipientReference {
+	if x != nil {
+		return x.TargetedRecipients
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *EventAnnotation) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetAuthor returns the Author field of x, or its zero value if it or x is nil.
+func (x *EventAnnotation) GetAuthor() *PersonReference {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+// GetComment returns the Comment field of x, or its zero value if it or x is nil.
+func (x *EventAnnotation) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// GetCreated returns the Created field of x, or its zero value if it or x is nil.
+func (x *EventAnnotation) GetCreated() Timestamp {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetRequestID returns the RequestID field of x, or its zero value if it or x is nil.
+func (x *EventFailedError) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *EventFailedError) GetStatus() EventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetEvent returns the Event field of x, or its zero value if it or x is nil.
+func (x *EventFailedError) GetEvent() Event {
+	if x != nil {
+		return x.Event
+	}
+	var zero Event
+	return zero
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *EventMetrics) GetFrom() Timestamp {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *EventMetrics) GetTo() Timestamp {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTotalEvents returns the TotalEvents field of x, or its zero value if it or x is nil.
+func (x *EventMetrics) GetTotalEvents() int64 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
+}
+
+// GetEventsByPriority returns the EventsByPriority field of x, or its zero value if it or x is nil.
+func (x *EventMetrics) GetEventsByPriority() map[string]int64 {
+	if x != nil {
+		return x.EventsByPriority
+	}
+	return nil
+}
+
+// GetEventsByStatus returns the EventsByStatus field of x, or its zero value if it or x is nil.
+func (x *EventMetrics) GetEventsByStatus() map[string]int64 {
+	if x != nil {
+		return x.EventsByStatus
+	}
+	return nil
+}
+
+// GetAcknowledgedEvents returns the AcknowledgedEvents field of x, or its zero value if it or x is nil.
+func (x *EventMetrics) GetAcknowledgedEvents() int64 {
+	if x != nil {
+		return x.AcknowledgedEvents
+	}
+	return 0
+}
+
+// GetMeanTimeToAcknowledgeSeconds returns the MeanTimeToAcknowledgeSeconds field of x, or its zero value if it or x is nil.
+func (x *EventMetrics) GetMeanTimeToAcknowledgeSeconds() float64 {
+	if x != nil {
+		return x.MeanTimeToAcknowledgeSeconds
+	}
+	return 0
+}
+
+// GetGroups returns the Groups field of x, or its zero value if it or x is nil.
+func (x *EventMetrics) GetGroups() []*GroupResponseRate {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *EventMetricsParams) GetFrom() Timestamp {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *EventMetricsParams) GetTo() Timestamp {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetEvents returns the Events field of x, or its zero value if it or x is nil.
+func (x *EventMetricsParams) GetEvents() GetEventsParams {
+	if x != nil {
+		return x.Events
+	}
+	var zero GetEventsParams
+	return zero
+}
+
+// GetEvents returns the Events field of x, or its zero value if it or x is nil.
+func (x *EventPagination) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *EventRecipient) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *EventRecipient) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+// GetRecipientType returns the RecipientType field of x, or its zero value if it or x is nil.
+func (x *EventRecipient) GetRecipientType() string {
+	if x != nil {
+		return x.RecipientType
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *EventReference) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetEventID returns the EventID field of x, or its zero value if it or x is nil.
+func (x *EventReference) GetEventID() string {
+	if x != nil && x.EventID != nil {
+		return *x.EventID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *EventReference) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *EventResponse) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetEvent returns the Event field of x, or its zero value if it or x is nil.
+func (x *EventResponse) GetEvent() *EventReference {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// GetPerson returns the Person field of x, or its zero value if it or x is nil.
+func (x *EventResponse) GetPerson() *PersonReference {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+// GetResponse returns the Response field of x, or its zero value if it or x is nil.
+func (x *EventResponse) GetResponse() *ResponseOption {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// GetComment returns the Comment field of x, or its zero value if it or x is nil.
+func (x *EventResponse) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// GetDevice returns the Device field of x, or its zero value if it or x is nil.
+func (x *EventResponse) GetDevice() *DeviceReference {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+// GetReceived returns the Received field of x, or its zero value if it or x is nil.
+func (x *EventResponse) GetReceived() Timestamp {
+	if x != nil && x.Received != nil {
+		return *x.Received
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetStartTime returns the StartTime field of x, or its zero value if it or x is nil.
+func (x *EventSchedule) GetStartTime() Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTimezone returns the Timezone field of x, or its zero value if it or x is nil.
+func (x *EventSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// GetEvent returns the Event field of x, or its zero value if it or x is nil.
+func (x *EventSuppression) GetEvent() *EventReference {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// GetMatch returns the Match field of x, or its zero value if it or x is nil.
+func (x *EventSuppression) GetMatch() *EventReference {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// GetIntegration returns the Integration field of x, or its zero value if it or x is nil.
+func (x *EventSuppression) GetIntegration() *IntegrationReference {
+	if x != nil {
+		return x.Integration
+	}
+	return nil
+}
+
+// GetAt returns the At field of x, or its zero value if it or x is nil.
+func (x *EventSuppression) GetAt() Timestamp {
+	if x != nil && x.At != nil {
+		return *x.At
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetIntegration returns the Integration field of x, or its zero value if it or x is nil.
+func (x *EventSuppressionCount) GetIntegration() string {
+	if x != nil {
+		return x.Integration
+	}
+	return ""
+}
+
+// GetDay returns the Day field of x, or its zero value if it or x is nil.
+func (x *EventSuppressionCount) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+// GetCount returns the Count field of x, or its zero value if it or x is nil.
+func (x *EventSuppressionCount) GetCount() int {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GetSuppressions returns the Suppressions field of x, or its zero value if it or x is nil.
+func (x *EventSuppressionPagination) GetSuppressions() []*EventSuppression {
+	if x != nil {
+		return x.Suppressions
+	}
+	return nil
+}
+
+// GetFormID returns the FormID field of x, or its zero value if it or x is nil.
+func (x *EventTemplate) GetFormID() string {
+	if x != nil {
+		return x.FormID
+	}
+	return ""
+}
+
+// GetPriority returns the Priority field of x, or its zero value if it or x is nil.
+func (x *EventTemplate) GetPriority() EventPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+// GetProperties returns the Properties field of x, or its zero value if it or x is nil.
+func (x *EventTemplate) GetProperties() map[string]string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// GetRecipients returns the Recipients field of x, or its zero value if it or x is nil.
+func (x *EventTemplate) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+// GetRequestID returns the RequestID field of x, or its zero value if it or x is nil.
+func (x *EventTrigger) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
+	}
+	return ""
+}
+
+// GetResource returns the Resource field of x, or its zero value if it or x is nil.
+func (x *ExportProgress) GetResource() SnapshotResource {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+// GetCount returns the Count field of x, or its zero value if it or x is nil.
+func (x *ExportProgress) GetCount() int {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GetRequestID returns the RequestID field of x, or its zero value if it or x is nil.
+func (x *FlowTrigger) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Form) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Form) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *Form) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetPlan returns the Plan field of x, or its zero value if it or x is nil.
+func (x *Form) GetPlan() *PlanReference {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// GetEnabled returns the Enabled field of x, or its zero value if it or x is nil.
+func (x *Form) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// GetWebEnabled returns the WebEnabled field of x, or its zero value if it or x is nil.
+func (x *Form) GetWebEnabled() bool {
+	if x != nil && x.WebEnabled != nil {
+		return *x.WebEnabled
+	}
+	return false
+}
+
+// GetAPIEnabled returns the APIEnabled field of x, or its zero value if it or x is nil.
+func (x *Form) GetAPIEnabled() bool {
+	if x != nil && x.APIEnabled != nil {
+		return *x.APIEnabled
+	}
+	return false
+}
+
+// GetMobileEnabled returns the MobileEnabled field of x, or its zero value if it or x is nil.
+func (x *Form) GetMobileEnabled() bool {
+	if x != nil && x.MobileEnabled != nil {
+		return *x.MobileEnabled
+	}
+	return false
+}
+
+// GetRecipients returns the Recipients field of x, or its zero value if it or x is nil.
+func (x *Form) GetRecipients() []*RecipientReference {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+// GetResponseOptions returns the ResponseOptions field of x, or its zero value if it or x is nil.
+func (x *Form) GetResponseOptions() []*ResponseOption {
+	if x != nil {
+		return x.ResponseOptions
+	}
+	return nil
+}
+
+// GetForms returns the Forms field of x, or its zero value if it or x is nil.
+func (x *FormPagination) GetForms() []*Form {
+	if x != nil {
+		return x.Forms
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *FormProperty) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *FormProperty) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *FormProperty) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetPropertyType returns the PropertyType field of x, or its zero value if it or x is nil.
+func (x *FormProperty) GetPropertyType() string {
+	if x != nil && x.PropertyType != nil {
+		return *x.PropertyType
+	}
+	return ""
+}
+
+// GetMinLength returns the MinLength field of x, or its zero value if it or x is nil.
+func (x *FormProperty) GetMinLength() int64 {
+	if x != nil && x.MinLength != nil {
+		return *x.MinLength
+	}
+	return 0
+}
+
+// GetMaxLength returns the MaxLength field of x, or its zero value if it or x is nil.
+func (x *FormProperty) GetMaxLength() int64 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
+	}
+	return 0
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *FormReference) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *FormReference) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *FormSection) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetType returns the Type field of x, or its zero value if it or x is nil.
+func (x *FormSection) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+// GetTitle returns the Title field of x, or its zero value if it or x is nil.
+func (x *FormSection) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+// GetProperties returns the Properties field of x, or its zero value if it or x is nil.
+func (x *FormSection) GetProperties() []*FormProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// GetEventID returns the EventID field of x, or its zero value if it or x is nil.
+func (x *GetAuditListParams) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+// GetAuditType returns the AuditType field of x, or its zero value if it or x is nil.
+func (x *GetAuditListParams) GetAuditType() string {
+	if x != nil {
+		return x.AuditType
+	}
+	return ""
+}
+
+// GetBy returns the By field of x, or its zero value if it or x is nil.
+func (x *GetAuditListParams) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *GetAuditListParams) GetFrom() Timestamp {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *GetAuditListParams) GetTo() Timestamp {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetSortOrder returns the SortOrder field of x, or its zero value if it or x is nil.
+func (x *GetAuditListParams) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+// GetEventID returns the EventID field of x, or its zero value if it or x is nil.
+func (x *GetAuditsParams) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+// GetAuditType returns the AuditType field of x, or its zero value if it or x is nil.
+func (x *GetAuditsParams) GetAuditType() string {
+	if x != nil {
+		return x.AuditType
+	}
+	return ""
+}
+
+// GetSortOrder returns the SortOrder field of x, or its zero value if it or x is nil.
+func (x *GetAuditsParams) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+// GetSearch returns the Search field of x, or its zero value if it or x is nil.
+func (x *GetChangeEventsParams) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+// GetServices returns the Services field of x, or its zero value if it or x is nil.
+func (x *GetChangeEventsParams) GetServices() string {
+	if x != nil {
+		return x.Services
+	}
+	return ""
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *GetChangeEventsParams) GetFrom() Timestamp {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *GetChangeEventsParams) GetTo() Timestamp {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetSortOrder returns the SortOrder field of x, or its zero value if it or x is nil.
+func (x *GetChangeEventsParams) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+// GetEmbed returns the Embed field of x, or its zero value if it or x is nil.
+func (x *GetDevicesParams) GetEmbed() string {
+	if x != nil {
+		return x.Embed
+	}
+	return ""
+}
+
+// GetDeviceStatus returns the DeviceStatus field of x, or its zero value if it or x is nil.
+func (x *GetDevicesParams) GetDeviceStatus() string {
+	if x != nil {
+		return x.DeviceStatus
+	}
+	return ""
+}
+
+// GetDeviceType returns the DeviceType field of x, or its zero value if it or x is nil.
+func (x *GetDevicesParams) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+// GetDeviceNames returns the DeviceNames field of x, or its zero value if it or x is nil.
+func (x *GetDevicesParams) GetDeviceNames() string {
+	if x != nil {
+		return x.DeviceNames
+	}
+	return ""
+}
+
+// GetExternalKey returns the ExternalKey field of x, or its zero value if it or x is nil.
+func (x *GetDevicesParams) GetExternalKey() string {
+	if x != nil {
+		return x.ExternalKey
+	}
+	return ""
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *GetEventSuppressionsParams) GetFrom() Timestamp {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *GetEventSuppressionsParams) GetTo() Timestamp {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetIntegration returns the Integration field of x, or its zero value if it or x is nil.
+func (x *GetEventSuppressionsParams) GetIntegration() string {
+	if x != nil {
+		return x.Integration
+	}
+	return ""
+}
+
+// GetSortOrder returns the SortOrder field of x, or its zero value if it or x is nil.
+func (x *GetEventSuppressionsParams) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+// GetEmbed returns the Embed field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetEmbed() string {
+	if x != nil {
+		return x.Embed
+	}
+	return ""
+}
+
+// GetSearch returns the Search field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetPriority returns the Priority field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetFrom() Timestamp {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetTo() Timestamp {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetRequestID returns the RequestID field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+// GetSubmitter returns the Submitter field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetSubmitter() string {
+	if x != nil {
+		return x.Submitter
+	}
+	return ""
+}
+
+// GetPlan returns the Plan field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+// GetForm returns the Form field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+// GetTargetedRecipients returns the TargetedRecipients field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetTargetedRecipients() string {
+	if x != nil {
+		return x.TargetedRecipients
+	}
+	return ""
+}
+
+// GetPropertyName returns the PropertyName field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetPropertyName() string {
+	if x != nil {
+		return x.PropertyName
+	}
+	return ""
+}
+
+// GetPropertyValue returns the PropertyValue field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetPropertyValue() string {
+	if x != nil {
+		return x.PropertyValue
+	}
+	return ""
+}
+
+// GetSortBy returns the SortBy field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+// GetSortOrder returns the SortOrder field of x, or its zero value if it or x is nil.
+func (x *GetEventsParams) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+// GetEmbed returns the Embed field of x, or its zero value if it or x is nil.
+func (x *GetFormsParams) GetEmbed() string {
+	if x != nil {
+		return x.Embed
+	}
+	return ""
+}
+
+// GetSearch returns the Search field of x, or its zero value if it or x is nil.
+func (x *GetFormsParams) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+// GetEnabled returns the Enabled field of x, or its zero value if it or x is nil.
+func (x *GetFormsParams) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// GetEmbed returns the Embed field of x, or its zero value if it or x is nil.
+func (x *GetGroupsParams) GetEmbed() string {
+	if x != nil {
+		return x.Embed
+	}
+	return ""
+}
+
+// GetGroupType returns the GroupType field of x, or its zero value if it or x is nil.
+func (x *GetGroupsParams) GetGroupType() string {
+	if x != nil {
+		return x.GroupType
+	}
+	return ""
+}
+
+// GetMemberExists returns the MemberExists field of x, or its zero value if it or x is nil.
+func (x *GetGroupsParams) GetMemberExists() string {
+	if x != nil {
+		return x.MemberExists
+	}
+	return ""
+}
+
+// GetMembers returns the Members field of x, or its zero value if it or x is nil.
+func (x *GetGroupsParams) GetMembers() string {
+	if x != nil {
+		return x.Members
+	}
+	return ""
+}
+
+// GetSites returns the Sites field of x, or its zero value if it or x is nil.
+func (x *GetGroupsParams) GetSites() string {
+	if x != nil {
+		return x.Sites
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *GetGroupsParams) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetSupervisors returns the Supervisors field of x, or its zero value if it or x is nil.
+func (x *GetGroupsParams) GetSupervisors() string {
+	if x != nil {
+		return x.Supervisors
+	}
+	return ""
+}
+
+// GetSortBy returns the SortBy field of x, or its zero value if it or x is nil.
+func (x *GetGroupsParams) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+// GetSortOrder returns the SortOrder field of x, or its zero value if it or x is nil.
+func (x *GetGroupsParams) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+// GetSearch returns the Search field of x, or its zero value if it or x is nil.
+func (x *GetIncidentsParams) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *GetIncidentsParams) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetSeverity returns the Severity field of x, or its zero value if it or x is nil.
+func (x *GetIncidentsParams) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+// GetImpactedServices returns the ImpactedServices field of x, or its zero value if it or x is nil.
+func (x *GetIncidentsParams) GetImpactedServices() string {
+	if x != nil {
+		return x.ImpactedServices
+	}
+	return ""
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *GetIncidentsParams) GetFrom() Timestamp {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *GetIncidentsParams) GetTo() Timestamp {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetSortBy returns the SortBy field of x, or its zero value if it or x is nil.
+func (x *GetIncidentsParams) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+// GetSortOrder returns the SortOrder field of x, or its zero value if it or x is nil.
+func (x *GetIncidentsParams) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *GetIntegrationLogsParams) GetFrom() Timestamp {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *GetIntegrationLogsParams) GetTo() Timestamp {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *GetIntegrationLogsParams) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetRequestID returns the RequestID field of x, or its zero value if it or x is nil.
+func (x *GetIntegrationLogsParams) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+// GetSortOrder returns the SortOrder field of x, or its zero value if it or x is nil.
+func (x *GetIntegrationLogsParams) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+// GetGroups returns the Groups field of x, or its zero value if it or x is nil.
+func (x *GetOnCallParams) GetGroups() string {
+	if x != nil {
+		return x.Groups
+	}
+	return ""
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *GetOnCallParams) GetFrom() Timestamp {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *GetOnCallParams) GetTo() Timestamp {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetAt returns the At field of x, or its zero value if it or x is nil.
+func (x *GetOnCallParams) GetAt() Timestamp {
+	if x != nil && x.At != nil {
+		return *x.At
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetMembersPerShift returns the MembersPerShift field of x, or its zero value if it or x is nil.
+func (x *GetOnCallParams) GetMembersPerShift() int64 {
+	if x != nil {
+		return x.MembersPerShift
+	}
+	return 0
+}
+
+// GetEmbed returns the Embed field of x, or its zero value if it or x is nil.
+func (x *GetOnCallParams) GetEmbed() string {
+	if x != nil {
+		return x.Embed
+	}
+	return ""
+}
+
+// GetEmbed returns the Embed field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetEmbed() string {
+	if x != nil {
+		return x.Embed
+	}
+	return ""
+}
+
+// GetCreatedAfter returns the CreatedAfter field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetCreatedAfter() Timestamp {
+	if x != nil && x.CreatedAfter != nil {
+		return *x.CreatedAfter
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetCreatedBefore returns the CreatedBefore field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetCreatedBefore() Timestamp {
+	if x != nil && x.CreatedBefore != nil {
+		return *x.CreatedBefore
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetCreatedFrom returns the CreatedFrom field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetCreatedFrom() Timestamp {
+	if x != nil && x.CreatedFrom != nil {
+		return *x.CreatedFrom
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetCreatedTo returns the CreatedTo field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetCreatedTo() Timestamp {
+	if x != nil && x.CreatedTo != nil {
+		return *x.CreatedTo
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetDevicesExists returns the DevicesExists field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetDevicesExists() bool {
+	if x != nil && x.DevicesExists != nil {
+		return *x.DevicesExists
+	}
+	return false
+}
+
+// GetDevicesEmailExists returns the DevicesEmailExists field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetDevicesEmailExists() bool {
+	if x != nil && x.DevicesEmailExists != nil {
+		return *x.DevicesEmailExists
+	}
+	return false
+}
+
+// GetDevicesFailsafe returns the DevicesFailsafe field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetDevicesFailsafe() bool {
+	if x != nil && x.DevicesFailsafe != nil {
+		return *x.DevicesFailsafe
+	}
+	return false
+}
+
+// GetDevicesMobile returns the DevicesMobile field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetDevicesMobile() bool {
+	if x != nil && x.DevicesMobile != nil {
+		return *x.DevicesMobile
+	}
+	return false
+}
+
+// GetDevicesSMS returns the DevicesSMS field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetDevicesSMS() bool {
+	if x != nil && x.DevicesSMS != nil {
+		return *x.DevicesSMS
+	}
+	return false
+}
+
+// GetDevicesVoice returns the DevicesVoice field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetDevicesVoice() bool {
+	if x != nil && x.DevicesVoice != nil {
+		return *x.DevicesVoice
+	}
+	return false
+}
+
+// GetDevicesStatus returns the DevicesStatus field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetDevicesStatus() string {
+	if x != nil {
+		return x.DevicesStatus
+	}
+	return ""
+}
+
+// GetDevicesTestStatus returns the DevicesTestStatus field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetDevicesTestStatus() string {
+	if x != nil {
+		return x.DevicesTestStatus
+	}
+	return ""
+}
+
+// GetEmailAddress returns the EmailAddress field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+// GetFirstName returns the FirstName field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+// GetGroups returns the Groups field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetGroups() string {
+	if x != nil {
+		return x.Groups
+	}
+	return ""
+}
+
+// GetGroupsExists returns the GroupsExists field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetGroupsExists() bool {
+	if x != nil && x.GroupsExists != nil {
+		return *x.GroupsExists
+	}
+	return false
+}
+
+// GetLastName returns the LastName field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+// GetLicenseType returns the LicenseType field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetLicenseType() string {
+	if x != nil {
+		return x.LicenseType
+	}
+	return ""
+}
+
+// GetPhoneNumber returns the PhoneNumber field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+// GetRoles returns the Roles field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetRoles() string {
+	if x != nil {
+		return x.Roles
+	}
+	return ""
+}
+
+// GetSite returns the Site field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetSupervisors returns the Supervisors field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetSupervisors() string {
+	if x != nil {
+		return x.Supervisors
+	}
+	return ""
+}
+
+// GetSupervisorsExists returns the SupervisorsExists field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetSupervisorsExists() bool {
+	if x != nil && x.SupervisorsExists != nil {
+		return *x.SupervisorsExists
+	}
+	return false
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+// GetWebLogin returns the WebLogin field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetWebLogin() string {
+	if x != nil {
+		return x.WebLogin
+	}
+	return ""
+}
+
+// GetExternalKey returns the ExternalKey field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetExternalKey() string {
+	if x != nil {
+		return x.ExternalKey
+	}
+	return ""
+}
+
+// GetSortBy returns the SortBy field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+// GetSortOrder returns the SortOrder field of x, or its zero value if it or x is nil.
+func (x *GetPeopleParams) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+// GetSearch returns the Search field of x, or its zero value if it or x is nil.
+func (x *GetPlansParams) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+// GetPlanType returns the PlanType field of x, or its zero value if it or x is nil.
+func (x *GetPlansParams) GetPlanType() string {
+	if x != nil {
+		return x.PlanType
+	}
+	return ""
+}
+
+// GetEnabled returns the Enabled field of x, or its zero value if it or x is nil.
+func (x *GetPlansParams) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// GetServices returns the Services field of x, or its zero value if it or x is nil.
+func (x *GetServiceDependenciesParams) GetServices() string {
+	if x != nil {
+		return x.Services
+	}
+	return ""
+}
+
+// GetEmbed returns the Embed field of x, or its zero value if it or x is nil.
+func (x *GetServicesParams) GetEmbed() string {
+	if x != nil {
+		return x.Embed
+	}
+	return ""
+}
+
+// GetOwnedBy returns the OwnedBy field of x, or its zero value if it or x is nil.
+func (x *GetServicesParams) GetOwnedBy() string {
+	if x != nil {
+		return x.OwnedBy
+	}
+	return ""
+}
+
+// GetCountry returns the Country field of x, or its zero value if it or x is nil.
+func (x *GetSitesParams) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// GetGeocoded returns the Geocoded field of x, or its zero value if it or x is nil.
+func (x *GetSitesParams) GetGeocoded() bool {
+	if x != nil && x.Geocoded != nil {
+		return *x.Geocoded
+	}
+	return false
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *GetSitesParams) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetSearch returns the Search field of x, or its zero value if it or x is nil.
+func (x *GetSubscriptionsParams) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+// GetForm returns the Form field of x, or its zero value if it or x is nil.
+func (x *GetSubscriptionsParams) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+// GetOwner returns the Owner field of x, or its zero value if it or x is nil.
+func (x *GetSubscriptionsParams) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// GetMembers returns the Members field of x, or its zero value if it or x is nil.
+func (x *GetTemporaryAbsencesParams) GetMembers() string {
+	if x != nil {
+		return x.Members
+	}
+	return ""
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *GetTemporaryAbsencesParams) GetFrom() Timestamp {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *GetTemporaryAbsencesParams) GetTo() Timestamp {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetEmbed returns the Embed field of x, or its zero value if it or x is nil.
+func (x *GetUserDeliveriesParams) GetEmbed() string {
+	if x != nil {
+		return x.Embed
+	}
+	return ""
+}
+
+// GetDeliveryStatus returns the DeliveryStatus field of x, or its zero value if it or x is nil.
+func (x *GetUserDeliveriesParams) GetDeliveryStatus() string {
+	if x != nil {
+		return x.DeliveryStatus
+	}
+	return ""
+}
+
+// GetResponded returns the Responded field of x, or its zero value if it or x is nil.
+func (x *GetUserDeliveriesParams) GetResponded() bool {
+	if x != nil && x.Responded != nil {
+		return *x.Responded
+	}
+	return false
+}
+
+// GetAt returns the At field of x, or its zero value if it or x is nil.
+func (x *GetUserDeliveriesParams) GetAt() Timestamp {
+	if x != nil && x.At != nil {
+		return *x.At
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Group) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *Group) GetTargetName() string {
+	if x != nil && x.TargetName != nil {
+		return *x.TargetName
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *Group) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *Group) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetGroupType returns the GroupType field of x, or its zero value if it or x is nil.
+func (x *Group) GetGroupType() string {
+	if x != nil && x.GroupType != nil {
+		return *x.GroupType
+	}
+	return ""
+}
+
+// GetAllowDuplicates returns the AllowDuplicates field of x, or its zero value if it or x is nil.
+func (x *Group) GetAllowDuplicates() bool {
+	if x != nil && x.AllowDuplicates != nil {
+		return *x.AllowDuplicates
+	}
+	return false
+}
+
+// GetTimezone returns the Timezone field of x, or its zero value if it or x is nil.
+func (x *Group) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+// GetSite returns the Site field of x, or its zero value if it or x is nil.
+func (x *Group) GetSite() *ReferenceById {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+// GetObservedByAll returns the ObservedByAll field of x, or its zero value if it or x is nil.
+func (x *Group) GetObservedByAll() bool {
+	if x != nil && x.ObservedByAll != nil {
+		return *x.ObservedByAll
+	}
+	return false
+}
+
+// GetObservers returns the Observers field of x, or its zero value if it or x is nil.
+func (x *Group) GetObservers() []*ReferenceByName {
+	if x != nil {
+		return x.Observers
+	}
+	return nil
+}
+
+// GetUseDefaultDevices returns the UseDefaultDevices field of x, or its zero value if it or x is nil.
+func (x *Group) GetUseDefaultDevices() bool {
+	if x != nil && x.UseDefaultDevices != nil {
+		return *x.UseDefaultDevices
+	}
+	return false
+}
+
+// GetSupervisors returns the Supervisors field of x, or its zero value if it or x is nil.
+func (x *Group) GetSupervisors() []*ReferenceById {
+	if x != nil {
+		return x.Supervisors
+	}
+	return nil
+}
+
+// GetServices returns the Services field of x, or its zero value if it or x is nil.
+func (x *Group) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// GetExternalKey returns the ExternalKey field of x, or its zero value if it or x is nil.
+func (x *Group) GetExternalKey() string {
+	if x != nil && x.ExternalKey != nil {
+		return *x.ExternalKey
+	}
+	return ""
+}
+
+// GetExternallyOwned returns the ExternallyOwned field of x, or its zero value if it or x is nil.
+func (x *Group) GetExternallyOwned() bool {
+	if x != nil && x.ExternallyOwned != nil {
+		return *x.ExternallyOwned
+	}
+	return false
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *GroupMember) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetMemberType returns the MemberType field of x, or its zero value if it or x is nil.
+func (x *GroupMember) GetMemberType() string {
+	if x != nil && x.MemberType != nil {
+		return *x.MemberType
+	}
+	return ""
+}
+
+// GetGroup returns the Group field of x, or its zero value if it or x is nil.
+func (x *GroupMembership) GetGroup() GroupReference {
+	if x != nil {
+		return x.Group
+	}
+	var zero GroupReference
+	return zero
+}
+
+// GetMember returns the Member field of x, or its zero value if it or x is nil.
+func (x *GroupMembership) GetMember() RecipientReference {
+	if x != nil {
+		return x.Member
+	}
+	var zero RecipientReference
+	return zero
+}
+
+// GetShifts returns the Shifts field of x, or its zero value if it or x is nil.
+func (x *GroupMembership) GetShifts() ShiftPagination {
+	if x != nil {
+		return x.Shifts
+	}
+	var zero ShiftPagination
+	return zero
+}
+
+// GetMemberships returns the Memberships field of x, or its zero value if it or x is nil.
+func (x *GroupMembershipPagination) GetMemberships() []*GroupMembership {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+// GetGroups returns the Groups field of x, or its zero value if it or x is nil.
+func (x *GroupPagination) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *GroupReference) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *GroupReference) GetTargetName() string {
+	if x != nil && x.TargetName != nil {
+		return *x.TargetName
+	}
+	return ""
+}
+
+// GetRecipientType returns the RecipientType field of x, or its zero value if it or x is nil.
+func (x *GroupReference) GetRecipientType() string {
+	if x != nil && x.RecipientType != nil {
+		return *x.RecipientType
+	}
+	return ""
+}
+
+// GetGroupType returns the GroupType field of x, or its zero value if it or x is nil.
+func (x *GroupReference) GetGroupType() string {
+	if x != nil && x.GroupType != nil {
+		return *x.GroupType
+	}
+	return ""
+}
+
+// GetGroup returns the Group field of x, or its zero value if it or x is nil.
+func (x *GroupResponseRate) GetGroup() *RecipientReference {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// GetTargetedEvents returns the TargetedEvents field of x, or its zero value if it or x is nil.
+func (x *GroupResponseRate) GetTargetedEvents() int64 {
+	if x != nil {
+		return x.TargetedEvents
+	}
+	return 0
+}
+
+// GetAcknowledgedEvents returns the AcknowledgedEvents field of x, or its zero value if it or x is nil.
+func (x *GroupResponseRate) GetAcknowledgedEvents() int64 {
+	if x != nil {
+		return x.AcknowledgedEvents
+	}
+	return 0
+}
+
+// GetResponseRate returns the ResponseRate field of x, or its zero value if it or x is nil.
+func (x *GroupResponseRate) GetResponseRate() float64 {
+	if x != nil {
+		return x.ResponseRate
+	}
+	return 0
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *GroupRoster) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetGroup returns the Group field of x, or its zero value if it or x is nil.
+func (x *GroupRoster) GetGroup() *GroupReference {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// GetMembers returns the Members field of x, or its zero value if it or x is nil.
+func (x *GroupRoster) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetDate returns the Date field of x, or its zero value if it or x is nil.
+func (x *Holiday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// GetSites returns the Sites field of x, or its zero value if it or x is nil.
+func (x *Holiday) GetSites() []string {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+// GetTimeZone returns the TimeZone field of x, or its zero value if it or x is nil.
+func (x *HolidayCalendar) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// GetHolidays returns the Holidays field of x, or its zero value if it or x is nil.
+func (x *HolidayCalendar) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+// GetType returns the Type field of x, or its zero value if it or x is nil.
+func (x *HygieneFinding) GetType() HygieneFindingType {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *HygieneFinding) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *HygieneFinding) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+// GetDetail returns the Detail field of x, or its zero value if it or x is nil.
+func (x *HygieneFinding) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// GetStaleLoginDays returns the StaleLoginDays field of x, or its zero value if it or x is nil.
+func (x *HygieneReportParams) GetStaleLoginDays() int {
+	if x != nil {
+		return x.StaleLoginDays
+	}
+	return 0
+}
+
+// GetIncludeNeverLoggedIn returns the IncludeNeverLoggedIn field of x, or its zero value if it or x is nil.
+func (x *HygieneReportParams) GetIncludeNeverLoggedIn() bool {
+	if x != nil {
+		return x.IncludeNeverLoggedIn
+	}
+	return false
+}
+
+// GetResource returns the Resource field of x, or its zero value if it or x is nil.
+func (x *ImportChange) GetResource() SnapshotResource {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+// GetKey returns the Key field of x, or its zero value if it or x is nil.
+func (x *ImportChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// GetAction returns the Action field of x, or its zero value if it or x is nil.
+func (x *ImportChange) GetAction() ImportAction {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// GetChanges returns the Changes field of x, or its zero value if it or x is nil.
+func (x *ImportReport) GetChanges() []*ImportChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// GetErrors returns the Errors field of x, or its zero value if it or x is nil.
+func (x *ImportReport) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// GetSkipped returns the Skipped field of x, or its zero value if it or x is nil.
+func (x *ImportReport) GetSkipped() []SnapshotResource {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+// GetDryRun returns the DryRun field of x, or its zero value if it or x is nil.
+func (x *ImportServiceCatalogParams) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// GetPrune returns the Prune field of x, or its zero value if it or x is nil.
+func (x *ImportServiceCatalogParams) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+// GetType returns the Type field of x, or its zero value if it or x is nil.
+func (x *ImportedResource) GetType() ImportType {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *ImportedResource) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetObject returns the Object field of x, or its zero value if it or x is nil.
+func (x *ImportedResource) GetObject() interface{} {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Incident) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetIncidentIdentifier returns the IncidentIdentifier field of x, or its zero value if it or x is nil.
+func (x *Incident) GetIncidentIdentifier() string {
+	if x != nil && x.IncidentIdentifier != nil {
+		return *x.IncidentIdentifier
+	}
+	return ""
+}
+
+// GetSummary returns the Summary field of x, or its zero value if it or x is nil.
+func (x *Incident) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *Incident) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetSeverity returns the Severity field of x, or its zero value if it or x is nil.
+func (x *Incident) GetSeverity() IncidentSeverity {
+	if x != nil && x.Severity != nil {
+		return *x.Severity
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *Incident) GetStatus() IncidentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// GetCreated returns the Created field of x, or its zero value if it or x is nil.
+func (x *Incident) GetCreated() Timestamp {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetUpdated returns the Updated field of x, or its zero value if it or x is nil.
+func (x *Incident) GetUpdated() Timestamp {
+	if x != nil && x.Updated != nil {
+		return *x.Updated
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetImpactedServices returns the ImpactedServices field of x, or its zero value if it or x is nil.
+func (x *Incident) GetImpactedServices() []*ServiceReference {
+	if x != nil {
+		return x.ImpactedServices
+	}
+	return nil
+}
+
+// GetRequestID returns the RequestID field of x, or its zero value if it or x is nil.
+func (x *IncidentEngagement) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
+	}
+	return ""
+}
+
+// GetIncidents returns the Incidents field of x, or its zero value if it or x is nil.
+func (x *IncidentPagination) GetIncidents() []*Incident {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+// GetPerson returns the Person field of x, or its zero value if it or x is nil.
+func (x *IncidentResolver) GetPerson() *PersonReference {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+// GetRole returns the Role field of x, or its zero value if it or x is nil.
+func (x *IncidentResolver) GetRole() IncidentRole {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return ""
+}
+
+// GetAddedAt returns the AddedAt field of x, or its zero value if it or x is nil.
+func (x *IncidentResolver) GetAddedAt() Timestamp {
+	if x != nil && x.AddedAt != nil {
+		return *x.AddedAt
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetResolvers returns the Resolvers field of x, or its zero value if it or x is nil.
+func (x *IncidentResolverPagination) GetResolvers() []*IncidentResolver {
+	if x != nil {
+		return x.Resolvers
+	}
+	return nil
+}
+
+// GetInstance returns the Instance field of x, or its zero value if it or x is nil.
+func (x *InstanceHostname) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+// GetEnvironment returns the Environment field of x, or its zero value if it or x is nil.
+func (x *InstanceHostname) GetEnvironment() Environment {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+// GetRegion returns the Region field of x, or its zero value if it or x is nil.
+func (x *InstanceHostname) GetRegion() Region {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Integration) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Integration) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetPlan returns the Plan field of x, or its zero value if it or x is nil.
+func (x *Integration) GetPlan() *PlanReference {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// GetIntegrationType returns the IntegrationType field of x, or its zero value if it or x is nil.
+func (x *Integration) GetIntegrationType() string {
+	if x != nil && x.IntegrationType != nil {
+		return *x.IntegrationType
+	}
+	return ""
+}
+
+// GetOperation returns the Operation field of x, or its zero value if it or x is nil.
+func (x *Integration) GetOperation() string {
+	if x != nil && x.Operation != nil {
+		return *x.Operation
+	}
+	return ""
+}
+
+// GetForm returns the Form field of x, or its zero value if it or x is nil.
+func (x *Integration) GetForm() *FormReference {
+	if x != nil {
+		return x.Form
+	}
+	return nil
+}
+
+// GetEnabled returns the Enabled field of x, or its zero value if it or x is nil.
+func (x *Integration) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// GetDeployed returns the Deployed field of x, or its zero value if it or x is nil.
+func (x *Integration) GetDeployed() bool {
+	if x != nil && x.Deployed != nil {
+		return *x.Deployed
+	}
+	return false
+}
+
+// GetAuthenticationMethod returns the AuthenticationMethod field of x, or its zero value if it or x is nil.
+func (x *Integration) GetAuthenticationMethod() string {
+	if x != nil && x.AuthenticationMethod != nil {
+		return *x.AuthenticationMethod
+	}
+	return ""
+}
+
+// GetScript returns the Script field of x, or its zero value if it or x is nil.
+func (x *Integration) GetScript() string {
+	if x != nil && x.Script != nil {
+		return *x.Script
+	}
+	return ""
+}
+
+// GetCreated returns the Created field of x, or its zero value if it or x is nil.
+func (x *Integration) GetCreated() Timestamp {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *IntegrationLog) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetRequestID returns the RequestID field of x, or its zero value if it or x is nil.
+func (x *IntegrationLog) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *IntegrationLog) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// GetCreated returns the Created field of x, or its zero value if it or x is nil.
+func (x *IntegrationLog) GetCreated() Timestamp {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetCompleted returns the Completed field of x, or its zero value if it or x is nil.
+func (x *IntegrationLog) GetCompleted() Timestamp {
+	if x != nil && x.Completed != nil {
+		return *x.Completed
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetRequest returns the Request field of x, or its zero value if it or x is nil.
+func (x *IntegrationLog) GetRequest() string {
+	if x != nil && x.Request != nil {
+		return *x.Request
+	}
+	return ""
+}
+
+// GetLog returns the Log field of x, or its zero value if it or x is nil.
+func (x *IntegrationLog) GetLog() string {
+	if x != nil && x.Log != nil {
+		return *x.Log
+	}
+	return ""
+}
+
+// GetLogs returns the Logs field of x, or its zero value if it or x is nil.
+func (x *IntegrationLogPagination) GetLogs() []*IntegrationLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// GetIntegrations returns the Integrations field of x, or its zero value if it or x is nil.
+func (x *IntegrationPagination) GetIntegrations() []*Integration {
+	if x != nil {
+		return x.Integrations
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *IntegrationReference) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *IntegrationReference) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetGroup returns the Group field of x, or its zero value if it or x is nil.
+func (x *OnCall) GetGroup() *GroupReference {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// GetShift returns the Shift field of x, or its zero value if it or x is nil.
+func (x *OnCall) GetShift() *ShiftReference {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+// GetStart returns the Start field of x, or its zero value if it or x is nil.
+func (x *OnCall) GetStart() Timestamp {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetEnd returns the End field of x, or its zero value if it or x is nil.
+func (x *OnCall) GetEnd() Timestamp {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetMembers returns the Members field of x, or its zero value if it or x is nil.
+func (x *OnCall) GetMembers() []*OnCallMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// GetGroup returns the Group field of x, or its zero value if it or x is nil.
+func (x *OnCallCalendarParams) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *OnCallCalendarParams) GetFrom() time.Time {
+	if x != nil {
+		return x.From
+	}
+	var zero time.Time
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *OnCallCalendarParams) GetTo() time.Time {
+	if x != nil {
+		return x.To
+	}
+	var zero time.Time
+	return zero
+}
+
+// GetMembers returns the Members field of x, or its zero value if it or x is nil.
+func (x *OnCallCalendarParams) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *OnCallCalendarParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetPosition returns the Position field of x, or its zero value if it or x is nil.
+func (x *OnCallMember) GetPosition() int64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+// GetDelay returns the Delay field of x, or its zero value if it or x is nil.
+func (x *OnCallMember) GetDelay() int64 {
+	if x != nil && x.Delay != nil {
+		return *x.Delay
+	}
+	return 0
+}
+
+// GetEscalationType returns the EscalationType field of x, or its zero value if it or x is nil.
+func (x *OnCallMember) GetEscalationType() string {
+	if x != nil && x.EscalationType != nil {
+		return *x.EscalationType
+	}
+	return ""
+}
+
+// GetMember returns the Member field of x, or its zero value if it or x is nil.
+func (x *OnCallMember) GetMember() *RecipientReference {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// GetMembers returns the Members field of x, or its zero value if it or x is nil.
+func (x *OnCallMemberPagination) GetMembers() []*OnCallMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// GetOnCalls returns the OnCalls field of x, or its zero value if it or x is nil.
+func (x *OnCallPagination) GetOnCalls() []*OnCall {
+	if x != nil {
+		return x.OnCalls
+	}
+	return nil
+}
+
+// GetGeneratedAt returns the GeneratedAt field of x, or its zero value if it or x is nil.
+func (x *OnCallReport) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+// GetRows returns the Rows field of x, or its zero value if it or x is nil.
+func (x *OnCallReport) GetRows() []*OnCallReportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// GetGroups returns the Groups field of x, or its zero value if it or x is nil.
+func (x *OnCallReportParams) GetGroups() GetGroupsParams {
+	if x != nil {
+		return x.Groups
+	}
+	var zero GetGroupsParams
+	return zero
+}
+
+// GetAt returns the At field of x, or its zero value if it or x is nil.
+func (x *OnCallReportParams) GetAt() time.Time {
+	if x != nil {
+		return x.At
+	}
+	var zero time.Time
+	return zero
+}
+
+// GetLookahead returns the Lookahead field of x, or its zero value if it or x is nil.
+func (x *OnCallReportParams) GetLookahead() time.Duration {
+	if x != nil {
+		return x.Lookahead
+	}
+	var zero time.Duration
+	return zero
+}
+
+// GetGroup returns the Group field of x, or its zero value if it or x is nil.
+func (x *OnCallReportRow) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// GetShift returns the Shift field of x, or its zero value if it or x is nil.
+func (x *OnCallReportRow) GetShift() string {
+	if x != nil {
+		return x.Shift
+	}
+	return ""
+}
+
+// GetPosition returns the Position field of x, or its zero value if it or x is nil.
+func (x *OnCallReportRow) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// GetCurrent returns the Current field of x, or its zero value if it or x is nil.
+func (x *OnCallReportRow) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+// GetCurrentUntil returns the CurrentUntil field of x, or its zero value if it or x is nil.
+func (x *OnCallReportRow) GetCurrentUntil() string {
+	if x != nil {
+		return x.CurrentUntil
+	}
+	return ""
+}
+
+// GetReplacing returns the Replacing field of x, or its zero value if it or x is nil.
+func (x *OnCallReportRow) GetReplacing() string {
+	if x != nil {
+		return x.Replacing
+	}
+	return ""
+}
+
+// GetNext returns the Next field of x, or its zero value if it or x is nil.
+func (x *OnCallReportRow) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+// GetNextFrom returns the NextFrom field of x, or its zero value if it or x is nil.
+func (x *OnCallReportRow) GetNextFrom() string {
+	if x != nil {
+		return x.NextFrom
+	}
+	return ""
+}
+
+// GetDevices returns the Devices field of x, or its zero value if it or x is nil.
+func (x *OnCallReportRow) GetDevices() []string {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+// GetCount returns the Count field of x, or its zero value if it or x is nil.
+func (x *Pagination) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+// GetTotal returns the Total field of x, or its zero value if it or x is nil.
+func (x *Pagination) GetTotal() int64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+// GetLinks returns the Links field of x, or its zero value if it or x is nil.
+func (x *Pagination) GetLinks() *PaginationLinks {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// GetNext returns the Next field of x, or its zero value if it or x is nil.
+func (x *PaginationLinks) GetNext() string {
+	if x != nil && x.Next != nil {
+		return *x.Next
+	}
+	return ""
+}
+
+// GetPrevious returns the Previous field of x, or its zero value if it or x is nil.
+func (x *PaginationLinks) GetPrevious() string {
+	if x != nil && x.Previous != nil {
+		return *x.Previous
+	}
+	return ""
+}
+
+// GetSelf returns the Self field of x, or its zero value if it or x is nil.
+func (x *PaginationLinks) GetSelf() string {
+	if x != nil && x.Self != nil {
+		return *x.Self
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Person) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *Person) GetTargetName() string {
+	if x != nil && x.TargetName != nil {
+		return *x.TargetName
+	}
+	return ""
+}
+
+// GetFirstName returns the FirstName field of x, or its zero value if it or x is nil.
+func (x *Person) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+// GetLastName returns the LastName field of x, or its zero value if it or x is nil.
+func (x *Person) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+// GetRoles returns the Roles field of x, or its zero value if it or x is nil.
+func (x *Person) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *Person) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// GetWebLogin returns the WebLogin field of x, or its zero value if it or x is nil.
+func (x *Person) GetWebLogin() string {
+	if x != nil && x.WebLogin != nil {
+		return *x.WebLogin
+	}
+	return ""
+}
+
+// GetSite returns the Site field of x, or its zero value if it or x is nil.
+func (x *Person) GetSite() *ReferenceById {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+// GetTimezone returns the Timezone field of x, or its zero value if it or x is nil.
+func (x *Person) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+// GetLanguage returns the Language field of x, or its zero value if it or x is nil.
+func (x *Person) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+// GetSupervisors returns the Supervisors field of x, or its zero value if it or x is nil.
+func (x *Person) GetSupervisors() []*Person {
+	if x != nil {
+		return x.Supervisors
+	}
+	return nil
+}
+
+// GetPhoneLogin returns the PhoneLogin field of x, or its zero value if it or x is nil.
+func (x *Person) GetPhoneLogin() string {
+	if x != nil && x.PhoneLogin != nil {
+		return *x.PhoneLogin
+	}
+	return ""
+}
+
+// GetLicenseType returns the LicenseType field of x, or its zero value if it or x is nil.
+func (x *Person) GetLicenseType() string {
+	if x != nil && x.LicenseType != nil {
+		return *x.LicenseType
+	}
+	return ""
+}
+
+// GetExternalKey returns the ExternalKey field of x, or its zero value if it or x is nil.
+func (x *Person) GetExternalKey() string {
+	if x != nil && x.ExternalKey != nil {
+		return *x.ExternalKey
+	}
+	return ""
+}
+
+// GetExternallyOwned returns the ExternallyOwned field of x, or its zero value if it or x is nil.
+func (x *Person) GetExternallyOwned() bool {
+	if x != nil && x.ExternallyOwned != nil {
+		return *x.ExternallyOwned
+	}
+	return false
+}
+
+// GetLastLogin returns the LastLogin field of x, or its zero value if it or x is nil.
+func (x *Person) GetLastLogin() Timestamp {
+	if x != nil && x.LastLogin != nil {
+		return *x.LastLogin
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetPeople returns the People field of x, or its zero value if it or x is nil.
+func (x *PersonPagination) GetPeople() []*Person {
+	if x != nil {
+		return x.People
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PersonReference) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *PersonReference) GetTargetName() string {
+	if x != nil && x.TargetName != nil {
+		return *x.TargetName
+	}
+	return ""
+}
+
+// GetFirstName returns the FirstName field of x, or its zero value if it or x is nil.
+func (x *PersonReference) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+// GetLastName returns the LastName field of x, or its zero value if it or x is nil.
+func (x *PersonReference) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Plan) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Plan) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *Plan) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetPlanType returns the PlanType field of x, or its zero value if it or x is nil.
+func (x *Plan) GetPlanType() string {
+	if x != nil && x.PlanType != nil {
+		return *x.PlanType
+	}
+	return ""
+}
+
+// GetEnabled returns the Enabled field of x, or its zero value if it or x is nil.
+func (x *Plan) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// GetEditable returns the Editable field of x, or its zero value if it or x is nil.
+func (x *Plan) GetEditable() bool {
+	if x != nil && x.Editable != nil {
+		return *x.Editable
+	}
+	return false
+}
+
+// GetAccessibleByAll returns the AccessibleByAll field of x, or its zero value if it or x is nil.
+func (x *Plan) GetAccessibleByAll() bool {
+	if x != nil && x.AccessibleByAll != nil {
+		return *x.AccessibleByAll
+	}
+	return false
+}
+
+// GetFloodControl returns the FloodControl field of x, or its zero value if it or x is nil.
+func (x *Plan) GetFloodControl() bool {
+	if x != nil && x.FloodControl != nil {
+		return *x.FloodControl
+	}
+	return false
+}
+
+// GetCreator returns the Creator field of x, or its zero value if it or x is nil.
+func (x *Plan) GetCreator() *PersonReference {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+// GetCreated returns the Created field of x, or its zero value if it or x is nil.
+func (x *Plan) GetCreated() Timestamp {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetPlans returns the Plans field of x, or its zero value if it or x is nil.
+func (x *PlanPagination) GetPlans() []*Plan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PlanReference) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *PlanReference) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetSummary returns the Summary field of x, or its zero value if it or x is nil.
+func (x *PostChangeEventParams) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+// GetServices returns the Services field of x, or its zero value if it or x is nil.
+func (x *PostChangeEventParams) GetServices() []*ReferenceById {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *PostChangeEventParams) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetChangeType returns the ChangeType field of x, or its zero value if it or x is nil.
+func (x *PostChangeEventParams) GetChangeType() string {
+	if x != nil && x.ChangeType != nil {
+		return *x.ChangeType
+	}
+	return ""
+}
+
+// GetSource returns the Source field of x, or its zero value if it or x is nil.
+func (x *PostChangeEventParams) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return ""
+}
+
+// GetExternalURL returns the ExternalURL field of x, or its zero value if it or x is nil.
+func (x *PostChangeEventParams) GetExternalURL() string {
+	if x != nil && x.ExternalURL != nil {
+		return *x.ExternalURL
+	}
+	return ""
+}
+
+// GetProperties returns the Properties field of x, or its zero value if it or x is nil.
+func (x *PostChangeEventParams) GetProperties() map[string]interface{} {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// GetOccurred returns the Occurred field of x, or its zero value if it or x is nil.
+func (x *PostChangeEventParams) GetOccurred() Timestamp {
+	if x != nil && x.Occurred != nil {
+		return *x.Occurred
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetOperation returns the Operation field of x, or its zero value if it or x is nil.
+func (x *Progress) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+// GetDone returns the Done field of x, or its zero value if it or x is nil.
+func (x *Progress) GetDone() int {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+// GetTotal returns the Total field of x, or its zero value if it or x is nil.
+func (x *Progress) GetTotal() int {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetPage returns the Page field of x, or its zero value if it or x is nil.
+func (x *Progress) GetPage() int {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+// GetElapsed returns the Elapsed field of x, or its zero value if it or x is nil.
+func (x *Progress) GetElapsed() time.Duration {
+	if x != nil {
+		return x.Elapsed
+	}
+	var zero time.Duration
+	return zero
+}
+
+// GetETA returns the ETA field of x, or its zero value if it or x is nil.
+func (x *Progress) GetETA() time.Duration {
+	if x != nil {
+		return x.ETA
+	}
+	var zero time.Duration
+	return zero
+}
+
+// GetDeviceType returns the DeviceType field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetOwner returns the Owner field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// GetSequence returns the Sequence field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetSequence() int32 {
+	if x != nil && x.Sequence != nil {
+		return *x.Sequence
+	}
+	return 0
+}
+
+// GetPriorityThreshold returns the PriorityThreshold field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetPriorityThreshold() string {
+	if x != nil {
+		return x.PriorityThreshold
+	}
+	return ""
+}
+
+// GetTestStatus returns the TestStatus field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetTestStatus() string {
+	if x != nil {
+		return x.TestStatus
+	}
+	return ""
+}
+
+// GetTimeframes returns the Timeframes field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetTimeframes() []*DeviceTimeframe {
+	if x != nil {
+		return x.Timeframes
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetCountry returns the Country field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// GetDefaultDevice returns the DefaultDevice field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetDefaultDevice() bool {
+	if x != nil && x.DefaultDevice != nil {
+		return *x.DefaultDevice
+	}
+	return false
+}
+
+// GetDelay returns the Delay field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetDelay() Nullable[int32] {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+// GetEmailAddress returns the EmailAddress field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+// GetExternalKey returns the ExternalKey field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetExternalKey() Nullable[string] {
+	if x != nil {
+		return x.ExternalKey
+	}
+	return nil
+}
+
+// GetExternallyOwned returns the ExternallyOwned field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetExternallyOwned() Nullable[bool] {
+	if x != nil {
+		return x.ExternallyOwned
+	}
+	return nil
+}
+
+// GetPhoneNumber returns the PhoneNumber field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+// GetPIN returns the PIN field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetPIN() string {
+	if x != nil {
+		return x.PIN
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetTwoWayDevice returns the TwoWayDevice field of x, or its zero value if it or x is nil.
+func (x *PushDeviceParams) GetTwoWayDevice() Nullable[bool] {
+	if x != nil {
+		return x.TwoWayDevice
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+// GetAllowDuplicates returns the AllowDuplicates field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetAllowDuplicates() bool {
+	if x != nil && x.AllowDuplicates != nil {
+		return *x.AllowDuplicates
+	}
+	return false
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// GetExternalKey returns the ExternalKey field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetExternalKey() Nullable[string] {
+	if x != nil {
+		return x.ExternalKey
+	}
+	return nil
+}
+
+// GetExternallyOwned returns the ExternallyOwned field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetExternallyOwned() Nullable[bool] {
+	if x != nil {
+		return x.ExternallyOwned
+	}
+	return nil
+}
+
+// GetGroupType returns the GroupType field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetGroupType() GroupType {
+	if x != nil {
+		return x.GroupType
+	}
+	return ""
+}
+
+// GetObservedByAll returns the ObservedByAll field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetObservedByAll() bool {
+	if x != nil && x.ObservedByAll != nil {
+		return *x.ObservedByAll
+	}
+	return false
+}
+
+// GetObservers returns the Observers field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetObservers() []*ReferenceByName {
+	if x != nil {
+		return x.Observers
+	}
+	return nil
+}
+
+// GetSite returns the Site field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetUseDefaultDevices returns the UseDefaultDevices field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetUseDefaultDevices() bool {
+	if x != nil && x.UseDefaultDevices != nil {
+		return *x.UseDefaultDevices
+	}
+	return false
+}
+
+// GetSupervisors returns the Supervisors field of x, or its zero value if it or x is nil.
+func (x *PushGroupParams) GetSupervisors() []*ReferenceById {
+	if x != nil {
+		return x.Supervisors
+	}
+	return nil
+}
+
+// GetPlanID returns the PlanID field of x, or its zero value if it or x is nil.
+func (x *PushIntegrationParams) GetPlanID() string {
+	if x != nil {
+		return x.PlanID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *PushIntegrationParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetIntegrationType returns the IntegrationType field of x, or its zero value if it or x is nil.
+func (x *PushIntegrationParams) GetIntegrationType() string {
+	if x != nil {
+		return x.IntegrationType
+	}
+	return ""
+}
+
+// GetOperation returns the Operation field of x, or its zero value if it or x is nil.
+func (x *PushIntegrationParams) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PushIntegrationParams) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetForm returns the Form field of x, or its zero value if it or x is nil.
+func (x *PushIntegrationParams) GetForm() *ReferenceById {
+	if x != nil {
+		return x.Form
+	}
+	return nil
+}
+
+// GetEnabled returns the Enabled field of x, or its zero value if it or x is nil.
+func (x *PushIntegrationParams) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// GetAuthenticationMethod returns the AuthenticationMethod field of x, or its zero value if it or x is nil.
+func (x *PushIntegrationParams) GetAuthenticationMethod() string {
+	if x != nil && x.AuthenticationMethod != nil {
+		return *x.AuthenticationMethod
+	}
+	return ""
+}
+
+// GetScript returns the Script field of x, or its zero value if it or x is nil.
+func (x *PushIntegrationParams) GetScript() string {
+	if x != nil && x.Script != nil {
+		return *x.Script
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+// GetFirstName returns the FirstName field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+// GetLastName returns the LastName field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+// GetRoles returns the Roles field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetRoles() []*string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// GetLicenseType returns the LicenseType field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetLicenseType() LicenseType {
+	if x != nil {
+		return x.LicenseType
+	}
+	return ""
+}
+
+// GetSite returns the Site field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+// GetLanguage returns the Language field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// GetSupervisors returns the Supervisors field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetSupervisors() []*string {
+	if x != nil {
+		return x.Supervisors
+	}
+	return nil
+}
+
+// GetTimezone returns the Timezone field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// GetWebLogin returns the WebLogin field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetWebLogin() string {
+	if x != nil {
+		return x.WebLogin
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetPhoneLogin returns the PhoneLogin field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetPhoneLogin() Nullable[string] {
+	if x != nil {
+		return x.PhoneLogin
+	}
+	return nil
+}
+
+// GetPhonePin returns the PhonePin field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetPhonePin() string {
+	if x != nil {
+		return x.PhonePin
+	}
+	return ""
+}
+
+// GetExternalKey returns the ExternalKey field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetExternalKey() Nullable[string] {
+	if x != nil {
+		return x.ExternalKey
+	}
+	return nil
+}
+
+// GetExternallyOwned returns the ExternallyOwned field of x, or its zero value if it or x is nil.
+func (x *PushPersonParams) GetExternallyOwned() Nullable[bool] {
+	if x != nil {
+		return x.ExternallyOwned
+	}
+	return nil
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *PushPlanParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PushPlanParams) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *PushPlanParams) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetPlanType returns the PlanType field of x, or its zero value if it or x is nil.
+func (x *PushPlanParams) GetPlanType() PlanType {
+	if x != nil {
+		return x.PlanType
+	}
+	return ""
+}
+
+// GetEnabled returns the Enabled field of x, or its zero value if it or x is nil.
+func (x *PushPlanParams) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// GetAccessibleByAll returns the AccessibleByAll field of x, or its zero value if it or x is nil.
+func (x *PushPlanParams) GetAccessibleByAll() bool {
+	if x != nil && x.AccessibleByAll != nil {
+		return *x.AccessibleByAll
+	}
+	return false
+}
+
+// GetFloodControl returns the FloodControl field of x, or its zero value if it or x is nil.
+func (x *PushPlanParams) GetFloodControl() bool {
+	if x != nil && x.FloodControl != nil {
+		return *x.FloodControl
+	}
+	return false
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PushServiceDependencyParams) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetServiceID returns the ServiceID field of x, or its zero value if it or x is nil.
+func (x *PushServiceDependencyParams) GetServiceID() string {
+	if x != nil {
+		return x.ServiceID
+	}
+	return ""
+}
+
+// GetDependentServiceID returns the DependentServiceID field of x, or its zero value if it or x is nil.
+func (x *PushServiceDependencyParams) GetDependentServiceID() string {
+	if x != nil {
+		return x.DependentServiceID
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PushServiceParams) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *PushServiceParams) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *PushServiceParams) GetDescription() Nullable[string] {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+// GetServiceType returns the ServiceType field of x, or its zero value if it or x is nil.
+func (x *PushServiceParams) GetServiceType() string {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ""
+}
+
+// GetServiceTier returns the ServiceTier field of x, or its zero value if it or x is nil.
+func (x *PushServiceParams) GetServiceTier() Nullable[string] {
+	if x != nil {
+		return x.ServiceTier
+	}
+	return nil
+}
+
+// GetOwnedBy returns the OwnedBy field of x, or its zero value if it or x is nil.
+func (x *PushServiceParams) GetOwnedBy() Nullable[*GroupReference] {
+	if x != nil {
+		return x.OwnedBy
+	}
+	return nil
+}
+
+// GetServiceLinks returns the ServiceLinks field of x, or its zero value if it or x is nil.
+func (x *PushServiceParams) GetServiceLinks() []*ServiceLink {
+	if x != nil {
+		return x.ServiceLinks
+	}
+	return nil
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *PushServiceParams) GetStatus() ServiceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetRecipient returns the Recipient field of x, or its zero value if it or x is nil.
+func (x *PushShiftMember) GetRecipient() *RecipientPointer {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+// GetPosition returns the Position field of x, or its zero value if it or x is nil.
+func (x *PushShiftMember) GetPosition() int64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+// GetDelay returns the Delay field of x, or its zero value if it or x is nil.
+func (x *PushShiftMember) GetDelay() int64 {
+	if x != nil && x.Delay != nil {
+		return *x.Delay
+	}
+	return 0
+}
+
+// GetEscalationType returns the EscalationType field of x, or its zero value if it or x is nil.
+func (x *PushShiftMember) GetEscalationType() string {
+	if x != nil && x.EscalationType != nil {
+		return *x.EscalationType
+	}
+	return ""
+}
+
+// GetInRotation returns the InRotation field of x, or its zero value if it or x is nil.
+func (x *PushShiftMember) GetInRotation() bool {
+	if x != nil && x.InRotation != nil {
+		return *x.InRotation
+	}
+	return false
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *PushShiftParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetStart returns the Start field of x, or its zero value if it or x is nil.
+func (x *PushShiftParams) GetStart() Timestamp {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetEnd returns the End field of x, or its zero value if it or x is nil.
+func (x *PushShiftParams) GetEnd() Timestamp {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PushShiftParams) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetTimezone returns the Timezone field of x, or its zero value if it or x is nil.
+func (x *PushShiftParams) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// GetRecurrence returns the Recurrence field of x, or its zero value if it or x is nil.
+func (x *PushShiftParams) GetRecurrence() *ShiftRecurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+// GetMembers returns the Members field of x, or its zero value if it or x is nil.
+func (x *PushShiftParams) GetMembers() []*PushShiftMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetCountry returns the Country field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// GetLanguage returns the Language field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// GetTimezone returns the Timezone field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// GetAddress1 returns the Address1 field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetAddress1() Nullable[string] {
+	if x != nil {
+		return x.Address1
+	}
+	return nil
+}
+
+// GetAddress2 returns the Address2 field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetAddress2() Nullable[string] {
+	if x != nil {
+		return x.Address2
+	}
+	return nil
+}
+
+// GetCity returns the City field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetCity() Nullable[string] {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetLatitude returns the Latitude field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetLatitude() Nullable[float64] {
+	if x != nil {
+		return x.Latitude
+	}
+	return nil
+}
+
+// GetLongitude returns the Longitude field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetLongitude() Nullable[float64] {
+	if x != nil {
+		return x.Longitude
+	}
+	return nil
+}
+
+// GetPostalCode returns the PostalCode field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetPostalCode() Nullable[string] {
+	if x != nil {
+		return x.PostalCode
+	}
+	return nil
+}
+
+// GetState returns the State field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetState() Nullable[string] {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *PushSiteParams) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *PushSubscriptionParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetForm returns the Form field of x, or its zero value if it or x is nil.
+func (x *PushSubscriptionParams) GetForm() ReferenceById {
+	if x != nil {
+		return x.Form
+	}
+	var zero ReferenceById
+	return zero
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *PushSubscriptionParams) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *PushSubscriptionParams) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetOwner returns the Owner field of x, or its zero value if it or x is nil.
+func (x *PushSubscriptionParams) GetOwner() *ReferenceById {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+// GetCriteria returns the Criteria field of x, or its zero value if it or x is nil.
+func (x *PushSubscriptionParams) GetCriteria() map[string]interface{} {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+// GetNotifyOwner returns the NotifyOwner field of x, or its zero value if it or x is nil.
+func (x *PushSubscriptionParams) GetNotifyOwner() bool {
+	if x != nil && x.NotifyOwner != nil {
+		return *x.NotifyOwner
+	}
+	return false
+}
+
+// GetTotal returns the Total field of x, or its zero value if it or x is nil.
+func (x *QuotaDetails) GetTotal() int64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+// GetActive returns the Active field of x, or its zero value if it or x is nil.
+func (x *QuotaDetails) GetActive() int64 {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return 0
+}
+
+// GetUnused returns the Unused field of x, or its zero value if it or x is nil.
+func (x *QuotaDetails) GetUnused() int64 {
+	if x != nil && x.Unused != nil {
+		return *x.Unused
+	}
+	return 0
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *RecipientPointer) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetType returns the Type field of x, or its zero value if it or x is nil.
+func (x *RecipientPointer) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetTargetName() string {
+	if x != nil && x.TargetName != nil {
+		return *x.TargetName
+	}
+	return ""
+}
+
+// GetRecipientType returns the RecipientType field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetRecipientType() string {
+	if x != nil && x.RecipientType != nil {
+		return *x.RecipientType
+	}
+	return ""
+}
+
+// GetSite returns the Site field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetSite() *ReferenceById {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+// GetSupervisors returns the Supervisors field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetSupervisors() *PersonPagination {
+	if x != nil {
+		return x.Supervisors
+	}
+	return nil
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetObservers returns the Observers field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetObservers() *RolePagination {
+	if x != nil {
+		return x.Observers
+	}
+	return nil
+}
+
+// GetAllowDuplicates returns the AllowDuplicates field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetAllowDuplicates() bool {
+	if x != nil && x.AllowDuplicates != nil {
+		return *x.AllowDuplicates
+	}
+	return false
+}
+
+// GetGroupType returns the GroupType field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetGroupType() string {
+	if x != nil && x.GroupType != nil {
+		return *x.GroupType
+	}
+	return ""
+}
+
+// GetObservedByAll returns the ObservedByAll field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetObservedByAll() bool {
+	if x != nil && x.ObservedByAll != nil {
+		return *x.ObservedByAll
+	}
+	return false
+}
+
+// GetResponseCount returns the ResponseCount field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetResponseCount() int64 {
+	if x != nil && x.ResponseCount != nil {
+		return *x.ResponseCount
+	}
+	return 0
+}
+
+// GetResponseCountThreshold returns the ResponseCountThreshold field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetResponseCountThreshold() int64 {
+	if x != nil && x.ResponseCountThreshold != nil {
+		return *x.ResponseCountThreshold
+	}
+	return 0
+}
+
+// GetUseDefaultDevices returns the UseDefaultDevices field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetUseDefaultDevices() bool {
+	if x != nil && x.UseDefaultDevices != nil {
+		return *x.UseDefaultDevices
+	}
+	return false
+}
+
+// GetServices returns the Services field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetServices() *ServicePagination {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// GetFirstName returns the FirstName field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+// GetLanguage returns the Language field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+// GetLastName returns the LastName field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+// GetLicenseType returns the LicenseType field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetLicenseType() string {
+	if x != nil && x.LicenseType != nil {
+		return *x.LicenseType
+	}
+	return ""
+}
+
+// GetPhoneLogin returns the PhoneLogin field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetPhoneLogin() string {
+	if x != nil && x.PhoneLogin != nil {
+		return *x.PhoneLogin
+	}
+	return ""
+}
+
+// GetPhonePin returns the PhonePin field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetPhonePin() string {
+	if x != nil && x.PhonePin != nil {
+		return *x.PhonePin
+	}
+	return ""
+}
+
+// GetProperties returns the Properties field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetProperties() map[string]string {
+	if x != nil && x.Properties != nil {
+		return *x.Properties
+	}
+	return nil
+}
+
+// GetRoles returns the Roles field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetRoles() *RolePagination {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// GetTimezone returns the Timezone field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+// GetLastLogin returns the LastLogin field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetLastLogin() Timestamp {
+	if x != nil && x.LastLogin != nil {
+		return *x.LastLogin
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetWebLogin returns the WebLogin field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetWebLogin() string {
+	if x != nil && x.WebLogin != nil {
+		return *x.WebLogin
+	}
+	return ""
+}
+
+// GetDefaultDevice returns the DefaultDevice field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetDefaultDevice() bool {
+	if x != nil && x.DefaultDevice != nil {
+		return *x.DefaultDevice
+	}
+	return false
+}
+
+// GetDelay returns the Delay field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetDelay() int64 {
+	if x != nil && x.Delay != nil {
+		return *x.Delay
+	}
+	return 0
+}
+
+// GetDeviceType returns the DeviceType field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetDeviceType() string {
+	if x != nil && x.DeviceType != nil {
+		return *x.DeviceType
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetOwner returns the Owner field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetOwner() *PersonReference {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+// GetPriorityThreshold returns the PriorityThreshold field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetPriorityThreshold() string {
+	if x != nil && x.PriorityThreshold != nil {
+		return *x.PriorityThreshold
+	}
+	return ""
+}
+
+// GetProvider returns the Provider field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetProvider() *ReferenceById {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+// GetSequence returns the Sequence field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetSequence() string {
+	if x != nil && x.Sequence != nil {
+		return *x.Sequence
+	}
+	return ""
+}
+
+// GetTestStatus returns the TestStatus field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetTestStatus() string {
+	if x != nil && x.TestStatus != nil {
+		return *x.TestStatus
+	}
+	return ""
+}
+
+// GetTimeframes returns the Timeframes field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetTimeframes() []DeviceTimeframe {
+	if x != nil && x.Timeframes != nil {
+		return *x.Timeframes
+	}
+	return nil
+}
+
+// GetExternallyOwned returns the ExternallyOwned field of x, or its zero value if it or x is nil.
+func (x *RecipientReference) GetExternallyOwned() bool {
+	if x != nil && x.ExternallyOwned != nil {
+		return *x.ExternallyOwned
+	}
+	return false
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *ReferenceById) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *ReferenceByName) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetPeople returns the People field of x, or its zero value if it or x is nil.
+func (x *ResolvedRecipients) GetPeople() []*Person {
+	if x != nil {
+		return x.People
+	}
+	return nil
+}
+
+// GetDevices returns the Devices field of x, or its zero value if it or x is nil.
+func (x *ResolvedRecipients) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+// GetUnresolved returns the Unresolved field of x, or its zero value if it or x is nil.
+func (x *ResolvedRecipients) GetUnresolved() []*UnresolvedRecipient {
+	if x != nil {
+		return x.Unresolved
+	}
+	return nil
+}
+
+// GetEventID returns the EventID field of x, or its zero value if it or x is nil.
+func (x *RespondToEventParams) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+// GetRecipient returns the Recipient field of x, or its zero value if it or x is nil.
+func (x *RespondToEventParams) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+// GetResponse returns the Response field of x, or its zero value if it or x is nil.
+func (x *RespondToEventParams) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+// GetDevice returns the Device field of x, or its zero value if it or x is nil.
+func (x *RespondToEventParams) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+// GetComment returns the Comment field of x, or its zero value if it or x is nil.
+func (x *RespondToEventParams) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *ResponseOption) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetNumber returns the Number field of x, or its zero value if it or x is nil.
+func (x *ResponseOption) GetNumber() int64 {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return 0
+}
+
+// GetText returns the Text field of x, or its zero value if it or x is nil.
+func (x *ResponseOption) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *ResponseOption) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetPrompt returns the Prompt field of x, or its zero value if it or x is nil.
+func (x *ResponseOption) GetPrompt() string {
+	if x != nil && x.Prompt != nil {
+		return *x.Prompt
+	}
+	return ""
+}
+
+// GetAction returns the Action field of x, or its zero value if it or x is nil.
+func (x *ResponseOption) GetAction() ResponseOptionAction {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+// GetContribution returns the Contribution field of x, or its zero value if it or x is nil.
+func (x *ResponseOption) GetContribution() ResponseOptionContribution {
+	if x != nil && x.Contribution != nil {
+		return *x.Contribution
+	}
+	return ""
+}
+
+// GetJoinConference returns the JoinConference field of x, or its zero value if it or x is nil.
+func (x *ResponseOption) GetJoinConference() bool {
+	if x != nil && x.JoinConference != nil {
+		return *x.JoinConference
+	}
+	return false
+}
+
+// GetAllowComments returns the AllowComments field of x, or its zero value if it or x is nil.
+func (x *ResponseOption) GetAllowComments() bool {
+	if x != nil && x.AllowComments != nil {
+		return *x.AllowComments
+	}
+	return false
+}
+
+// GetRedirectURL returns the RedirectURL field of x, or its zero value if it or x is nil.
+func (x *ResponseOption) GetRedirectURL() string {
+	if x != nil && x.RedirectURL != nil {
+		return *x.RedirectURL
+	}
+	return ""
+}
+
+// GetResponseOptions returns the ResponseOptions field of x, or its zero value if it or x is nil.
+func (x *ResponseOptionPagination) GetResponseOptions() []*ResponseOption {
+	if x != nil {
+		return x.ResponseOptions
+	}
+	return nil
+}
+
+// GetMaxRetries returns the MaxRetries field of x, or its zero value if it or x is nil.
+func (x *RetryPolicy) GetMaxRetries() int {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+// GetMinRetryDelay returns the MinRetryDelay field of x, or its zero value if it or x is nil.
+func (x *RetryPolicy) GetMinRetryDelay() time.Duration {
+	if x != nil {
+		return x.MinRetryDelay
+	}
+	var zero time.Duration
+	return zero
+}
+
+// GetMaxRetryDelay returns the MaxRetryDelay field of x, or its zero value if it or x is nil.
+func (x *RetryPolicy) GetMaxRetryDelay() time.Duration {
+	if x != nil {
+		return x.MaxRetryDelay
+	}
+	var zero time.Duration
+	return zero
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Role) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Role) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *Role) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetRoles returns the Roles field of x, or its zero value if it or x is nil.
+func (x *RolePagination) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// GetTerms returns the Terms field of x, or its zero value if it or x is nil.
+func (x *SearchQuery) GetTerms() string {
+	if x != nil {
+		return x.Terms
+	}
+	return ""
+}
+
+// GetFields returns the Fields field of x, or its zero value if it or x is nil.
+func (x *SearchQuery) GetFields() []SearchField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// GetOperand returns the Operand field of x, or its zero value if it or x is nil.
+func (x *SearchQuery) GetOperand() SearchOperand {
+	if x != nil {
+		return x.Operand
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *SenderPermission) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetRecipient returns the Recipient field of x, or its zero value if it or x is nil.
+func (x *SenderPermission) GetRecipient() *RecipientReference {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+// GetPermissions returns the Permissions field of x, or its zero value if it or x is nil.
+func (x *SenderPermissionPagination) GetPermissions() []*SenderPermission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Service) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *Service) GetTargetName() string {
+	if x != nil && x.TargetName != nil {
+		return *x.TargetName
+	}
+	return ""
+}
+
+// GetRecipientType returns the RecipientType field of x, or its zero value if it or x is nil.
+func (x *Service) GetRecipientType() string {
+	if x != nil && x.RecipientType != nil {
+		return *x.RecipientType
+	}
+	return ""
+}
+
+// GetServiceType returns the ServiceType field of x, or its zero value if it or x is nil.
+func (x *Service) GetServiceType() string {
+	if x != nil && x.ServiceType != nil {
+		return *x.ServiceType
+	}
+	return ""
+}
+
+// GetServiceTier returns the ServiceTier field of x, or its zero value if it or x is nil.
+func (x *Service) GetServiceTier() string {
+	if x != nil && x.ServiceTier != nil {
+		return *x.ServiceTier
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *Service) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetServiceLinks returns the ServiceLinks field of x, or its zero value if it or x is nil.
+func (x *Service) GetServiceLinks() []*ServiceLink {
+	if x != nil {
+		return x.ServiceLinks
+	}
+	return nil
+}
+
+// GetOwnedBy returns the OwnedBy field of x, or its zero value if it or x is nil.
+func (x *Service) GetOwnedBy() *GroupReference {
+	if x != nil {
+		return x.OwnedBy
+	}
+	return nil
+}
+
+// GetExternallyOwned returns the ExternallyOwned field of x, or its zero value if it or x is nil.
+func (x *Service) GetExternallyOwned() bool {
+	if x != nil && x.ExternallyOwned != nil {
+		return *x.ExternallyOwned
+	}
+	return false
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *Service) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// GetServices returns the Services field of x, or its zero value if it or x is nil.
+func (x *ServiceCatalog) GetServices() []*CatalogService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// GetDependencies returns the Dependencies field of x, or its zero value if it or x is nil.
+func (x *ServiceCatalog) GetDependencies() []*CatalogDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+// GetCreatedServices returns the CreatedServices field of x, or its zero value if it or x is nil.
+func (x *ServiceCatalogDiff) GetCreatedServices() []string {
+	if x != nil {
+		return x.CreatedServices
+	}
+	return nil
+}
+
+// GetUpdatedServices returns the UpdatedServices field of x, or its zero value if it or x is nil.
+func (x *ServiceCatalogDiff) GetUpdatedServices() []string {
+	if x != nil {
+		return x.UpdatedServices
+	}
+	return nil
+}
+
+// GetDeletedServices returns the DeletedServices field of x, or its zero value if it or x is nil.
+func (x *ServiceCatalogDiff) GetDeletedServices() []string {
+	if x != nil {
+		return x.DeletedServices
+	}
+	return nil
+}
+
+// GetCreatedDependencies returns the CreatedDependencies field of x, or its zero value if it or x is nil.
+func (x *ServiceCatalogDiff) GetCreatedDependencies() []*CatalogDependency {
+	if x != nil {
+		return x.CreatedDependencies
+	}
+	return nil
+}
+
+// GetDeletedDependencies returns the DeletedDependencies field of x, or its zero value if it or x is nil.
+func (x *ServiceCatalogDiff) GetDeletedDependencies() []*CatalogDependency {
+	if x != nil {
+		return x.DeletedDependencies
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *ServiceDependency) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetService returns the Service field of x, or its zero value if it or x is nil.
+func (x *ServiceDependency) GetService() *ServiceReference {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+// GetDependentService returns the DependentService field of x, or its zero value if it or x is nil.
+func (x *ServiceDependency) GetDependentService() *ServiceReference {
+	if x != nil {
+		return x.DependentService
+	}
+	return nil
+}
+
+// GetData returns the Data field of x, or its zero value if it or x is nil.
+func (x *ServiceDependencyPagination) GetData() []*ServiceDependency {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetLabel returns the Label field of x, or its zero value if it or x is nil.
+func (x *ServiceLink) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+// GetURL returns the URL field of x, or its zero value if it or x is nil.
+func (x *ServiceLink) GetURL() string {
+	if x != nil && x.URL != nil {
+		return *x.URL
+	}
+	return ""
+}
+
+// GetData returns the Data field of x, or its zero value if it or x is nil.
+func (x *ServiceLinksPagination) GetData() []*ServiceLink {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetServices returns the Services field of x, or its zero value if it or x is nil.
+func (x *ServicePagination) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *ServiceReference) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *ServiceReference) GetTargetName() string {
+	if x != nil && x.TargetName != nil {
+		return *x.TargetName
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Shift) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetGroup returns the Group field of x, or its zero value if it or x is nil.
+func (x *Shift) GetGroup() *GroupReference {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Shift) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetStart returns the Start field of x, or its zero value if it or x is nil.
+func (x *Shift) GetStart() Timestamp {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetEnd returns the End field of x, or its zero value if it or x is nil.
+func (x *Shift) GetEnd() Timestamp {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTimezone returns the Timezone field of x, or its zero value if it or x is nil.
+func (x *Shift) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+// GetRecurrence returns the Recurrence field of x, or its zero value if it or x is nil.
+func (x *Shift) GetRecurrence() *ShiftRecurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+// GetMembers returns the Members field of x, or its zero value if it or x is nil.
+func (x *Shift) GetMembers() []*ShiftMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// GetEndBy returns the EndBy field of x, or its zero value if it or x is nil.
+func (x *ShiftEnd) GetEndBy() string {
+	if x != nil && x.EndBy != nil {
+		return *x.EndBy
+	}
+	return ""
+}
+
+// GetDate returns the Date field of x, or its zero value if it or x is nil.
+func (x *ShiftEnd) GetDate() string {
+	if x != nil && x.Date != nil {
+		return *x.Date
+	}
+	return ""
+}
+
+// GetRepetitions returns the Repetitions field of x, or its zero value if it or x is nil.
+func (x *ShiftEnd) GetRepetitions() int64 {
+	if x != nil && x.Repetitions != nil {
+		return *x.Repetitions
+	}
+	return 0
+}
+
+// GetRecipient returns the Recipient field of x, or its zero value if it or x is nil.
+func (x *ShiftMember) GetRecipient() *RecipientPointer {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+// GetShift returns the Shift field of x, or its zero value if it or x is nil.
+func (x *ShiftMember) GetShift() *ReferenceById {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+// GetPosition returns the Position field of x, or its zero value if it or x is nil.
+func (x *ShiftMember) GetPosition() int64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+// GetDelay returns the Delay field of x, or its zero value if it or x is nil.
+func (x *ShiftMember) GetDelay() int64 {
+	if x != nil && x.Delay != nil {
+		return *x.Delay
+	}
+	return 0
+}
+
+// GetEscalationType returns the EscalationType field of x, or its zero value if it or x is nil.
+func (x *ShiftMember) GetEscalationType() string {
+	if x != nil && x.EscalationType != nil {
+		return *x.EscalationType
+	}
+	return ""
+}
+
+// GetInRotation returns the InRotation field of x, or its zero value if it or x is nil.
+func (x *ShiftMember) GetInRotation() bool {
+	if x != nil && x.InRotation != nil {
+		return *x.InRotation
+	}
+	return false
+}
+
+// GetMembers returns the Members field of x, or its zero value if it or x is nil.
+func (x *ShiftMemberPagination) GetMembers() []*ShiftMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// GetShifts returns the Shifts field of x, or its zero value if it or x is nil.
+func (x *ShiftPagination) GetShifts() []*Shift {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+// GetFrequency returns the Frequency field of x, or its zero value if it or x is nil.
+func (x *ShiftRecurrence) GetFrequency() string {
+	if x != nil && x.Frequency != nil {
+		return *x.Frequency
+	}
+	return ""
+}
+
+// GetRepeatEvery returns the RepeatEvery field of x, or its zero value if it or x is nil.
+func (x *ShiftRecurrence) GetRepeatEvery() int64 {
+	if x != nil && x.RepeatEvery != nil {
+		return *x.RepeatEvery
+	}
+	return 0
+}
+
+// GetOnDays returns the OnDays field of x, or its zero value if it or x is nil.
+func (x *ShiftRecurrence) GetOnDays() []*string {
+	if x != nil {
+		return x.OnDays
+	}
+	return nil
+}
+
+// GetOn returns the On field of x, or its zero value if it or x is nil.
+func (x *ShiftRecurrence) GetOn() string {
+	if x != nil && x.On != nil {
+		return *x.On
+	}
+	return ""
+}
+
+// GetMonths returns the Months field of x, or its zero value if it or x is nil.
+func (x *ShiftRecurrence) GetMonths() []*string {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+// GetDateOfMonth returns the DateOfMonth field of x, or its zero value if it or x is nil.
+func (x *ShiftRecurrence) GetDateOfMonth() string {
+	if x != nil && x.DateOfMonth != nil {
+		return *x.DateOfMonth
+	}
+	return ""
+}
+
+// GetDayOfWeekClassifier returns the DayOfWeekClassifier field of x, or its zero value if it or x is nil.
+func (x *ShiftRecurrence) GetDayOfWeekClassifier() string {
+	if x != nil && x.DayOfWeekClassifier != nil {
+		return *x.DayOfWeekClassifier
+	}
+	return ""
+}
+
+// GetDayOfWeek returns the DayOfWeek field of x, or its zero value if it or x is nil.
+func (x *ShiftRecurrence) GetDayOfWeek() string {
+	if x != nil && x.DayOfWeek != nil {
+		return *x.DayOfWeek
+	}
+	return ""
+}
+
+// GetEnd returns the End field of x, or its zero value if it or x is nil.
+func (x *ShiftRecurrence) GetEnd() *ShiftEnd {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *ShiftReference) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *ShiftReference) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetAddress1 returns the Address1 field of x, or its zero value if it or x is nil.
+func (x *Site) GetAddress1() string {
+	if x != nil && x.Address1 != nil {
+		return *x.Address1
+	}
+	return ""
+}
+
+// GetAddress2 returns the Address2 field of x, or its zero value if it or x is nil.
+func (x *Site) GetAddress2() string {
+	if x != nil && x.Address2 != nil {
+		return *x.Address2
+	}
+	return ""
+}
+
+// GetCity returns the City field of x, or its zero value if it or x is nil.
+func (x *Site) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+// GetCountry returns the Country field of x, or its zero value if it or x is nil.
+func (x *Site) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Site) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetLanguage returns the Language field of x, or its zero value if it or x is nil.
+func (x *Site) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+// GetLatitude returns the Latitude field of x, or its zero value if it or x is nil.
+func (x *Site) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+// GetLongitude returns the Longitude field of x, or its zero value if it or x is nil.
+func (x *Site) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Site) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetPostalCode returns the PostalCode field of x, or its zero value if it or x is nil.
+func (x *Site) GetPostalCode() string {
+	if x != nil && x.PostalCode != nil {
+		return *x.PostalCode
+	}
+	return ""
+}
+
+// GetState returns the State field of x, or its zero value if it or x is nil.
+func (x *Site) GetState() string {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *Site) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// GetTimezone returns the Timezone field of x, or its zero value if it or x is nil.
+func (x *Site) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+// GetSite returns the Site field of x, or its zero value if it or x is nil.
+func (x *SiteDistribution) GetSite() *Site {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+// GetActivePeople returns the ActivePeople field of x, or its zero value if it or x is nil.
+func (x *SiteDistribution) GetActivePeople() int64 {
+	if x != nil {
+		return x.ActivePeople
+	}
+	return 0
+}
+
+// GetActiveDevices returns the ActiveDevices field of x, or its zero value if it or x is nil.
+func (x *SiteDistribution) GetActiveDevices() int64 {
+	if x != nil {
+		return x.ActiveDevices
+	}
+	return 0
+}
+
+// GetLicenseTypes returns the LicenseTypes field of x, or its zero value if it or x is nil.
+func (x *SiteDistribution) GetLicenseTypes() map[string]int64 {
+	if x != nil {
+		return x.LicenseTypes
+	}
+	return nil
+}
+
+// GetSites returns the Sites field of x, or its zero value if it or x is nil.
+func (x *SiteDistributionParams) GetSites() GetSitesParams {
+	if x != nil {
+		return x.Sites
+	}
+	var zero GetSitesParams
+	return zero
+}
+
+// GetIncludeLicenseTypes returns the IncludeLicenseTypes field of x, or its zero value if it or x is nil.
+func (x *SiteDistributionParams) GetIncludeLicenseTypes() bool {
+	if x != nil {
+		return x.IncludeLicenseTypes
+	}
+	return false
+}
+
+// GetSites returns the Sites field of x, or its zero value if it or x is nil.
+func (x *SitePagination) GetSites() []*Site {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+// GetExportedAt returns the ExportedAt field of x, or its zero value if it or x is nil.
+func (x *Snapshot) GetExportedAt() string {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return ""
+}
+
+// GetSites returns the Sites field of x, or its zero value if it or x is nil.
+func (x *Snapshot) GetSites() []*Site {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+// GetPeople returns the People field of x, or its zero value if it or x is nil.
+func (x *Snapshot) GetPeople() []*Person {
+	if x != nil {
+		return x.People
+	}
+	return nil
+}
+
+// GetDevices returns the Devices field of x, or its zero value if it or x is nil.
+func (x *Snapshot) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+// GetGroups returns the Groups field of x, or its zero value if it or x is nil.
+func (x *Snapshot) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// GetRosters returns the Rosters field of x, or its zero value if it or x is nil.
+func (x *Snapshot) GetRosters() map[string][]*GroupMember {
+	if x != nil {
+		return x.Rosters
+	}
+	return nil
+}
+
+// GetShifts returns the Shifts field of x, or its zero value if it or x is nil.
+func (x *Snapshot) GetShifts() map[string][]*Shift {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+// GetServices returns the Services field of x, or its zero value if it or x is nil.
+func (x *Snapshot) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// GetServiceDependencies returns the ServiceDependencies field of x, or its zero value if it or x is nil.
+func (x *Snapshot) GetServiceDependencies() []*ServiceDependency {
+	if x != nil {
+		return x.ServiceDependencies
+	}
+	return nil
+}
+
+// GetDynamicTeams returns the DynamicTeams field of x, or its zero value if it or x is nil.
+func (x *Snapshot) GetDynamicTeams() []*DynamicTeam {
+	if x != nil {
+		return x.DynamicTeams
+	}
+	return nil
+}
+
+// GetSubscribers returns the Subscribers field of x, or its zero value if it or x is nil.
+func (x *SubscriberPagination) GetSubscribers() []*PersonReference {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *Subscription) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *Subscription) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *Subscription) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetForm returns the Form field of x, or its zero value if it or x is nil.
+func (x *Subscription) GetForm() *FormReference {
+	if x != nil {
+		return x.Form
+	}
+	return nil
+}
+
+// GetOwner returns the Owner field of x, or its zero value if it or x is nil.
+func (x *Subscription) GetOwner() *PersonReference {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+// GetCriteria returns the Criteria field of x, or its zero value if it or x is nil.
+func (x *Subscription) GetCriteria() map[string]interface{} {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+// GetNotifyOwner returns the NotifyOwner field of x, or its zero value if it or x is nil.
+func (x *Subscription) GetNotifyOwner() bool {
+	if x != nil && x.NotifyOwner != nil {
+		return *x.NotifyOwner
+	}
+	return false
+}
+
+// GetCreated returns the Created field of x, or its zero value if it or x is nil.
+func (x *Subscription) GetCreated() Timestamp {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetSubscriptions returns the Subscriptions field of x, or its zero value if it or x is nil.
+func (x *SubscriptionPagination) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+// GetFrom returns the From field of x, or its zero value if it or x is nil.
+func (x *SyncWindow) GetFrom() Timestamp {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetTo returns the To field of x, or its zero value if it or x is nil.
+func (x *SyncWindow) GetTo() Timestamp {
+	if x != nil {
+		return x.To
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetID returns the ID field of x, or its zero value if it or x is nil.
+func (x *TemporaryAbsence) GetID() string {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return ""
+}
+
+// GetAbsenceType returns the AbsenceType field of x, or its zero value if it or x is nil.
+func (x *TemporaryAbsence) GetAbsenceType() string {
+	if x != nil && x.AbsenceType != nil {
+		return *x.AbsenceType
+	}
+	return ""
+}
+
+// GetMember returns the Member field of x, or its zero value if it or x is nil.
+func (x *TemporaryAbsence) GetMember() *PersonReference {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// GetStart returns the Start field of x, or its zero value if it or x is nil.
+func (x *TemporaryAbsence) GetStart() Timestamp {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetEnd returns the End field of x, or its zero value if it or x is nil.
+func (x *TemporaryAbsence) GetEnd() Timestamp {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetGroup returns the Group field of x, or its zero value if it or x is nil.
+func (x *TemporaryAbsence) GetGroup() *GroupReference {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// GetReplacement returns the Replacement field of x, or its zero value if it or x is nil.
+func (x *TemporaryAbsence) GetReplacement() *PersonReference {
+	if x != nil {
+		return x.Replacement
+	}
+	return nil
+}
+
+// GetAbsences returns the Absences field of x, or its zero value if it or x is nil.
+func (x *TemporaryAbsencePagination) GetAbsences() []*TemporaryAbsence {
+	if x != nil {
+		return x.Absences
+	}
+	return nil
+}
+
+// GetFormID returns the FormID field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetFormID() string {
+	if x != nil {
+		return x.FormID
+	}
+	return ""
+}
+
+// GetRecipients returns the Recipients field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetRecipients() []*EventRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+// GetPriority returns the Priority field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetPriority() EventPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+// GetProperties returns the Properties field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetProperties() map[string]interface{} {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// GetConference returns the Conference field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetConference() *Conference {
+	if x != nil {
+		return x.Conference
+	}
+	return nil
+}
+
+// GetResponseOptions returns the ResponseOptions field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetResponseOptions() []*ResponseOption {
+	if x != nil {
+		return x.ResponseOptions
+	}
+	return nil
+}
+
+// GetAttachments returns the Attachments field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetAttachments() []*ReferenceById {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// GetSchedule returns the Schedule field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetSchedule() *EventSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// GetExpirationInMinutes returns the ExpirationInMinutes field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetExpirationInMinutes() int64 {
+	if x != nil && x.ExpirationInMinutes != nil {
+		return *x.ExpirationInMinutes
+	}
+	return 0
+}
+
+// GetBypassPhoneIntro returns the BypassPhoneIntro field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetBypassPhoneIntro() bool {
+	if x != nil && x.BypassPhoneIntro != nil {
+		return *x.BypassPhoneIntro
+	}
+	return false
+}
+
+// GetOverrideDeviceRestrictions returns the OverrideDeviceRestrictions field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetOverrideDeviceRestrictions() bool {
+	if x != nil && x.OverrideDeviceRestrictions != nil {
+		return *x.OverrideDeviceRestrictions
+	}
+	return false
+}
+
+// GetRequirePhonePassword returns the RequirePhonePassword field of x, or its zero value if it or x is nil.
+func (x *TriggerEventParams) GetRequirePhonePassword() bool {
+	if x != nil && x.RequirePhonePassword != nil {
+		return *x.RequirePhonePassword
+	}
+	return false
+}
+
+// GetRecipient returns the Recipient field of x, or its zero value if it or x is nil.
+func (x *UnresolvedRecipient) GetRecipient() *EventRecipient {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+// GetReason returns the Reason field of x, or its zero value if it or x is nil.
+func (x *UnresolvedRecipient) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// GetSummary returns the Summary field of x, or its zero value if it or x is nil.
+func (x *UpdateIncidentParams) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *UpdateIncidentParams) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GetSeverity returns the Severity field of x, or its zero value if it or x is nil.
+func (x *UpdateIncidentParams) GetSeverity() IncidentSeverity {
+	if x != nil && x.Severity != nil {
+		return *x.Severity
+	}
+	return ""
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *UpdateIncidentParams) GetStatus() IncidentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// GetTargetName returns the TargetName field of x, or its zero value if it or x is nil.
+func (x *UpdateServiceParams) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+// GetDescription returns the Description field of x, or its zero value if it or x is nil.
+func (x *UpdateServiceParams) GetDescription() Nullable[string] {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+// GetServiceType returns the ServiceType field of x, or its zero value if it or x is nil.
+func (x *UpdateServiceParams) GetServiceType() string {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ""
+}
+
+// GetServiceTier returns the ServiceTier field of x, or its zero value if it or x is nil.
+func (x *UpdateServiceParams) GetServiceTier() Nullable[string] {
+	if x != nil {
+		return x.ServiceTier
+	}
+	return nil
+}
+
+// GetOwnedBy returns the OwnedBy field of x, or its zero value if it or x is nil.
+func (x *UpdateServiceParams) GetOwnedBy() Nullable[*GroupReference] {
+	if x != nil {
+		return x.OwnedBy
+	}
+	return nil
+}
+
+// GetServiceLinks returns the ServiceLinks field of x, or its zero value if it or x is nil.
+func (x *UpdateServiceParams) GetServiceLinks() []*ServiceLink {
+	if x != nil {
+		return x.ServiceLinks
+	}
+	return nil
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *UpdateServiceParams) GetStatus() ServiceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetName returns the Name field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetCountry returns the Country field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// GetLanguage returns the Language field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// GetTimezone returns the Timezone field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// GetAddress1 returns the Address1 field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetAddress1() Nullable[string] {
+	if x != nil {
+		return x.Address1
+	}
+	return nil
+}
+
+// GetAddress2 returns the Address2 field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetAddress2() Nullable[string] {
+	if x != nil {
+		return x.Address2
+	}
+	return nil
+}
+
+// GetCity returns the City field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetCity() Nullable[string] {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+// GetLatitude returns the Latitude field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetLatitude() Nullable[float64] {
+	if x != nil {
+		return x.Latitude
+	}
+	return nil
+}
+
+// GetLongitude returns the Longitude field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetLongitude() Nullable[float64] {
+	if x != nil {
+		return x.Longitude
+	}
+	return nil
+}
+
+// GetPostalCode returns the PostalCode field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetPostalCode() Nullable[string] {
+	if x != nil {
+		return x.PostalCode
+	}
+	return nil
+}
+
+// GetState returns the State field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetState() Nullable[string] {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// GetStatus returns the Status field of x, or its zero value if it or x is nil.
+func (x *UpdateSiteParams) GetStatus() SiteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetEvent returns the Event field of x, or its zero value if it or x is nil.
+func (x *UserDelivery) GetEvent() *EventReference {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// GetPerson returns the Person field of x, or its zero value if it or x is nil.
+func (x *UserDelivery) GetPerson() *PersonReference {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+// GetDeliveryStatus returns the DeliveryStatus field of x, or its zero value if it or x is nil.
+func (x *UserDelivery) GetDeliveryStatus() string {
+	if x != nil && x.DeliveryStatus != nil {
+		return *x.DeliveryStatus
+	}
+	return ""
+}
+
+// GetAt returns the At field of x, or its zero value if it or x is nil.
+func (x *UserDelivery) GetAt() Timestamp {
+	if x != nil && x.At != nil {
+		return *x.At
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetResponse returns the Response field of x, or its zero value if it or x is nil.
+func (x *UserDelivery) GetResponse() *UserDeliveryResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// GetNotifications returns the Notifications field of x, or its zero value if it or x is nil.
+func (x *UserDelivery) GetNotifications() []*DeliveryNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+// GetDeliveries returns the Deliveries field of x, or its zero value if it or x is nil.
+func (x *UserDeliveryPagination) GetDeliveries() []*UserDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// GetText returns the Text field of x, or its zero value if it or x is nil.
+func (x *UserDeliveryResponse) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+// GetContribution returns the Contribution field of x, or its zero value if it or x is nil.
+func (x *UserDeliveryResponse) GetContribution() string {
+	if x != nil && x.Contribution != nil {
+		return *x.Contribution
+	}
+	return ""
+}
+
+// GetComment returns the Comment field of x, or its zero value if it or x is nil.
+func (x *UserDeliveryResponse) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// GetReceived returns the Received field of x, or its zero value if it or x is nil.
+func (x *UserDeliveryResponse) GetReceived() Timestamp {
+	if x != nil && x.Received != nil {
+		return *x.Received
+	}
+	var zero Timestamp
+	return zero
+}
+
+// GetDevice returns the Device field of x, or its zero value if it or x is nil.
+func (x *UserDeliveryResponse) GetDevice() *DeviceReference {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+// GetStakeholderUsersEnabled returns the StakeholderUsersEnabled field of x, or its zero value if it or x is nil.
+func (x *UserQuotas) GetStakeholderUsersEnabled() bool {
+	if x != nil && x.StakeholderUsersEnabled != nil {
+		return *x.StakeholderUsersEnabled
+	}
+	return false
+}
+
+// GetStakeholderUsers returns the StakeholderUsers field of x, or its zero value if it or x is nil.
+func (x *UserQuotas) GetStakeholderUsers() *QuotaDetails {
+	if x != nil {
+		return x.StakeholderUsers
+	}
+	return nil
+}
+
+// GetFullUsers returns the FullUsers field of x, or its zero value if it or x is nil.
+func (x *UserQuotas) GetFullUsers() *QuotaDetails {
+	if x != nil {
+		return x.FullUsers
+	}
+	return nil
+}
+
+// GetCode returns the Code field of x, or its zero value if it or x is nil.
+func (x *XMattersError) GetCode() int {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+// GetReason returns the Reason field of x, or its zero value if it or x is nil.
+func (x *XMattersError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// GetMessage returns the Message field of x, or its zero value if it or x is nil.
+func (x *XMattersError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetSubcode returns the Subcode field of x, or its zero value if it or x is nil.
+func (x *XMattersError) GetSubcode() string {
+	if x != nil {
+		return x.Subcode
+	}
+	return ""
+}
@@ -0,0 +1,6449 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package xmatters
+
+import (
+	"reflect"
+)
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Attachment) Equal(other *Attachment) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !equalComparablePointer(x.ContentType, other.ContentType) {
+		return false
+	}
+	if !equalComparablePointer(x.Size, other.Size) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Attachment) Copy() *Attachment {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.ContentType = copyShallowPointer(x.ContentType)
+	copied.Size = copyShallowPointer(x.Size)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Audit) Equal(other *Audit) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Type, other.Type) {
+		return false
+	}
+	if !equalPointer(x.At, other.At, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !x.By.Equal(other.By) {
+		return false
+	}
+	if !x.Event.Equal(other.Event) {
+		return false
+	}
+	if !x.Annotation.Equal(other.Annotation) {
+		return false
+	}
+	if !x.Response.Equal(other.Response) {
+		return false
+	}
+	if !x.Notification.Equal(other.Notification) {
+		return false
+	}
+	if !x.Person.Equal(other.Person) {
+		return false
+	}
+	if !x.Recipient.Equal(other.Recipient) {
+		return false
+	}
+	if !equalComparablePointer(x.DeliveryStatus, other.DeliveryStatus) {
+		return false
+	}
+	if !equalComparablePointer(x.Message, other.Message) {
+		return false
+	}
+	if !equalComparablePointer(x.Source, other.Source) {
+		return false
+	}
+	if !equalMap(x.Details, other.Details, func(x, y interface{}) bool { return reflect.DeepEqual(x, y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Audit) Copy() *Audit {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Type = copyShallowPointer(x.Type)
+	copied.At = copyShallowPointer(x.At)
+	copied.By = x.By.Copy()
+	copied.Event = x.Event.Copy()
+	copied.Annotation = x.Annotation.Copy()
+	copied.Response = x.Response.Copy()
+	copied.Notification = x.Notification.Copy()
+	copied.Person = x.Person.Copy()
+	copied.Recipient = x.Recipient.Copy()
+	copied.DeliveryStatus = copyShallowPointer(x.DeliveryStatus)
+	copied.Message = copyShallowPointer(x.Message)
+	copied.Source = copyShallowPointer(x.Source)
+	copied.Details = copyMap(x.Details, func(x interface{}) interface{} { return copyInterface(x) })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *AuditPagination) Equal(other *AuditPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Audits, other.Audits, func(x, y *Audit) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *AuditPagination) Copy() *AuditPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Audits = copySlice(x.Audits, func(x *Audit) *Audit { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Capabilities) Equal(other *Capabilities) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.User != other.User {
+		return false
+	}
+	if !equalSlice(x.Roles, other.Roles, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.Granted, other.Granted, func(x, y bool) bool { return x == y }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Capabilities) Copy() *Capabilities {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Roles = copySlice(x.Roles, func(x string) string { return x })
+	copied.Granted = copyMap(x.Granted, func(x bool) bool { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *CatalogDependency) Equal(other *CatalogDependency) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Service != other.Service {
+		return false
+	}
+	if x.DependentService != other.DependentService {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *CatalogDependency) Copy() *CatalogDependency {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *CatalogService) Equal(other *CatalogService) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.TargetName != other.TargetName {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if x.ServiceType != other.ServiceType {
+		return false
+	}
+	if !equalComparablePointer(x.ServiceTier, other.ServiceTier) {
+		return false
+	}
+	if x.OwnedBy != other.OwnedBy {
+		return false
+	}
+	if !equalSlice(x.ServiceLinks, other.ServiceLinks, func(x, y *ServiceLink) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *CatalogService) Copy() *CatalogService {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Description = copyShallowPointer(x.Description)
+	copied.ServiceTier = copyShallowPointer(x.ServiceTier)
+	copied.ServiceLinks = copySlice(x.ServiceLinks, func(x *ServiceLink) *ServiceLink { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ChangeEvent) Equal(other *ChangeEvent) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Summary, other.Summary) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !equalComparablePointer(x.ChangeType, other.ChangeType) {
+		return false
+	}
+	if !equalComparablePointer(x.Source, other.Source) {
+		return false
+	}
+	if !equalComparablePointer(x.ExternalURL, other.ExternalURL) {
+		return false
+	}
+	if !equalSlice(x.Services, other.Services, func(x, y *ServiceReference) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalMap(x.Properties, other.Properties, func(x, y interface{}) bool { return reflect.DeepEqual(x, y) }) {
+		return false
+	}
+	if !equalPointer(x.Occurred, other.Occurred, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.Created, other.Created, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ChangeEvent) Copy() *ChangeEvent {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Summary = copyShallowPointer(x.Summary)
+	copied.Description = copyShallowPointer(x.Description)
+	copied.ChangeType = copyShallowPointer(x.ChangeType)
+	copied.Source = copyShallowPointer(x.Source)
+	copied.ExternalURL = copyShallowPointer(x.ExternalURL)
+	copied.Services = copySlice(x.Services, func(x *ServiceReference) *ServiceReference { return x.Copy() })
+	copied.Properties = copyMap(x.Properties, func(x interface{}) interface{} { return copyInterface(x) })
+	copied.Occurred = copyShallowPointer(x.Occurred)
+	copied.Created = copyShallowPointer(x.Created)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ChangeEventPagination) Equal(other *ChangeEventPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.ChangeEvents, other.ChangeEvents, func(x, y *ChangeEvent) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ChangeEventPagination) Copy() *ChangeEventPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.ChangeEvents = copySlice(x.ChangeEvents, func(x *ChangeEvent) *ChangeEvent { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ChangeEventStatusParams) Equal(other *ChangeEventStatusParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ChangeEventStatusParams) Copy() *ChangeEventStatusParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ClientConfig) Equal(other *ClientConfig) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Default != other.Default {
+		return false
+	}
+	if !equalMap(x.Profiles, other.Profiles, func(x, y *ClientProfile) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ClientConfig) Copy() *ClientConfig {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Profiles = copyMap(x.Profiles, func(x *ClientProfile) *ClientProfile { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ClientProfile) Equal(other *ClientProfile) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Hostname != other.Hostname {
+		return false
+	}
+	if x.Instance != other.Instance {
+		return false
+	}
+	if x.Environment != other.Environment {
+		return false
+	}
+	if x.Region != other.Region {
+		return false
+	}
+	if x.AuthType != other.AuthType {
+		return false
+	}
+	if x.Token != other.Token {
+		return false
+	}
+	if x.Username != other.Username {
+		return false
+	}
+	if x.Password != other.Password {
+		return false
+	}
+	if x.RateLimit != other.RateLimit {
+		return false
+	}
+	if !equalComparablePointer(x.MaxRetries, other.MaxRetries) {
+		return false
+	}
+	if x.MinRetryDelay != other.MinRetryDelay {
+		return false
+	}
+	if x.MaxRetryDelay != other.MaxRetryDelay {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ClientProfile) Copy() *ClientProfile {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.MaxRetries = copyShallowPointer(x.MaxRetries)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Conference) Equal(other *Conference) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Type != other.Type {
+		return false
+	}
+	if !equalComparablePointer(x.BridgeID, other.BridgeID) {
+		return false
+	}
+	if !equalComparablePointer(x.BridgeNumber, other.BridgeNumber) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Conference) Copy() *Conference {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.BridgeID = copyShallowPointer(x.BridgeID)
+	copied.BridgeNumber = copyShallowPointer(x.BridgeNumber)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *CoveragePeriod) Equal(other *CoveragePeriod) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Start.Equal(other.Start) {
+		return false
+	}
+	if !x.End.Equal(other.End) {
+		return false
+	}
+	if !equalSlice(x.Members, other.Members, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *CoveragePeriod) Copy() *CoveragePeriod {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Members = copySlice(x.Members, func(x string) string { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *CoverageReport) Equal(other *CoverageReport) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Group != other.Group {
+		return false
+	}
+	if !x.From.Equal(other.From) {
+		return false
+	}
+	if !x.To.Equal(other.To) {
+		return false
+	}
+	if !equalSlice(x.Gaps, other.Gaps, func(x, y *CoveragePeriod) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.SinglePerson, other.SinglePerson, func(x, y *CoveragePeriod) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *CoverageReport) Copy() *CoverageReport {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Gaps = copySlice(x.Gaps, func(x *CoveragePeriod) *CoveragePeriod { return x.Copy() })
+	copied.SinglePerson = copySlice(x.SinglePerson, func(x *CoveragePeriod) *CoveragePeriod { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *CreateIncidentParams) Equal(other *CreateIncidentParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Summary != other.Summary {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !equalComparablePointer(x.Severity, other.Severity) {
+		return false
+	}
+	if !equalComparablePointer(x.Status, other.Status) {
+		return false
+	}
+	if !equalComparablePointer(x.RequestID, other.RequestID) {
+		return false
+	}
+	if !equalSlice(x.ImpactedServices, other.ImpactedServices, func(x, y *ReferenceById) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *CreateIncidentParams) Copy() *CreateIncidentParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Description = copyShallowPointer(x.Description)
+	copied.Severity = copyShallowPointer(x.Severity)
+	copied.Status = copyShallowPointer(x.Status)
+	copied.RequestID = copyShallowPointer(x.RequestID)
+	copied.ImpactedServices = copySlice(x.ImpactedServices, func(x *ReferenceById) *ReferenceById { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DecodeWarning) Equal(other *DecodeWarning) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Path != other.Path {
+		return false
+	}
+	if x.Type != other.Type {
+		return false
+	}
+	if x.Value != other.Value {
+		return false
+	}
+	if x.Dropped != other.Dropped {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DecodeWarning) Copy() *DecodeWarning {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DeletePlan) Equal(other *DeletePlan) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.PersonID != other.PersonID {
+		return false
+	}
+	if x.TargetName != other.TargetName {
+		return false
+	}
+	if !equalSlice(x.Steps, other.Steps, func(x, y *DeletePlanStep) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Warnings, other.Warnings, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DeletePlan) Copy() *DeletePlan {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Steps = copySlice(x.Steps, func(x *DeletePlanStep) *DeletePlanStep { return x.Copy() })
+	copied.Warnings = copySlice(x.Warnings, func(x string) string { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DeletePlanStep) Equal(other *DeletePlanStep) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Action != other.Action {
+		return false
+	}
+	if x.ObjectID != other.ObjectID {
+		return false
+	}
+	if x.MemberID != other.MemberID {
+		return false
+	}
+	if x.Description != other.Description {
+		return false
+	}
+	if x.Done != other.Done {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DeletePlanStep) Copy() *DeletePlanStep {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DeliveryNotification) Equal(other *DeliveryNotification) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !x.Device.Equal(other.Device) {
+		return false
+	}
+	if !equalComparablePointer(x.DeliveryStatus, other.DeliveryStatus) {
+		return false
+	}
+	if !equalPointer(x.Created, other.Created, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.Delivered, other.Delivered, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DeliveryNotification) Copy() *DeliveryNotification {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Device = x.Device.Copy()
+	copied.DeliveryStatus = copyShallowPointer(x.DeliveryStatus)
+	copied.Created = copyShallowPointer(x.Created)
+	copied.Delivered = copyShallowPointer(x.Delivered)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Device) Equal(other *Device) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.TargetName, other.TargetName) {
+		return false
+	}
+	if !equalComparablePointer(x.Country, other.Country) {
+		return false
+	}
+	if !equalComparablePointer(x.DefaultDevice, other.DefaultDevice) {
+		return false
+	}
+	if !equalComparablePointer(x.Delay, other.Delay) {
+		return false
+	}
+	if !equalComparablePointer(x.DeviceType, other.DeviceType) {
+		return false
+	}
+	if !equalComparablePointer(x.EmailAddress, other.EmailAddress) {
+		return false
+	}
+	if !equalComparablePointer(x.ExternalKey, other.ExternalKey) {
+		return false
+	}
+	if !equalComparablePointer(x.ExternallyOwned, other.ExternallyOwned) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !x.Owner.Equal(other.Owner) {
+		return false
+	}
+	if !equalComparablePointer(x.PhoneNumber, other.PhoneNumber) {
+		return false
+	}
+	if !equalComparablePointer(x.PIN, other.PIN) {
+		return false
+	}
+	if !equalComparablePointer(x.PriorityThreshold, other.PriorityThreshold) {
+		return false
+	}
+	if !equalComparablePointer(x.Sequence, other.Sequence) {
+		return false
+	}
+	if !equalComparablePointer(x.Status, other.Status) {
+		return false
+	}
+	if !equalComparablePointer(x.TestStatus, other.TestStatus) {
+		return false
+	}
+	if !equalSlice(x.Timeframes, other.Timeframes, func(x, y *DeviceTimeframe) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.TwoWayDevice, other.TwoWayDevice) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Device) Copy() *Device {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.TargetName = copyShallowPointer(x.TargetName)
+	copied.Country = copyShallowPointer(x.Country)
+	copied.DefaultDevice = copyShallowPointer(x.DefaultDevice)
+	copied.Delay = copyShallowPointer(x.Delay)
+	copied.DeviceType = copyShallowPointer(x.DeviceType)
+	copied.EmailAddress = copyShallowPointer(x.EmailAddress)
+	copied.ExternalKey = copyShallowPointer(x.ExternalKey)
+	copied.ExternallyOwned = copyShallowPointer(x.ExternallyOwned)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.Owner = x.Owner.Copy()
+	copied.PhoneNumber = copyShallowPointer(x.PhoneNumber)
+	copied.PIN = copyShallowPointer(x.PIN)
+	copied.PriorityThreshold = copyShallowPointer(x.PriorityThreshold)
+	copied.Sequence = copyShallowPointer(x.Sequence)
+	copied.Status = copyShallowPointer(x.Status)
+	copied.TestStatus = copyShallowPointer(x.TestStatus)
+	copied.Timeframes = copySlice(x.Timeframes, func(x *DeviceTimeframe) *DeviceTimeframe { return x.Copy() })
+	copied.TwoWayDevice = copyShallowPointer(x.TwoWayDevice)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DevicePagination) Equal(other *DevicePagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Devices, other.Devices, func(x, y *Device) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DevicePagination) Copy() *DevicePagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Devices = copySlice(x.Devices, func(x *Device) *Device { return x.Copy() })
+	copied.Pagination = x.Pagination.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DeviceReference) Equal(other *DeviceReference) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.TargetName, other.TargetName) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !equalComparablePointer(x.DeviceType, other.DeviceType) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DeviceReference) Copy() *DeviceReference {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.TargetName = copyShallowPointer(x.TargetName)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.DeviceType = copyShallowPointer(x.DeviceType)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DeviceTimeframe) Equal(other *DeviceTimeframe) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !equalComparablePointer(x.StartTime, other.StartTime) {
+		return false
+	}
+	if !equalComparablePointer(x.DurationInMinutes, other.DurationInMinutes) {
+		return false
+	}
+	if !equalSlice(x.Days, other.Days, func(x, y *string) bool { return equalComparablePointer(x, y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.ExcludeHolidays, other.ExcludeHolidays) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DeviceTimeframe) Copy() *DeviceTimeframe {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Name = copyShallowPointer(x.Name)
+	copied.StartTime = copyShallowPointer(x.StartTime)
+	copied.DurationInMinutes = copyShallowPointer(x.DurationInMinutes)
+	copied.Days = copySlice(x.Days, func(x *string) *string { return copyShallowPointer(x) })
+	copied.ExcludeHolidays = copyShallowPointer(x.ExcludeHolidays)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DeviceTimeframePagination) Equal(other *DeviceTimeframePagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Data, other.Data, func(x, y *DeviceTimeframe) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DeviceTimeframePagination) Copy() *DeviceTimeframePagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Data = copySlice(x.Data, func(x *DeviceTimeframe) *DeviceTimeframe { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DriftItem) Equal(other *DriftItem) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Resource != other.Resource {
+		return false
+	}
+	if x.Key != other.Key {
+		return false
+	}
+	if !equalSlice(x.Fields, other.Fields, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DriftItem) Copy() *DriftItem {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Fields = copySlice(x.Fields, func(x string) string { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DriftReport) Equal(other *DriftReport) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Missing, other.Missing, func(x, y *DriftItem) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Extra, other.Extra, func(x, y *DriftItem) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Differing, other.Differing, func(x, y *DriftItem) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DriftReport) Copy() *DriftReport {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Missing = copySlice(x.Missing, func(x *DriftItem) *DriftItem { return x.Copy() })
+	copied.Extra = copySlice(x.Extra, func(x *DriftItem) *DriftItem { return x.Copy() })
+	copied.Differing = copySlice(x.Differing, func(x *DriftItem) *DriftItem { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DynamicTeam) Equal(other *DynamicTeam) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.TargetName, other.TargetName) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !equalComparablePointer(x.ResponseCount, other.ResponseCount) {
+		return false
+	}
+	if !equalComparablePointer(x.ResponseCountThreshold, other.ResponseCountThreshold) {
+		return false
+	}
+	if !equalComparablePointer(x.UseEmergencyDevice, other.UseEmergencyDevice) {
+		return false
+	}
+	if !equalComparablePointer(x.ObservedByAll, other.ObservedByAll) {
+		return false
+	}
+	if !equalSlice(x.Criteria, other.Criteria, func(x, y *DynamicTeamCriterion) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DynamicTeam) Copy() *DynamicTeam {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.TargetName = copyShallowPointer(x.TargetName)
+	copied.Description = copyShallowPointer(x.Description)
+	copied.ResponseCount = copyShallowPointer(x.ResponseCount)
+	copied.ResponseCountThreshold = copyShallowPointer(x.ResponseCountThreshold)
+	copied.UseEmergencyDevice = copyShallowPointer(x.UseEmergencyDevice)
+	copied.ObservedByAll = copyShallowPointer(x.ObservedByAll)
+	copied.Criteria = copySlice(x.Criteria, func(x *DynamicTeamCriterion) *DynamicTeamCriterion { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DynamicTeamCriterion) Equal(other *DynamicTeamCriterion) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.CriterionType, other.CriterionType) {
+		return false
+	}
+	if !equalComparablePointer(x.Field, other.Field) {
+		return false
+	}
+	if !equalComparablePointer(x.Operand, other.Operand) {
+		return false
+	}
+	if !equalComparablePointer(x.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DynamicTeamCriterion) Copy() *DynamicTeamCriterion {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.CriterionType = copyShallowPointer(x.CriterionType)
+	copied.Field = copyShallowPointer(x.Field)
+	copied.Operand = copyShallowPointer(x.Operand)
+	copied.Value = copyShallowPointer(x.Value)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *DynamicTeamPagination) Equal(other *DynamicTeamPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.DynamicTeams, other.DynamicTeams, func(x, y *DynamicTeam) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *DynamicTeamPagination) Copy() *DynamicTeamPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.DynamicTeams = copySlice(x.DynamicTeams, func(x *DynamicTeam) *DynamicTeam { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EngageIncidentParams) Equal(other *EngageIncidentParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Recipients, other.Recipients, func(x, y *EventRecipient) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.Message, other.Message) {
+		return false
+	}
+	if x.Priority != other.Priority {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EngageIncidentParams) Copy() *EngageIncidentParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Recipients = copySlice(x.Recipients, func(x *EventRecipient) *EventRecipient { return x.Copy() })
+	copied.Message = copyShallowPointer(x.Message)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Event) Equal(other *Event) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.EventID, other.EventID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !equalComparablePointer(x.Status, other.Status) {
+		return false
+	}
+	if !equalComparablePointer(x.Priority, other.Priority) {
+		return false
+	}
+	if !equalComparablePointer(x.Incident, other.Incident) {
+		return false
+	}
+	if !equalComparablePointer(x.RequestID, other.RequestID) {
+		return false
+	}
+	if !equalPointer(x.Created, other.Created, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.Terminated, other.Terminated, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !x.Submitter.Equal(other.Submitter) {
+		return false
+	}
+	if !x.Plan.Equal(other.Plan) {
+		return false
+	}
+	if !x.Form.Equal(other.Form) {
+		return false
+	}
+	if !equalComparablePointer(x.BypassPhoneIntro, other.BypassPhoneIntro) {
+		return false
+	}
+	if !equalComparablePointer(x.ExpirationInMinutes, other.ExpirationInMinutes) {
+		return false
+	}
+	if !equalComparablePointer(x.OverrideDeviceRestrictions, other.OverrideDeviceRestrictions) {
+		return false
+	}
+	if !equalComparablePointer(x.RequirePhonePassword, other.RequirePhonePassword) {
+		return false
+	}
+	if !x.Conference.Equal(other.Conference) {
+		return false
+	}
+	if !equalMap(x.Properties, other.Properties, func(x, y interface{}) bool { return reflect.DeepEqual(x, y) }) {
+		return false
+	}
+	if !equalSlice(x.Annotations, other.Annotations, func(x, y *EventAnnotation) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.ResponseOptions, other.ResponseOptions, func(x, y *ResponseOption) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Recipients, other.Recipients, func(x, y *RecipientReference) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.TargetedRecipients, other.TargetedRecipients, func(x, y *RecipientReference) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Event) Copy() *Event {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.EventID = copyShallowPointer(x.EventID)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.Status = copyShallowPointer(x.Status)
+	copied.Priority = copyShallowPointer(x.Priority)
+	copied.Incident = copyShallowPointer(x.Incident)
+	copied.RequestID = copyShallowPointer(x.RequestID)
+	copied.Created = copyShallowPointer(x.Created)
+	copied.Terminated = copyShallowPointer(x.Terminated)
+	copied.Submitter = x.Submitter.Copy()
+	copied.Plan = x.Plan.Copy()
+	copied.Form = x.Form.Copy()
+	copied.BypassPhoneIntro = copyShallowPointer(x.BypassPhoneIntro)
+	copied.ExpirationInMinutes = copyShallowPointer(x.ExpirationInMinutes)
+	copied.OverrideDeviceRestrictions = copyShallowPointer(x.OverrideDeviceRestrictions)
+	copied.RequirePhonePassword = copyShallowPointer(x.RequirePhonePassword)
+	copied.Conference = x.Conference.Copy()
+	copied.Properties = copyMap(x.Properties, func(x interface{}) interface{} { return copyInterface(x) })
+	copied.Annotations = copySlice(x.Annotations, func(x *EventAnnotation) *EventAnnotation { return x.Copy() })
+	copied.ResponseOptions = copySlice(x.ResponseOptions, func(x *ResponseOption) *ResponseOption { return x.Copy() })
+	copied.Recipients = copySlice(x.Recipients, func(x *RecipientReference) *RecipientReference { return x.Copy() })
+	copied.TargetedRecipients = copySlice(x.TargetedRecipients, func(x *RecipientReference) *RecipientReference { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventAnnotation) Equal(other *EventAnnotation) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !x.Author.Equal(other.Author) {
+		return false
+	}
+	if !equalComparablePointer(x.Comment, other.Comment) {
+		return false
+	}
+	if !equalPointer(x.Created, other.Created, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventAnnotation) Copy() *EventAnnotation {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Author = x.Author.Copy()
+	copied.Comment = copyShallowPointer(x.Comment)
+	copied.Created = copyShallowPointer(x.Created)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventFailedError) Equal(other *EventFailedError) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.RequestID != other.RequestID {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	if !x.Event.Equal(&other.Event) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventFailedError) Copy() *EventFailedError {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Event = *x.Event.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventMetrics) Equal(other *EventMetrics) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalPointer(x.From, other.From, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.To, other.To, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.TotalEvents != other.TotalEvents {
+		return false
+	}
+	if !equalMap(x.EventsByPriority, other.EventsByPriority, func(x, y int64) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.EventsByStatus, other.EventsByStatus, func(x, y int64) bool { return x == y }) {
+		return false
+	}
+	if x.AcknowledgedEvents != other.AcknowledgedEvents {
+		return false
+	}
+	if x.MeanTimeToAcknowledgeSeconds != other.MeanTimeToAcknowledgeSeconds {
+		return false
+	}
+	if !equalSlice(x.Groups, other.Groups, func(x, y *GroupResponseRate) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventMetrics) Copy() *EventMetrics {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.From = copyShallowPointer(x.From)
+	copied.To = copyShallowPointer(x.To)
+	copied.EventsByPriority = copyMap(x.EventsByPriority, func(x int64) int64 { return x })
+	copied.EventsByStatus = copyMap(x.EventsByStatus, func(x int64) int64 { return x })
+	copied.Groups = copySlice(x.Groups, func(x *GroupResponseRate) *GroupResponseRate { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventMetricsParams) Equal(other *EventMetricsParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalPointer(x.From, other.From, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.To, other.To, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !x.Events.Equal(&other.Events) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventMetricsParams) Copy() *EventMetricsParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.From = copyShallowPointer(x.From)
+	copied.To = copyShallowPointer(x.To)
+	copied.Events = *x.Events.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventPagination) Equal(other *EventPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Events, other.Events, func(x, y *Event) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventPagination) Copy() *EventPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Events = copySlice(x.Events, func(x *Event) *Event { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventRecipient) Equal(other *EventRecipient) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if x.TargetName != other.TargetName {
+		return false
+	}
+	if x.RecipientType != other.RecipientType {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventRecipient) Copy() *EventRecipient {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventReference) Equal(other *EventReference) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.EventID, other.EventID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventReference) Copy() *EventReference {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.EventID = copyShallowPointer(x.EventID)
+	copied.Name = copyShallowPointer(x.Name)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventResponse) Equal(other *EventResponse) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !x.Event.Equal(other.Event) {
+		return false
+	}
+	if !x.Person.Equal(other.Person) {
+		return false
+	}
+	if !x.Response.Equal(other.Response) {
+		return false
+	}
+	if !equalComparablePointer(x.Comment, other.Comment) {
+		return false
+	}
+	if !x.Device.Equal(other.Device) {
+		return false
+	}
+	if !equalPointer(x.Received, other.Received, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventResponse) Copy() *EventResponse {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Event = x.Event.Copy()
+	copied.Person = x.Person.Copy()
+	copied.Response = x.Response.Copy()
+	copied.Comment = copyShallowPointer(x.Comment)
+	copied.Device = x.Device.Copy()
+	copied.Received = copyShallowPointer(x.Received)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventSchedule) Equal(other *EventSchedule) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.StartTime.Equal(other.StartTime) {
+		return false
+	}
+	if x.Timezone != other.Timezone {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventSchedule) Copy() *EventSchedule {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventSuppression) Equal(other *EventSuppression) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Event.Equal(other.Event) {
+		return false
+	}
+	if !x.Match.Equal(other.Match) {
+		return false
+	}
+	if !x.Integration.Equal(other.Integration) {
+		return false
+	}
+	if !equalPointer(x.At, other.At, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventSuppression) Copy() *EventSuppression {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Event = x.Event.Copy()
+	copied.Match = x.Match.Copy()
+	copied.Integration = x.Integration.Copy()
+	copied.At = copyShallowPointer(x.At)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventSuppressionCount) Equal(other *EventSuppressionCount) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Integration != other.Integration {
+		return false
+	}
+	if x.Day != other.Day {
+		return false
+	}
+	if x.Count != other.Count {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventSuppressionCount) Copy() *EventSuppressionCount {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventSuppressionPagination) Equal(other *EventSuppressionPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Suppressions, other.Suppressions, func(x, y *EventSuppression) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventSuppressionPagination) Copy() *EventSuppressionPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Suppressions = copySlice(x.Suppressions, func(x *EventSuppression) *EventSuppression { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventTemplate) Equal(other *EventTemplate) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.FormID != other.FormID {
+		return false
+	}
+	if x.Priority != other.Priority {
+		return false
+	}
+	if !equalMap(x.Properties, other.Properties, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalSlice(x.Recipients, other.Recipients, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventTemplate) Copy() *EventTemplate {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Properties = copyMap(x.Properties, func(x string) string { return x })
+	copied.Recipients = copySlice(x.Recipients, func(x string) string { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *EventTrigger) Equal(other *EventTrigger) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.RequestID, other.RequestID) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *EventTrigger) Copy() *EventTrigger {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.RequestID = copyShallowPointer(x.RequestID)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ExportProgress) Equal(other *ExportProgress) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Resource != other.Resource {
+		return false
+	}
+	if x.Count != other.Count {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ExportProgress) Copy() *ExportProgress {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *FlowTrigger) Equal(other *FlowTrigger) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.RequestID, other.RequestID) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *FlowTrigger) Copy() *FlowTrigger {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.RequestID = copyShallowPointer(x.RequestID)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Form) Equal(other *Form) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !x.Plan.Equal(other.Plan) {
+		return false
+	}
+	if !equalComparablePointer(x.Enabled, other.Enabled) {
+		return false
+	}
+	if !equalComparablePointer(x.WebEnabled, other.WebEnabled) {
+		return false
+	}
+	if !equalComparablePointer(x.APIEnabled, other.APIEnabled) {
+		return false
+	}
+	if !equalComparablePointer(x.MobileEnabled, other.MobileEnabled) {
+		return false
+	}
+	if !equalSlice(x.Recipients, other.Recipients, func(x, y *RecipientReference) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.ResponseOptions, other.ResponseOptions, func(x, y *ResponseOption) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Form) Copy() *Form {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.Description = copyShallowPointer(x.Description)
+	copied.Plan = x.Plan.Copy()
+	copied.Enabled = copyShallowPointer(x.Enabled)
+	copied.WebEnabled = copyShallowPointer(x.WebEnabled)
+	copied.APIEnabled = copyShallowPointer(x.APIEnabled)
+	copied.MobileEnabled = copyShallowPointer(x.MobileEnabled)
+	copied.Recipients = copySlice(x.Recipients, func(x *RecipientReference) *RecipientReference { return x.Copy() })
+	copied.ResponseOptions = copySlice(x.ResponseOptions, func(x *ResponseOption) *ResponseOption { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *FormPagination) Equal(other *FormPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Forms, other.Forms, func(x, y *Form) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *FormPagination) Copy() *FormPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Forms = copySlice(x.Forms, func(x *Form) *Form { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *FormProperty) Equal(other *FormProperty) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !equalComparablePointer(x.PropertyType, other.PropertyType) {
+		return false
+	}
+	if !equalComparablePointer(x.MinLength, other.MinLength) {
+		return false
+	}
+	if !equalComparablePointer(x.MaxLength, other.MaxLength) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *FormProperty) Copy() *FormProperty {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.Description = copyShallowPointer(x.Description)
+	copied.PropertyType = copyShallowPointer(x.PropertyType)
+	copied.MinLength = copyShallowPointer(x.MinLength)
+	copied.MaxLength = copyShallowPointer(x.MaxLength)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *FormReference) Equal(other *FormReference) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *FormReference) Copy() *FormReference {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Name = copyShallowPointer(x.Name)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *FormSection) Equal(other *FormSection) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Type, other.Type) {
+		return false
+	}
+	if !equalComparablePointer(x.Title, other.Title) {
+		return false
+	}
+	if !equalSlice(x.Properties, other.Properties, func(x, y *FormProperty) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *FormSection) Copy() *FormSection {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Type = copyShallowPointer(x.Type)
+	copied.Title = copyShallowPointer(x.Title)
+	copied.Properties = copySlice(x.Properties, func(x *FormProperty) *FormProperty { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetAuditListParams) Equal(other *GetAuditListParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.EventID != other.EventID {
+		return false
+	}
+	if x.AuditType != other.AuditType {
+		return false
+	}
+	if x.By != other.By {
+		return false
+	}
+	if !equalPointer(x.From, other.From, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.To, other.To, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.SortOrder != other.SortOrder {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetAuditListParams) Copy() *GetAuditListParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.From = copyShallowPointer(x.From)
+	copied.To = copyShallowPointer(x.To)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetAuditsParams) Equal(other *GetAuditsParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.EventID != other.EventID {
+		return false
+	}
+	if x.AuditType != other.AuditType {
+		return false
+	}
+	if x.SortOrder != other.SortOrder {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetAuditsParams) Copy() *GetAuditsParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetChangeEventsParams) Equal(other *GetChangeEventsParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Search != other.Search {
+		return false
+	}
+	if x.Services != other.Services {
+		return false
+	}
+	if !equalPointer(x.From, other.From, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.To, other.To, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.SortOrder != other.SortOrder {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetChangeEventsParams) Copy() *GetChangeEventsParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.From = copyShallowPointer(x.From)
+	copied.To = copyShallowPointer(x.To)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetDevicesParams) Equal(other *GetDevicesParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Embed != other.Embed {
+		return false
+	}
+	if x.DeviceStatus != other.DeviceStatus {
+		return false
+	}
+	if x.DeviceType != other.DeviceType {
+		return false
+	}
+	if x.DeviceNames != other.DeviceNames {
+		return false
+	}
+	if x.ExternalKey != other.ExternalKey {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetDevicesParams) Copy() *GetDevicesParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetEventSuppressionsParams) Equal(other *GetEventSuppressionsParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalPointer(x.From, other.From, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.To, other.To, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.Integration != other.Integration {
+		return false
+	}
+	if x.SortOrder != other.SortOrder {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetEventSuppressionsParams) Copy() *GetEventSuppressionsParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.From = copyShallowPointer(x.From)
+	copied.To = copyShallowPointer(x.To)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetEventsParams) Equal(other *GetEventsParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Embed != other.Embed {
+		return false
+	}
+	if x.Search != other.Search {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	if x.Priority != other.Priority {
+		return false
+	}
+	if !equalPointer(x.From, other.From, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.To, other.To, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.RequestID != other.RequestID {
+		return false
+	}
+	if x.Submitter != other.Submitter {
+		return false
+	}
+	if x.Plan != other.Plan {
+		return false
+	}
+	if x.Form != other.Form {
+		return false
+	}
+	if x.TargetedRecipients != other.TargetedRecipients {
+		return false
+	}
+	if x.PropertyName != other.PropertyName {
+		return false
+	}
+	if x.PropertyValue != other.PropertyValue {
+		return false
+	}
+	if x.SortBy != other.SortBy {
+		return false
+	}
+	if x.SortOrder != other.SortOrder {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetEventsParams) Copy() *GetEventsParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.From = copyShallowPointer(x.From)
+	copied.To = copyShallowPointer(x.To)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetFormsParams) Equal(other *GetFormsParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Embed != other.Embed {
+		return false
+	}
+	if x.Search != other.Search {
+		return false
+	}
+	if !equalComparablePointer(x.Enabled, other.Enabled) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetFormsParams) Copy() *GetFormsParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Enabled = copyShallowPointer(x.Enabled)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetGroupsParams) Equal(other *GetGroupsParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Embed != other.Embed {
+		return false
+	}
+	if !x.SearchQuery.Equal(&other.SearchQuery) {
+		return false
+	}
+	if x.GroupType != other.GroupType {
+		return false
+	}
+	if x.MemberExists != other.MemberExists {
+		return false
+	}
+	if x.Members != other.Members {
+		return false
+	}
+	if x.Sites != other.Sites {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	if x.Supervisors != other.Supervisors {
+		return false
+	}
+	if x.SortBy != other.SortBy {
+		return false
+	}
+	if x.SortOrder != other.SortOrder {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetGroupsParams) Copy() *GetGroupsParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.SearchQuery = *x.SearchQuery.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetIncidentsParams) Equal(other *GetIncidentsParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Search != other.Search {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	if x.Severity != other.Severity {
+		return false
+	}
+	if x.ImpactedServices != other.ImpactedServices {
+		return false
+	}
+	if !equalPointer(x.From, other.From, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.To, other.To, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.SortBy != other.SortBy {
+		return false
+	}
+	if x.SortOrder != other.SortOrder {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetIncidentsParams) Copy() *GetIncidentsParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.From = copyShallowPointer(x.From)
+	copied.To = copyShallowPointer(x.To)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetIntegrationLogsParams) Equal(other *GetIntegrationLogsParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalPointer(x.From, other.From, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.To, other.To, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	if x.RequestID != other.RequestID {
+		return false
+	}
+	if x.SortOrder != other.SortOrder {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetIntegrationLogsParams) Copy() *GetIntegrationLogsParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.From = copyShallowPointer(x.From)
+	copied.To = copyShallowPointer(x.To)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetOnCallParams) Equal(other *GetOnCallParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Groups != other.Groups {
+		return false
+	}
+	if !equalPointer(x.From, other.From, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.To, other.To, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.At, other.At, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.MembersPerShift != other.MembersPerShift {
+		return false
+	}
+	if x.Embed != other.Embed {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetOnCallParams) Copy() *GetOnCallParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.From = copyShallowPointer(x.From)
+	copied.To = copyShallowPointer(x.To)
+	copied.At = copyShallowPointer(x.At)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetPeopleParams) Equal(other *GetPeopleParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Embed != other.Embed {
+		return false
+	}
+	if !x.SearchQuery.Equal(&other.SearchQuery) {
+		return false
+	}
+	if !equalPointer(x.CreatedAfter, other.CreatedAfter, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.CreatedBefore, other.CreatedBefore, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.CreatedFrom, other.CreatedFrom, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.CreatedTo, other.CreatedTo, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.DevicesExists, other.DevicesExists) {
+		return false
+	}
+	if !equalComparablePointer(x.DevicesEmailExists, other.DevicesEmailExists) {
+		return false
+	}
+	if !equalComparablePointer(x.DevicesFailsafe, other.DevicesFailsafe) {
+		return false
+	}
+	if !equalComparablePointer(x.DevicesMobile, other.DevicesMobile) {
+		return false
+	}
+	if !equalComparablePointer(x.DevicesSMS, other.DevicesSMS) {
+		return false
+	}
+	if !equalComparablePointer(x.DevicesVoice, other.DevicesVoice) {
+		return false
+	}
+	if x.DevicesStatus != other.DevicesStatus {
+		return false
+	}
+	if x.DevicesTestStatus != other.DevicesTestStatus {
+		return false
+	}
+	if x.EmailAddress != other.EmailAddress {
+		return false
+	}
+	if x.FirstName != other.FirstName {
+		return false
+	}
+	if x.Groups != other.Groups {
+		return false
+	}
+	if !equalComparablePointer(x.GroupsExists, other.GroupsExists) {
+		return false
+	}
+	if x.LastName != other.LastName {
+		return false
+	}
+	if x.LicenseType != other.LicenseType {
+		return false
+	}
+	if x.PhoneNumber != other.PhoneNumber {
+		return false
+	}
+	if x.Roles != other.Roles {
+		return false
+	}
+	if x.Site != other.Site {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	if x.Supervisors != other.Supervisors {
+		return false
+	}
+	if !equalComparablePointer(x.SupervisorsExists, other.SupervisorsExists) {
+		return false
+	}
+	if x.TargetName != other.TargetName {
+		return false
+	}
+	if x.WebLogin != other.WebLogin {
+		return false
+	}
+	if x.ExternalKey != other.ExternalKey {
+		return false
+	}
+	if x.SortBy != other.SortBy {
+		return false
+	}
+	if x.SortOrder != other.SortOrder {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetPeopleParams) Copy() *GetPeopleParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.SearchQuery = *x.SearchQuery.Copy()
+	copied.CreatedAfter = copyShallowPointer(x.CreatedAfter)
+	copied.CreatedBefore = copyShallowPointer(x.CreatedBefore)
+	copied.CreatedFrom = copyShallowPointer(x.CreatedFrom)
+	copied.CreatedTo = copyShallowPointer(x.CreatedTo)
+	copied.DevicesExists = copyShallowPointer(x.DevicesExists)
+	copied.DevicesEmailExists = copyShallowPointer(x.DevicesEmailExists)
+	copied.DevicesFailsafe = copyShallowPointer(x.DevicesFailsafe)
+	copied.DevicesMobile = copyShallowPointer(x.DevicesMobile)
+	copied.DevicesSMS = copyShallowPointer(x.DevicesSMS)
+	copied.DevicesVoice = copyShallowPointer(x.DevicesVoice)
+	copied.GroupsExists = copyShallowPointer(x.GroupsExists)
+	copied.SupervisorsExists = copyShallowPointer(x.SupervisorsExists)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetPlansParams) Equal(other *GetPlansParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Search != other.Search {
+		return false
+	}
+	if x.PlanType != other.PlanType {
+		return false
+	}
+	if !equalComparablePointer(x.Enabled, other.Enabled) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetPlansParams) Copy() *GetPlansParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Enabled = copyShallowPointer(x.Enabled)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetServiceDependenciesParams) Equal(other *GetServiceDependenciesParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Services != other.Services {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetServiceDependenciesParams) Copy() *GetServiceDependenciesParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetServicesParams) Equal(other *GetServicesParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Embed != other.Embed {
+		return false
+	}
+	if !x.SearchQuery.Equal(&other.SearchQuery) {
+		return false
+	}
+	if x.OwnedBy != other.OwnedBy {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetServicesParams) Copy() *GetServicesParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.SearchQuery = *x.SearchQuery.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetSitesParams) Equal(other *GetSitesParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.SearchQuery.Equal(&other.SearchQuery) {
+		return false
+	}
+	if x.Country != other.Country {
+		return false
+	}
+	if !equalComparablePointer(x.Geocoded, other.Geocoded) {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetSitesParams) Copy() *GetSitesParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.SearchQuery = *x.SearchQuery.Copy()
+	copied.Geocoded = copyShallowPointer(x.Geocoded)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetSubscriptionsParams) Equal(other *GetSubscriptionsParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Search != other.Search {
+		return false
+	}
+	if x.Form != other.Form {
+		return false
+	}
+	if x.Owner != other.Owner {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetSubscriptionsParams) Copy() *GetSubscriptionsParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetTemporaryAbsencesParams) Equal(other *GetTemporaryAbsencesParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Members != other.Members {
+		return false
+	}
+	if !equalPointer(x.From, other.From, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.To, other.To, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetTemporaryAbsencesParams) Copy() *GetTemporaryAbsencesParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.From = copyShallowPointer(x.From)
+	copied.To = copyShallowPointer(x.To)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GetUserDeliveriesParams) Equal(other *GetUserDeliveriesParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Embed != other.Embed {
+		return false
+	}
+	if x.DeliveryStatus != other.DeliveryStatus {
+		return false
+	}
+	if !equalComparablePointer(x.Responded, other.Responded) {
+		return false
+	}
+	if !equalPointer(x.At, other.At, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GetUserDeliveriesParams) Copy() *GetUserDeliveriesParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Responded = copyShallowPointer(x.Responded)
+	copied.At = copyShallowPointer(x.At)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Group) Equal(other *Group) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.TargetName, other.TargetName) {
+		return false
+	}
+	if !equalComparablePointer(x.Status, other.Status) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !equalComparablePointer(x.GroupType, other.GroupType) {
+		return false
+	}
+	if !equalComparablePointer(x.AllowDuplicates, other.AllowDuplicates) {
+		return false
+	}
+	if !equalComparablePointer(x.Timezone, other.Timezone) {
+		return false
+	}
+	if !x.Site.Equal(other.Site) {
+		return false
+	}
+	if !equalComparablePointer(x.ObservedByAll, other.ObservedByAll) {
+		return false
+	}
+	if !equalSlice(x.Observers, other.Observers, func(x, y *ReferenceByName) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.UseDefaultDevices, other.UseDefaultDevices) {
+		return false
+	}
+	if !equalSlice(x.Supervisors, other.Supervisors, func(x, y *ReferenceById) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Services, other.Services, func(x, y *Service) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.ExternalKey, other.ExternalKey) {
+		return false
+	}
+	if !equalComparablePointer(x.ExternallyOwned, other.ExternallyOwned) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Group) Copy() *Group {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.TargetName = copyShallowPointer(x.TargetName)
+	copied.Status = copyShallowPointer(x.Status)
+	copied.Description = copyShallowPointer(x.Description)
+	copied.GroupType = copyShallowPointer(x.GroupType)
+	copied.AllowDuplicates = copyShallowPointer(x.AllowDuplicates)
+	copied.Timezone = copyShallowPointer(x.Timezone)
+	copied.Site = x.Site.Copy()
+	copied.ObservedByAll = copyShallowPointer(x.ObservedByAll)
+	copied.Observers = copySlice(x.Observers, func(x *ReferenceByName) *ReferenceByName { return x.Copy() })
+	copied.UseDefaultDevices = copyShallowPointer(x.UseDefaultDevices)
+	copied.Supervisors = copySlice(x.Supervisors, func(x *ReferenceById) *ReferenceById { return x.Copy() })
+	copied.Services = copySlice(x.Services, func(x *Service) *Service { return x.Copy() })
+	copied.ExternalKey = copyShallowPointer(x.ExternalKey)
+	copied.ExternallyOwned = copyShallowPointer(x.ExternallyOwned)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GroupMember) Equal(other *GroupMember) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.MemberType, other.MemberType) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GroupMember) Copy() *GroupMember {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.MemberType = copyShallowPointer(x.MemberType)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GroupMembership) Equal(other *GroupMembership) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Group.Equal(&other.Group) {
+		return false
+	}
+	if !x.Member.Equal(&other.Member) {
+		return false
+	}
+	if !x.Shifts.Equal(&other.Shifts) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GroupMembership) Copy() *GroupMembership {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Group = *x.Group.Copy()
+	copied.Member = *x.Member.Copy()
+	copied.Shifts = *x.Shifts.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GroupMembershipPagination) Equal(other *GroupMembershipPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Memberships, other.Memberships, func(x, y *GroupMembership) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GroupMembershipPagination) Copy() *GroupMembershipPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = *x.Pagination.Copy()
+	copied.Memberships = copySlice(x.Memberships, func(x *GroupMembership) *GroupMembership { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GroupPagination) Equal(other *GroupPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Groups, other.Groups, func(x, y *Group) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GroupPagination) Copy() *GroupPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Groups = copySlice(x.Groups, func(x *Group) *Group { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GroupReference) Equal(other *GroupReference) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.TargetName, other.TargetName) {
+		return false
+	}
+	if !equalComparablePointer(x.RecipientType, other.RecipientType) {
+		return false
+	}
+	if !equalComparablePointer(x.GroupType, other.GroupType) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GroupReference) Copy() *GroupReference {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.TargetName = copyShallowPointer(x.TargetName)
+	copied.RecipientType = copyShallowPointer(x.RecipientType)
+	copied.GroupType = copyShallowPointer(x.GroupType)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GroupResponseRate) Equal(other *GroupResponseRate) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Group.Equal(other.Group) {
+		return false
+	}
+	if x.TargetedEvents != other.TargetedEvents {
+		return false
+	}
+	if x.AcknowledgedEvents != other.AcknowledgedEvents {
+		return false
+	}
+	if x.ResponseRate != other.ResponseRate {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GroupResponseRate) Copy() *GroupResponseRate {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Group = x.Group.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *GroupRoster) Equal(other *GroupRoster) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !x.Group.Equal(other.Group) {
+		return false
+	}
+	if !equalSlice(x.Members, other.Members, func(x, y *GroupMember) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *GroupRoster) Copy() *GroupRoster {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Group = x.Group.Copy()
+	copied.Members = copySlice(x.Members, func(x *GroupMember) *GroupMember { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Holiday) Equal(other *Holiday) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Name != other.Name {
+		return false
+	}
+	if x.Date != other.Date {
+		return false
+	}
+	if !equalSlice(x.Sites, other.Sites, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Holiday) Copy() *Holiday {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Sites = copySlice(x.Sites, func(x string) string { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *HolidayCalendar) Equal(other *HolidayCalendar) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.TimeZone != other.TimeZone {
+		return false
+	}
+	if !equalSlice(x.Holidays, other.Holidays, func(x, y *Holiday) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *HolidayCalendar) Copy() *HolidayCalendar {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Holidays = copySlice(x.Holidays, func(x *Holiday) *Holiday { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *HygieneFinding) Equal(other *HygieneFinding) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Type != other.Type {
+		return false
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if x.TargetName != other.TargetName {
+		return false
+	}
+	if x.Detail != other.Detail {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *HygieneFinding) Copy() *HygieneFinding {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *HygieneReportParams) Equal(other *HygieneReportParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.StaleLoginDays != other.StaleLoginDays {
+		return false
+	}
+	if x.IncludeNeverLoggedIn != other.IncludeNeverLoggedIn {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *HygieneReportParams) Copy() *HygieneReportParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ImportChange) Equal(other *ImportChange) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Resource != other.Resource {
+		return false
+	}
+	if x.Key != other.Key {
+		return false
+	}
+	if x.Action != other.Action {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ImportChange) Copy() *ImportChange {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ImportReport) Equal(other *ImportReport) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Changes, other.Changes, func(x, y *ImportChange) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Errors, other.Errors, func(x, y *ImportError) bool { return equalComparablePointer(x, y) }) {
+		return false
+	}
+	if !equalSlice(x.Skipped, other.Skipped, func(x, y SnapshotResource) bool { return x == y }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ImportReport) Copy() *ImportReport {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Changes = copySlice(x.Changes, func(x *ImportChange) *ImportChange { return x.Copy() })
+	copied.Errors = copySlice(x.Errors, func(x *ImportError) *ImportError { return copyShallowPointer(x) })
+	copied.Skipped = copySlice(x.Skipped, func(x SnapshotResource) SnapshotResource { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ImportServiceCatalogParams) Equal(other *ImportServiceCatalogParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.DryRun != other.DryRun {
+		return false
+	}
+	if x.Prune != other.Prune {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ImportServiceCatalogParams) Copy() *ImportServiceCatalogParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ImportedResource) Equal(other *ImportedResource) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Type != other.Type {
+		return false
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if !reflect.DeepEqual(x.Object, other.Object) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ImportedResource) Copy() *ImportedResource {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Object = copyInterface(x.Object)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Incident) Equal(other *Incident) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.IncidentIdentifier, other.IncidentIdentifier) {
+		return false
+	}
+	if !equalComparablePointer(x.Summary, other.Summary) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !equalComparablePointer(x.Severity, other.Severity) {
+		return false
+	}
+	if !equalComparablePointer(x.Status, other.Status) {
+		return false
+	}
+	if !equalPointer(x.Created, other.Created, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.Updated, other.Updated, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.ImpactedServices, other.ImpactedServices, func(x, y *ServiceReference) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Incident) Copy() *Incident {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.IncidentIdentifier = copyShallowPointer(x.IncidentIdentifier)
+	copied.Summary = copyShallowPointer(x.Summary)
+	copied.Description = copyShallowPointer(x.Description)
+	copied.Severity = copyShallowPointer(x.Severity)
+	copied.Status = copyShallowPointer(x.Status)
+	copied.Created = copyShallowPointer(x.Created)
+	copied.Updated = copyShallowPointer(x.Updated)
+	copied.ImpactedServices = copySlice(x.ImpactedServices, func(x *ServiceReference) *ServiceReference { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *IncidentEngagement) Equal(other *IncidentEngagement) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.RequestID, other.RequestID) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *IncidentEngagement) Copy() *IncidentEngagement {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.RequestID = copyShallowPointer(x.RequestID)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *IncidentPagination) Equal(other *IncidentPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Incidents, other.Incidents, func(x, y *Incident) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *IncidentPagination) Copy() *IncidentPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Incidents = copySlice(x.Incidents, func(x *Incident) *Incident { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *IncidentResolver) Equal(other *IncidentResolver) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Person.Equal(other.Person) {
+		return false
+	}
+	if !equalComparablePointer(x.Role, other.Role) {
+		return false
+	}
+	if !equalPointer(x.AddedAt, other.AddedAt, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *IncidentResolver) Copy() *IncidentResolver {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Person = x.Person.Copy()
+	copied.Role = copyShallowPointer(x.Role)
+	copied.AddedAt = copyShallowPointer(x.AddedAt)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *IncidentResolverPagination) Equal(other *IncidentResolverPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Resolvers, other.Resolvers, func(x, y *IncidentResolver) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *IncidentResolverPagination) Copy() *IncidentResolverPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Resolvers = copySlice(x.Resolvers, func(x *IncidentResolver) *IncidentResolver { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *InstanceHostname) Equal(other *InstanceHostname) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Instance != other.Instance {
+		return false
+	}
+	if x.Environment != other.Environment {
+		return false
+	}
+	if x.Region != other.Region {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *InstanceHostname) Copy() *InstanceHostname {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Integration) Equal(other *Integration) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !x.Plan.Equal(other.Plan) {
+		return false
+	}
+	if !equalComparablePointer(x.IntegrationType, other.IntegrationType) {
+		return false
+	}
+	if !equalComparablePointer(x.Operation, other.Operation) {
+		return false
+	}
+	if !x.Form.Equal(other.Form) {
+		return false
+	}
+	if !equalComparablePointer(x.Enabled, other.Enabled) {
+		return false
+	}
+	if !equalComparablePointer(x.Deployed, other.Deployed) {
+		return false
+	}
+	if !equalComparablePointer(x.AuthenticationMethod, other.AuthenticationMethod) {
+		return false
+	}
+	if !equalComparablePointer(x.Script, other.Script) {
+		return false
+	}
+	if !equalPointer(x.Created, other.Created, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Integration) Copy() *Integration {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.Plan = x.Plan.Copy()
+	copied.IntegrationType = copyShallowPointer(x.IntegrationType)
+	copied.Operation = copyShallowPointer(x.Operation)
+	copied.Form = x.Form.Copy()
+	copied.Enabled = copyShallowPointer(x.Enabled)
+	copied.Deployed = copyShallowPointer(x.Deployed)
+	copied.AuthenticationMethod = copyShallowPointer(x.AuthenticationMethod)
+	copied.Script = copyShallowPointer(x.Script)
+	copied.Created = copyShallowPointer(x.Created)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *IntegrationLog) Equal(other *IntegrationLog) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.RequestID, other.RequestID) {
+		return false
+	}
+	if !equalComparablePointer(x.Status, other.Status) {
+		return false
+	}
+	if !equalPointer(x.Created, other.Created, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.Completed, other.Completed, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.Request, other.Request) {
+		return false
+	}
+	if !equalComparablePointer(x.Log, other.Log) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *IntegrationLog) Copy() *IntegrationLog {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.RequestID = copyShallowPointer(x.RequestID)
+	copied.Status = copyShallowPointer(x.Status)
+	copied.Created = copyShallowPointer(x.Created)
+	copied.Completed = copyShallowPointer(x.Completed)
+	copied.Request = copyShallowPointer(x.Request)
+	copied.Log = copyShallowPointer(x.Log)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *IntegrationLogPagination) Equal(other *IntegrationLogPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Logs, other.Logs, func(x, y *IntegrationLog) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *IntegrationLogPagination) Copy() *IntegrationLogPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Logs = copySlice(x.Logs, func(x *IntegrationLog) *IntegrationLog { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *IntegrationPagination) Equal(other *IntegrationPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Integrations, other.Integrations, func(x, y *Integration) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *IntegrationPagination) Copy() *IntegrationPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Integrations = copySlice(x.Integrations, func(x *Integration) *Integration { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *IntegrationReference) Equal(other *IntegrationReference) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *IntegrationReference) Copy() *IntegrationReference {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Name = copyShallowPointer(x.Name)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *OnCall) Equal(other *OnCall) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Group.Equal(other.Group) {
+		return false
+	}
+	if !x.Shift.Equal(other.Shift) {
+		return false
+	}
+	if !equalPointer(x.Start, other.Start, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.End, other.End, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Members, other.Members, func(x, y *OnCallMember) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *OnCall) Copy() *OnCall {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Group = x.Group.Copy()
+	copied.Shift = x.Shift.Copy()
+	copied.Start = copyShallowPointer(x.Start)
+	copied.End = copyShallowPointer(x.End)
+	copied.Members = copySlice(x.Members, func(x *OnCallMember) *OnCallMember { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *OnCallCalendarParams) Equal(other *OnCallCalendarParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Group != other.Group {
+		return false
+	}
+	if !x.From.Equal(other.From) {
+		return false
+	}
+	if !x.To.Equal(other.To) {
+		return false
+	}
+	if !equalSlice(x.Members, other.Members, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if x.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *OnCallCalendarParams) Copy() *OnCallCalendarParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Members = copySlice(x.Members, func(x string) string { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *OnCallMember) Equal(other *OnCallMember) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.Position, other.Position) {
+		return false
+	}
+	if !equalComparablePointer(x.Delay, other.Delay) {
+		return false
+	}
+	if !equalComparablePointer(x.EscalationType, other.EscalationType) {
+		return false
+	}
+	if !x.Member.Equal(other.Member) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *OnCallMember) Copy() *OnCallMember {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Position = copyShallowPointer(x.Position)
+	copied.Delay = copyShallowPointer(x.Delay)
+	copied.EscalationType = copyShallowPointer(x.EscalationType)
+	copied.Member = x.Member.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *OnCallMemberPagination) Equal(other *OnCallMemberPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Members, other.Members, func(x, y *OnCallMember) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *OnCallMemberPagination) Copy() *OnCallMemberPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Members = copySlice(x.Members, func(x *OnCallMember) *OnCallMember { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *OnCallPagination) Equal(other *OnCallPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.OnCalls, other.OnCalls, func(x, y *OnCall) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *OnCallPagination) Copy() *OnCallPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.OnCalls = copySlice(x.OnCalls, func(x *OnCall) *OnCall { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *OnCallReport) Equal(other *OnCallReport) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.GeneratedAt != other.GeneratedAt {
+		return false
+	}
+	if !equalSlice(x.Rows, other.Rows, func(x, y *OnCallReportRow) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *OnCallReport) Copy() *OnCallReport {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Rows = copySlice(x.Rows, func(x *OnCallReportRow) *OnCallReportRow { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *OnCallReportParams) Equal(other *OnCallReportParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Groups.Equal(&other.Groups) {
+		return false
+	}
+	if !x.At.Equal(other.At) {
+		return false
+	}
+	if x.Lookahead != other.Lookahead {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *OnCallReportParams) Copy() *OnCallReportParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Groups = *x.Groups.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *OnCallReportRow) Equal(other *OnCallReportRow) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Group != other.Group {
+		return false
+	}
+	if x.Shift != other.Shift {
+		return false
+	}
+	if x.Position != other.Position {
+		return false
+	}
+	if x.Current != other.Current {
+		return false
+	}
+	if x.CurrentUntil != other.CurrentUntil {
+		return false
+	}
+	if x.Replacing != other.Replacing {
+		return false
+	}
+	if x.Next != other.Next {
+		return false
+	}
+	if x.NextFrom != other.NextFrom {
+		return false
+	}
+	if !equalSlice(x.Devices, other.Devices, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *OnCallReportRow) Copy() *OnCallReportRow {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Devices = copySlice(x.Devices, func(x string) string { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Pagination) Equal(other *Pagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.Count, other.Count) {
+		return false
+	}
+	if !equalComparablePointer(x.Total, other.Total) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Pagination) Copy() *Pagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Count = copyShallowPointer(x.Count)
+	copied.Total = copyShallowPointer(x.Total)
+	copied.Links = x.Links.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PaginationLinks) Equal(other *PaginationLinks) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.Next, other.Next) {
+		return false
+	}
+	if !equalComparablePointer(x.Previous, other.Previous) {
+		return false
+	}
+	if !equalComparablePointer(x.Self, other.Self) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PaginationLinks) Copy() *PaginationLinks {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Next = copyShallowPointer(x.Next)
+	copied.Previous = copyShallowPointer(x.Previous)
+	copied.Self = copyShallowPointer(x.Self)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Person) Equal(other *Person) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.TargetName, other.TargetName) {
+		return false
+	}
+	if !equalComparablePointer(x.FirstName, other.FirstName) {
+		return false
+	}
+	if !equalComparablePointer(x.LastName, other.LastName) {
+		return false
+	}
+	if !equalSlice(x.Roles, other.Roles, func(x, y *Role) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.Status, other.Status) {
+		return false
+	}
+	if !equalComparablePointer(x.WebLogin, other.WebLogin) {
+		return false
+	}
+	if !x.Site.Equal(other.Site) {
+		return false
+	}
+	if !equalComparablePointer(x.Timezone, other.Timezone) {
+		return false
+	}
+	if !equalComparablePointer(x.Language, other.Language) {
+		return false
+	}
+	if !equalSlice(x.Supervisors, other.Supervisors, func(x, y *Person) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.PhoneLogin, other.PhoneLogin) {
+		return false
+	}
+	if !equalComparablePointer(x.LicenseType, other.LicenseType) {
+		return false
+	}
+	if !equalComparablePointer(x.ExternalKey, other.ExternalKey) {
+		return false
+	}
+	if !equalComparablePointer(x.ExternallyOwned, other.ExternallyOwned) {
+		return false
+	}
+	if !equalPointer(x.LastLogin, other.LastLogin, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Person) Copy() *Person {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.TargetName = copyShallowPointer(x.TargetName)
+	copied.FirstName = copyShallowPointer(x.FirstName)
+	copied.LastName = copyShallowPointer(x.LastName)
+	copied.Roles = copySlice(x.Roles, func(x *Role) *Role { return x.Copy() })
+	copied.Status = copyShallowPointer(x.Status)
+	copied.WebLogin = copyShallowPointer(x.WebLogin)
+	copied.Site = x.Site.Copy()
+	copied.Timezone = copyShallowPointer(x.Timezone)
+	copied.Language = copyShallowPointer(x.Language)
+	copied.Supervisors = copySlice(x.Supervisors, func(x *Person) *Person { return x.Copy() })
+	copied.PhoneLogin = copyShallowPointer(x.PhoneLogin)
+	copied.LicenseType = copyShallowPointer(x.LicenseType)
+	copied.ExternalKey = copyShallowPointer(x.ExternalKey)
+	copied.ExternallyOwned = copyShallowPointer(x.ExternallyOwned)
+	copied.LastLogin = copyShallowPointer(x.LastLogin)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PersonPagination) Equal(other *PersonPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.People, other.People, func(x, y *Person) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PersonPagination) Copy() *PersonPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.People = copySlice(x.People, func(x *Person) *Person { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PersonReference) Equal(other *PersonReference) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.TargetName, other.TargetName) {
+		return false
+	}
+	if !equalComparablePointer(x.FirstName, other.FirstName) {
+		return false
+	}
+	if !equalComparablePointer(x.LastName, other.LastName) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PersonReference) Copy() *PersonReference {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.TargetName = copyShallowPointer(x.TargetName)
+	copied.FirstName = copyShallowPointer(x.FirstName)
+	copied.LastName = copyShallowPointer(x.LastName)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Plan) Equal(other *Plan) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !equalComparablePointer(x.PlanType, other.PlanType) {
+		return false
+	}
+	if !equalComparablePointer(x.Enabled, other.Enabled) {
+		return false
+	}
+	if !equalComparablePointer(x.Editable, other.Editable) {
+		return false
+	}
+	if !equalComparablePointer(x.AccessibleByAll, other.AccessibleByAll) {
+		return false
+	}
+	if !equalComparablePointer(x.FloodControl, other.FloodControl) {
+		return false
+	}
+	if !x.Creator.Equal(other.Creator) {
+		return false
+	}
+	if !equalPointer(x.Created, other.Created, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Plan) Copy() *Plan {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.Description = copyShallowPointer(x.Description)
+	copied.PlanType = copyShallowPointer(x.PlanType)
+	copied.Enabled = copyShallowPointer(x.Enabled)
+	copied.Editable = copyShallowPointer(x.Editable)
+	copied.AccessibleByAll = copyShallowPointer(x.AccessibleByAll)
+	copied.FloodControl = copyShallowPointer(x.FloodControl)
+	copied.Creator = x.Creator.Copy()
+	copied.Created = copyShallowPointer(x.Created)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PlanPagination) Equal(other *PlanPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Plans, other.Plans, func(x, y *Plan) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PlanPagination) Copy() *PlanPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Plans = copySlice(x.Plans, func(x *Plan) *Plan { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PlanReference) Equal(other *PlanReference) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PlanReference) Copy() *PlanReference {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Name = copyShallowPointer(x.Name)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PostChangeEventParams) Equal(other *PostChangeEventParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Summary != other.Summary {
+		return false
+	}
+	if !equalSlice(x.Services, other.Services, func(x, y *ReferenceById) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !equalComparablePointer(x.ChangeType, other.ChangeType) {
+		return false
+	}
+	if !equalComparablePointer(x.Source, other.Source) {
+		return false
+	}
+	if !equalComparablePointer(x.ExternalURL, other.ExternalURL) {
+		return false
+	}
+	if !equalMap(x.Properties, other.Properties, func(x, y interface{}) bool { return reflect.DeepEqual(x, y) }) {
+		return false
+	}
+	if !equalPointer(x.Occurred, other.Occurred, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PostChangeEventParams) Copy() *PostChangeEventParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Services = copySlice(x.Services, func(x *ReferenceById) *ReferenceById { return x.Copy() })
+	copied.Description = copyShallowPointer(x.Description)
+	copied.ChangeType = copyShallowPointer(x.ChangeType)
+	copied.Source = copyShallowPointer(x.Source)
+	copied.ExternalURL = copyShallowPointer(x.ExternalURL)
+	copied.Properties = copyMap(x.Properties, func(x interface{}) interface{} { return copyInterface(x) })
+	copied.Occurred = copyShallowPointer(x.Occurred)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Progress) Equal(other *Progress) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Operation != other.Operation {
+		return false
+	}
+	if x.Done != other.Done {
+		return false
+	}
+	if x.Total != other.Total {
+		return false
+	}
+	if x.Page != other.Page {
+		return false
+	}
+	if x.Elapsed != other.Elapsed {
+		return false
+	}
+	if x.ETA != other.ETA {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Progress) Copy() *Progress {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PushDeviceParams) Equal(other *PushDeviceParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.DeviceType != other.DeviceType {
+		return false
+	}
+	if x.Name != other.Name {
+		return false
+	}
+	if x.Owner != other.Owner {
+		return false
+	}
+	if !equalComparablePointer(x.Sequence, other.Sequence) {
+		return false
+	}
+	if x.PriorityThreshold != other.PriorityThreshold {
+		return false
+	}
+	if x.TestStatus != other.TestStatus {
+		return false
+	}
+	if !equalSlice(x.Timeframes, other.Timeframes, func(x, y *DeviceTimeframe) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if x.Country != other.Country {
+		return false
+	}
+	if !equalComparablePointer(x.DefaultDevice, other.DefaultDevice) {
+		return false
+	}
+	if !equalMap(x.Delay, other.Delay, func(x, y int32) bool { return x == y }) {
+		return false
+	}
+	if x.EmailAddress != other.EmailAddress {
+		return false
+	}
+	if !equalMap(x.ExternalKey, other.ExternalKey, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.ExternallyOwned, other.ExternallyOwned, func(x, y bool) bool { return x == y }) {
+		return false
+	}
+	if x.PhoneNumber != other.PhoneNumber {
+		return false
+	}
+	if x.PIN != other.PIN {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	if !equalMap(x.TwoWayDevice, other.TwoWayDevice, func(x, y bool) bool { return x == y }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PushDeviceParams) Copy() *PushDeviceParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Sequence = copyShallowPointer(x.Sequence)
+	copied.Timeframes = copySlice(x.Timeframes, func(x *DeviceTimeframe) *DeviceTimeframe { return x.Copy() })
+	copied.DefaultDevice = copyShallowPointer(x.DefaultDevice)
+	copied.Delay = copyMap(x.Delay, func(x int32) int32 { return x })
+	copied.ExternalKey = copyMap(x.ExternalKey, func(x string) string { return x })
+	copied.ExternallyOwned = copyMap(x.ExternallyOwned, func(x bool) bool { return x })
+	copied.TwoWayDevice = copyMap(x.TwoWayDevice, func(x bool) bool { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PushGroupParams) Equal(other *PushGroupParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if x.TargetName != other.TargetName {
+		return false
+	}
+	if !equalComparablePointer(x.AllowDuplicates, other.AllowDuplicates) {
+		return false
+	}
+	if x.Description != other.Description {
+		return false
+	}
+	if !equalMap(x.ExternalKey, other.ExternalKey, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.ExternallyOwned, other.ExternallyOwned, func(x, y bool) bool { return x == y }) {
+		return false
+	}
+	if x.GroupType != other.GroupType {
+		return false
+	}
+	if !equalComparablePointer(x.ObservedByAll, other.ObservedByAll) {
+		return false
+	}
+	if !equalSlice(x.Observers, other.Observers, func(x, y *ReferenceByName) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.Site != other.Site {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	if !equalComparablePointer(x.UseDefaultDevices, other.UseDefaultDevices) {
+		return false
+	}
+	if !equalSlice(x.Supervisors, other.Supervisors, func(x, y *ReferenceById) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PushGroupParams) Copy() *PushGroupParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.AllowDuplicates = copyShallowPointer(x.AllowDuplicates)
+	copied.ExternalKey = copyMap(x.ExternalKey, func(x string) string { return x })
+	copied.ExternallyOwned = copyMap(x.ExternallyOwned, func(x bool) bool { return x })
+	copied.ObservedByAll = copyShallowPointer(x.ObservedByAll)
+	copied.Observers = copySlice(x.Observers, func(x *ReferenceByName) *ReferenceByName { return x.Copy() })
+	copied.UseDefaultDevices = copyShallowPointer(x.UseDefaultDevices)
+	copied.Supervisors = copySlice(x.Supervisors, func(x *ReferenceById) *ReferenceById { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PushIntegrationParams) Equal(other *PushIntegrationParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.PlanID != other.PlanID {
+		return false
+	}
+	if x.Name != other.Name {
+		return false
+	}
+	if x.IntegrationType != other.IntegrationType {
+		return false
+	}
+	if x.Operation != other.Operation {
+		return false
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if !x.Form.Equal(other.Form) {
+		return false
+	}
+	if !equalComparablePointer(x.Enabled, other.Enabled) {
+		return false
+	}
+	if !equalComparablePointer(x.AuthenticationMethod, other.AuthenticationMethod) {
+		return false
+	}
+	if !equalComparablePointer(x.Script, other.Script) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PushIntegrationParams) Copy() *PushIntegrationParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Form = x.Form.Copy()
+	copied.Enabled = copyShallowPointer(x.Enabled)
+	copied.AuthenticationMethod = copyShallowPointer(x.AuthenticationMethod)
+	copied.Script = copyShallowPointer(x.Script)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PushPersonParams) Equal(other *PushPersonParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.TargetName != other.TargetName {
+		return false
+	}
+	if x.FirstName != other.FirstName {
+		return false
+	}
+	if x.LastName != other.LastName {
+		return false
+	}
+	if !equalSlice(x.Roles, other.Roles, func(x, y *string) bool { return equalComparablePointer(x, y) }) {
+		return false
+	}
+	if x.LicenseType != other.LicenseType {
+		return false
+	}
+	if x.Site != other.Site {
+		return false
+	}
+	if x.Language != other.Language {
+		return false
+	}
+	if !equalSlice(x.Supervisors, other.Supervisors, func(x, y *string) bool { return equalComparablePointer(x, y) }) {
+		return false
+	}
+	if x.Timezone != other.Timezone {
+		return false
+	}
+	if x.WebLogin != other.WebLogin {
+		return false
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	if !equalMap(x.PhoneLogin, other.PhoneLogin, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if x.PhonePin != other.PhonePin {
+		return false
+	}
+	if !equalMap(x.ExternalKey, other.ExternalKey, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.ExternallyOwned, other.ExternallyOwned, func(x, y bool) bool { return x == y }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PushPersonParams) Copy() *PushPersonParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Roles = copySlice(x.Roles, func(x *string) *string { return copyShallowPointer(x) })
+	copied.Supervisors = copySlice(x.Supervisors, func(x *string) *string { return copyShallowPointer(x) })
+	copied.PhoneLogin = copyMap(x.PhoneLogin, func(x string) string { return x })
+	copied.ExternalKey = copyMap(x.ExternalKey, func(x string) string { return x })
+	copied.ExternallyOwned = copyMap(x.ExternallyOwned, func(x bool) bool { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PushPlanParams) Equal(other *PushPlanParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Name != other.Name {
+		return false
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if x.PlanType != other.PlanType {
+		return false
+	}
+	if !equalComparablePointer(x.Enabled, other.Enabled) {
+		return false
+	}
+	if !equalComparablePointer(x.AccessibleByAll, other.AccessibleByAll) {
+		return false
+	}
+	if !equalComparablePointer(x.FloodControl, other.FloodControl) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PushPlanParams) Copy() *PushPlanParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Description = copyShallowPointer(x.Description)
+	copied.Enabled = copyShallowPointer(x.Enabled)
+	copied.AccessibleByAll = copyShallowPointer(x.AccessibleByAll)
+	copied.FloodControl = copyShallowPointer(x.FloodControl)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PushServiceDependencyParams) Equal(other *PushServiceDependencyParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if x.ServiceID != other.ServiceID {
+		return false
+	}
+	if x.DependentServiceID != other.DependentServiceID {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PushServiceDependencyParams) Copy() *PushServiceDependencyParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PushServiceParams) Equal(other *PushServiceParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if x.TargetName != other.TargetName {
+		return false
+	}
+	if !equalMap(x.Description, other.Description, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if x.ServiceType != other.ServiceType {
+		return false
+	}
+	if !equalMap(x.ServiceTier, other.ServiceTier, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.OwnedBy, other.OwnedBy, func(x, y *GroupReference) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.ServiceLinks, other.ServiceLinks, func(x, y *ServiceLink) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PushServiceParams) Copy() *PushServiceParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Description = copyMap(x.Description, func(x string) string { return x })
+	copied.ServiceTier = copyMap(x.ServiceTier, func(x string) string { return x })
+	copied.OwnedBy = copyMap(x.OwnedBy, func(x *GroupReference) *GroupReference { return x.Copy() })
+	copied.ServiceLinks = copySlice(x.ServiceLinks, func(x *ServiceLink) *ServiceLink { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PushShiftMember) Equal(other *PushShiftMember) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Recipient.Equal(other.Recipient) {
+		return false
+	}
+	if !equalComparablePointer(x.Position, other.Position) {
+		return false
+	}
+	if !equalComparablePointer(x.Delay, other.Delay) {
+		return false
+	}
+	if !equalComparablePointer(x.EscalationType, other.EscalationType) {
+		return false
+	}
+	if !equalComparablePointer(x.InRotation, other.InRotation) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PushShiftMember) Copy() *PushShiftMember {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Recipient = x.Recipient.Copy()
+	copied.Position = copyShallowPointer(x.Position)
+	copied.Delay = copyShallowPointer(x.Delay)
+	copied.EscalationType = copyShallowPointer(x.EscalationType)
+	copied.InRotation = copyShallowPointer(x.InRotation)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PushShiftParams) Equal(other *PushShiftParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Name != other.Name {
+		return false
+	}
+	if !equalPointer(x.Start, other.Start, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.End, other.End, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if x.Timezone != other.Timezone {
+		return false
+	}
+	if !x.Recurrence.Equal(other.Recurrence) {
+		return false
+	}
+	if !equalSlice(x.Members, other.Members, func(x, y *PushShiftMember) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PushShiftParams) Copy() *PushShiftParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Start = copyShallowPointer(x.Start)
+	copied.End = copyShallowPointer(x.End)
+	copied.Recurrence = x.Recurrence.Copy()
+	copied.Members = copySlice(x.Members, func(x *PushShiftMember) *PushShiftMember { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PushSiteParams) Equal(other *PushSiteParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Name != other.Name {
+		return false
+	}
+	if x.Country != other.Country {
+		return false
+	}
+	if x.Language != other.Language {
+		return false
+	}
+	if x.Timezone != other.Timezone {
+		return false
+	}
+	if !equalMap(x.Address1, other.Address1, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.Address2, other.Address2, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.City, other.City, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if !equalMap(x.Latitude, other.Latitude, func(x, y float64) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.Longitude, other.Longitude, func(x, y float64) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.PostalCode, other.PostalCode, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.State, other.State, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PushSiteParams) Copy() *PushSiteParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Address1 = copyMap(x.Address1, func(x string) string { return x })
+	copied.Address2 = copyMap(x.Address2, func(x string) string { return x })
+	copied.City = copyMap(x.City, func(x string) string { return x })
+	copied.Latitude = copyMap(x.Latitude, func(x float64) float64 { return x })
+	copied.Longitude = copyMap(x.Longitude, func(x float64) float64 { return x })
+	copied.PostalCode = copyMap(x.PostalCode, func(x string) string { return x })
+	copied.State = copyMap(x.State, func(x string) string { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *PushSubscriptionParams) Equal(other *PushSubscriptionParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Name != other.Name {
+		return false
+	}
+	if !x.Form.Equal(&other.Form) {
+		return false
+	}
+	if x.ID != other.ID {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !x.Owner.Equal(other.Owner) {
+		return false
+	}
+	if !equalMap(x.Criteria, other.Criteria, func(x, y interface{}) bool { return reflect.DeepEqual(x, y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.NotifyOwner, other.NotifyOwner) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *PushSubscriptionParams) Copy() *PushSubscriptionParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Form = *x.Form.Copy()
+	copied.Description = copyShallowPointer(x.Description)
+	copied.Owner = x.Owner.Copy()
+	copied.Criteria = copyMap(x.Criteria, func(x interface{}) interface{} { return copyInterface(x) })
+	copied.NotifyOwner = copyShallowPointer(x.NotifyOwner)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *QuotaDetails) Equal(other *QuotaDetails) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.Total, other.Total) {
+		return false
+	}
+	if !equalComparablePointer(x.Active, other.Active) {
+		return false
+	}
+	if !equalComparablePointer(x.Unused, other.Unused) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *QuotaDetails) Copy() *QuotaDetails {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Total = copyShallowPointer(x.Total)
+	copied.Active = copyShallowPointer(x.Active)
+	copied.Unused = copyShallowPointer(x.Unused)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *RecipientPointer) Equal(other *RecipientPointer) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *RecipientPointer) Copy() *RecipientPointer {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Type = copyShallowPointer(x.Type)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *RecipientReference) Equal(other *RecipientReference) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.TargetName, other.TargetName) {
+		return false
+	}
+	if !equalComparablePointer(x.RecipientType, other.RecipientType) {
+		return false
+	}
+	if !x.Site.Equal(other.Site) {
+		return false
+	}
+	if !x.Supervisors.Equal(other.Supervisors) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !x.Observers.Equal(other.Observers) {
+		return false
+	}
+	if !equalComparablePointer(x.AllowDuplicates, other.AllowDuplicates) {
+		return false
+	}
+	if !equalComparablePointer(x.GroupType, other.GroupType) {
+		return false
+	}
+	if !equalComparablePointer(x.ObservedByAll, other.ObservedByAll) {
+		return false
+	}
+	if !equalComparablePointer(x.ResponseCount, other.ResponseCount) {
+		return false
+	}
+	if !equalComparablePointer(x.ResponseCountThreshold, other.ResponseCountThreshold) {
+		return false
+	}
+	if !equalComparablePointer(x.UseDefaultDevices, other.UseDefaultDevices) {
+		return false
+	}
+	if !x.Services.Equal(other.Services) {
+		return false
+	}
+	if !equalComparablePointer(x.FirstName, other.FirstName) {
+		return false
+	}
+	if !equalComparablePointer(x.Language, other.Language) {
+		return false
+	}
+	if !equalComparablePointer(x.LastName, other.LastName) {
+		return false
+	}
+	if !equalComparablePointer(x.LicenseType, other.LicenseType) {
+		return false
+	}
+	if !equalComparablePointer(x.PhoneLogin, other.PhoneLogin) {
+		return false
+	}
+	if !equalComparablePointer(x.PhonePin, other.PhonePin) {
+		return false
+	}
+	if !equalPointer(x.Properties, other.Properties, func(x, y map[string]string) bool { return equalMap(x, y, func(x, y string) bool { return x == y }) }) {
+		return false
+	}
+	if !x.Roles.Equal(other.Roles) {
+		return false
+	}
+	if !equalComparablePointer(x.Timezone, other.Timezone) {
+		return false
+	}
+	if !equalPointer(x.LastLogin, other.LastLogin, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.WebLogin, other.WebLogin) {
+		return false
+	}
+	if !equalComparablePointer(x.DefaultDevice, other.DefaultDevice) {
+		return false
+	}
+	if !equalComparablePointer(x.Delay, other.Delay) {
+		return false
+	}
+	if !equalComparablePointer(x.DeviceType, other.DeviceType) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !x.Owner.Equal(other.Owner) {
+		return false
+	}
+	if !equalComparablePointer(x.PriorityThreshold, other.PriorityThreshold) {
+		return false
+	}
+	if !x.Provider.Equal(other.Provider) {
+		return false
+	}
+	if !equalComparablePointer(x.Sequence, other.Sequence) {
+		return false
+	}
+	if !equalComparablePointer(x.TestStatus, other.TestStatus) {
+		return false
+	}
+	if !equalPointer(x.Timeframes, other.Timeframes, func(x, y []DeviceTimeframe) bool {
+		return equalSlice(x, y, func(x, y DeviceTimeframe) bool { return x.Equal(&y) })
+	}) {
+		return false
+	}
+	if !equalComparablePointer(x.ExternallyOwned, other.ExternallyOwned) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *RecipientReference) Copy() *RecipientReference {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.TargetName = copyShallowPointer(x.TargetName)
+	copied.RecipientType = copyShallowPointer(x.RecipientType)
+	copied.Site = x.Site.Copy()
+	copied.Supervisors = x.Supervisors.Copy()
+	copied.Description = copyShallowPointer(x.Description)
+	copied.Observers = x.Observers.Copy()
+	copied.AllowDuplicates = copyShallowPointer(x.AllowDuplicates)
+	copied.GroupType = copyShallowPointer(x.GroupType)
+	copied.ObservedByAll = copyShallowPointer(x.ObservedByAll)
+	copied.ResponseCount = copyShallowPointer(x.ResponseCount)
+	copied.ResponseCountThreshold = copyShallowPointer(x.ResponseCountThreshold)
+	copied.UseDefaultDevices = copyShallowPointer(x.UseDefaultDevices)
+	copied.Services = x.Services.Copy()
+	copied.FirstName = copyShallowPointer(x.FirstName)
+	copied.Language = copyShallowPointer(x.Language)
+	copied.LastName = copyShallowPointer(x.LastName)
+	copied.LicenseType = copyShallowPointer(x.LicenseType)
+	copied.PhoneLogin = copyShallowPointer(x.PhoneLogin)
+	copied.PhonePin = copyShallowPointer(x.PhonePin)
+	copied.Properties = copyPointer(x.Properties, func(x map[string]string) map[string]string { return copyMap(x, func(x string) string { return x }) })
+	copied.Roles = x.Roles.Copy()
+	copied.Timezone = copyShallowPointer(x.Timezone)
+	copied.LastLogin = copyShallowPointer(x.LastLogin)
+	copied.WebLogin = copyShallowPointer(x.WebLogin)
+	copied.DefaultDevice = copyShallowPointer(x.DefaultDevice)
+	copied.Delay = copyShallowPointer(x.Delay)
+	copied.DeviceType = copyShallowPointer(x.DeviceType)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.Owner = x.Owner.Copy()
+	copied.PriorityThreshold = copyShallowPointer(x.PriorityThreshold)
+	copied.Provider = x.Provider.Copy()
+	copied.Sequence = copyShallowPointer(x.Sequence)
+	copied.TestStatus = copyShallowPointer(x.TestStatus)
+	copied.Timeframes = copyPointer(x.Timeframes, func(x []DeviceTimeframe) []DeviceTimeframe {
+		return copySlice(x, func(x DeviceTimeframe) DeviceTimeframe { return *x.Copy() })
+	})
+	copied.ExternallyOwned = copyShallowPointer(x.ExternallyOwned)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ReferenceById) Equal(other *ReferenceById) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ReferenceById) Copy() *ReferenceById {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ReferenceByName) Equal(other *ReferenceByName) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ReferenceByName) Copy() *ReferenceByName {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Name = copyShallowPointer(x.Name)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ResolvedRecipients) Equal(other *ResolvedRecipients) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.People, other.People, func(x, y *Person) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Devices, other.Devices, func(x, y *Device) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Unresolved, other.Unresolved, func(x, y *UnresolvedRecipient) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ResolvedRecipients) Copy() *ResolvedRecipients {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.People = copySlice(x.People, func(x *Person) *Person { return x.Copy() })
+	copied.Devices = copySlice(x.Devices, func(x *Device) *Device { return x.Copy() })
+	copied.Unresolved = copySlice(x.Unresolved, func(x *UnresolvedRecipient) *UnresolvedRecipient { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *RespondToEventParams) Equal(other *RespondToEventParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.EventID != other.EventID {
+		return false
+	}
+	if x.Recipient != other.Recipient {
+		return false
+	}
+	if x.Response != other.Response {
+		return false
+	}
+	if x.Device != other.Device {
+		return false
+	}
+	if !equalComparablePointer(x.Comment, other.Comment) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *RespondToEventParams) Copy() *RespondToEventParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Comment = copyShallowPointer(x.Comment)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ResponseOption) Equal(other *ResponseOption) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Number, other.Number) {
+		return false
+	}
+	if !equalComparablePointer(x.Text, other.Text) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !equalComparablePointer(x.Prompt, other.Prompt) {
+		return false
+	}
+	if !equalComparablePointer(x.Action, other.Action) {
+		return false
+	}
+	if !equalComparablePointer(x.Contribution, other.Contribution) {
+		return false
+	}
+	if !equalComparablePointer(x.JoinConference, other.JoinConference) {
+		return false
+	}
+	if !equalComparablePointer(x.AllowComments, other.AllowComments) {
+		return false
+	}
+	if !equalComparablePointer(x.RedirectURL, other.RedirectURL) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ResponseOption) Copy() *ResponseOption {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Number = copyShallowPointer(x.Number)
+	copied.Text = copyShallowPointer(x.Text)
+	copied.Description = copyShallowPointer(x.Description)
+	copied.Prompt = copyShallowPointer(x.Prompt)
+	copied.Action = copyShallowPointer(x.Action)
+	copied.Contribution = copyShallowPointer(x.Contribution)
+	copied.JoinConference = copyShallowPointer(x.JoinConference)
+	copied.AllowComments = copyShallowPointer(x.AllowComments)
+	copied.RedirectURL = copyShallowPointer(x.RedirectURL)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ResponseOptionPagination) Equal(other *ResponseOptionPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.ResponseOptions, other.ResponseOptions, func(x, y *ResponseOption) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ResponseOptionPagination) Copy() *ResponseOptionPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.ResponseOptions = copySlice(x.ResponseOptions, func(x *ResponseOption) *ResponseOption { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *RetryPolicy) Equal(other *RetryPolicy) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.MaxRetries != other.MaxRetries {
+		return false
+	}
+	if x.MinRetryDelay != other.MinRetryDelay {
+		return false
+	}
+	if x.MaxRetryDelay != other.MaxRetryDelay {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *RetryPolicy) Copy() *RetryPolicy {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Role) Equal(other *Role) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Role) Copy() *Role {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.Description = copyShallowPointer(x.Description)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *RolePagination) Equal(other *RolePagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Roles, other.Roles, func(x, y *Role) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *RolePagination) Copy() *RolePagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Roles = copySlice(x.Roles, func(x *Role) *Role { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *SearchQuery) Equal(other *SearchQuery) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Terms != other.Terms {
+		return false
+	}
+	if !equalSlice(x.Fields, other.Fields, func(x, y SearchField) bool { return x == y }) {
+		return false
+	}
+	if x.Operand != other.Operand {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *SearchQuery) Copy() *SearchQuery {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Fields = copySlice(x.Fields, func(x SearchField) SearchField { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *SenderPermission) Equal(other *SenderPermission) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !x.Recipient.Equal(other.Recipient) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *SenderPermission) Copy() *SenderPermission {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Recipient = x.Recipient.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *SenderPermissionPagination) Equal(other *SenderPermissionPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Permissions, other.Permissions, func(x, y *SenderPermission) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *SenderPermissionPagination) Copy() *SenderPermissionPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Permissions = copySlice(x.Permissions, func(x *SenderPermission) *SenderPermission { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Service) Equal(other *Service) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.TargetName, other.TargetName) {
+		return false
+	}
+	if !equalComparablePointer(x.RecipientType, other.RecipientType) {
+		return false
+	}
+	if !equalComparablePointer(x.ServiceType, other.ServiceType) {
+		return false
+	}
+	if !equalComparablePointer(x.ServiceTier, other.ServiceTier) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !equalSlice(x.ServiceLinks, other.ServiceLinks, func(x, y *ServiceLink) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !x.OwnedBy.Equal(other.OwnedBy) {
+		return false
+	}
+	if !equalComparablePointer(x.ExternallyOwned, other.ExternallyOwned) {
+		return false
+	}
+	if !equalComparablePointer(x.Status, other.Status) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Service) Copy() *Service {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.TargetName = copyShallowPointer(x.TargetName)
+	copied.RecipientType = copyShallowPointer(x.RecipientType)
+	copied.ServiceType = copyShallowPointer(x.ServiceType)
+	copied.ServiceTier = copyShallowPointer(x.ServiceTier)
+	copied.Description = copyShallowPointer(x.Description)
+	copied.ServiceLinks = copySlice(x.ServiceLinks, func(x *ServiceLink) *ServiceLink { return x.Copy() })
+	copied.OwnedBy = x.OwnedBy.Copy()
+	copied.ExternallyOwned = copyShallowPointer(x.ExternallyOwned)
+	copied.Status = copyShallowPointer(x.Status)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ServiceCatalog) Equal(other *ServiceCatalog) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Services, other.Services, func(x, y *CatalogService) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Dependencies, other.Dependencies, func(x, y *CatalogDependency) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ServiceCatalog) Copy() *ServiceCatalog {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Services = copySlice(x.Services, func(x *CatalogService) *CatalogService { return x.Copy() })
+	copied.Dependencies = copySlice(x.Dependencies, func(x *CatalogDependency) *CatalogDependency { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ServiceCatalogDiff) Equal(other *ServiceCatalogDiff) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.CreatedServices, other.CreatedServices, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalSlice(x.UpdatedServices, other.UpdatedServices, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalSlice(x.DeletedServices, other.DeletedServices, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalSlice(x.CreatedDependencies, other.CreatedDependencies, func(x, y *CatalogDependency) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.DeletedDependencies, other.DeletedDependencies, func(x, y *CatalogDependency) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ServiceCatalogDiff) Copy() *ServiceCatalogDiff {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.CreatedServices = copySlice(x.CreatedServices, func(x string) string { return x })
+	copied.UpdatedServices = copySlice(x.UpdatedServices, func(x string) string { return x })
+	copied.DeletedServices = copySlice(x.DeletedServices, func(x string) string { return x })
+	copied.CreatedDependencies = copySlice(x.CreatedDependencies, func(x *CatalogDependency) *CatalogDependency { return x.Copy() })
+	copied.DeletedDependencies = copySlice(x.DeletedDependencies, func(x *CatalogDependency) *CatalogDependency { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ServiceDependency) Equal(other *ServiceDependency) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !x.Service.Equal(other.Service) {
+		return false
+	}
+	if !x.DependentService.Equal(other.DependentService) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ServiceDependency) Copy() *ServiceDependency {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Service = x.Service.Copy()
+	copied.DependentService = x.DependentService.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ServiceDependencyPagination) Equal(other *ServiceDependencyPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Data, other.Data, func(x, y *ServiceDependency) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ServiceDependencyPagination) Copy() *ServiceDependencyPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Data = copySlice(x.Data, func(x *ServiceDependency) *ServiceDependency { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ServiceLink) Equal(other *ServiceLink) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.Label, other.Label) {
+		return false
+	}
+	if !equalComparablePointer(x.URL, other.URL) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ServiceLink) Copy() *ServiceLink {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Label = copyShallowPointer(x.Label)
+	copied.URL = copyShallowPointer(x.URL)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ServiceLinksPagination) Equal(other *ServiceLinksPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Data, other.Data, func(x, y *ServiceLink) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ServiceLinksPagination) Copy() *ServiceLinksPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Data = copySlice(x.Data, func(x *ServiceLink) *ServiceLink { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ServicePagination) Equal(other *ServicePagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Services, other.Services, func(x, y *Service) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ServicePagination) Copy() *ServicePagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Services = copySlice(x.Services, func(x *Service) *Service { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ServiceReference) Equal(other *ServiceReference) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.TargetName, other.TargetName) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ServiceReference) Copy() *ServiceReference {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.TargetName = copyShallowPointer(x.TargetName)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Shift) Equal(other *Shift) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !x.Group.Equal(other.Group) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !equalPointer(x.Start, other.Start, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.End, other.End, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.Timezone, other.Timezone) {
+		return false
+	}
+	if !x.Recurrence.Equal(other.Recurrence) {
+		return false
+	}
+	if !equalSlice(x.Members, other.Members, func(x, y *ShiftMember) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Shift) Copy() *Shift {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Group = x.Group.Copy()
+	copied.Name = copyShallowPointer(x.Name)
+	copied.Start = copyShallowPointer(x.Start)
+	copied.End = copyShallowPointer(x.End)
+	copied.Timezone = copyShallowPointer(x.Timezone)
+	copied.Recurrence = x.Recurrence.Copy()
+	copied.Members = copySlice(x.Members, func(x *ShiftMember) *ShiftMember { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ShiftEnd) Equal(other *ShiftEnd) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.EndBy, other.EndBy) {
+		return false
+	}
+	if !equalComparablePointer(x.Date, other.Date) {
+		return false
+	}
+	if !equalComparablePointer(x.Repetitions, other.Repetitions) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ShiftEnd) Copy() *ShiftEnd {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.EndBy = copyShallowPointer(x.EndBy)
+	copied.Date = copyShallowPointer(x.Date)
+	copied.Repetitions = copyShallowPointer(x.Repetitions)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ShiftMember) Equal(other *ShiftMember) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Recipient.Equal(other.Recipient) {
+		return false
+	}
+	if !x.Shift.Equal(other.Shift) {
+		return false
+	}
+	if !equalComparablePointer(x.Position, other.Position) {
+		return false
+	}
+	if !equalComparablePointer(x.Delay, other.Delay) {
+		return false
+	}
+	if !equalComparablePointer(x.EscalationType, other.EscalationType) {
+		return false
+	}
+	if !equalComparablePointer(x.InRotation, other.InRotation) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ShiftMember) Copy() *ShiftMember {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Recipient = x.Recipient.Copy()
+	copied.Shift = x.Shift.Copy()
+	copied.Position = copyShallowPointer(x.Position)
+	copied.Delay = copyShallowPointer(x.Delay)
+	copied.EscalationType = copyShallowPointer(x.EscalationType)
+	copied.InRotation = copyShallowPointer(x.InRotation)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ShiftMemberPagination) Equal(other *ShiftMemberPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Members, other.Members, func(x, y *ShiftMember) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ShiftMemberPagination) Copy() *ShiftMemberPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Members = copySlice(x.Members, func(x *ShiftMember) *ShiftMember { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ShiftPagination) Equal(other *ShiftPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Shifts, other.Shifts, func(x, y *Shift) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ShiftPagination) Copy() *ShiftPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Shifts = copySlice(x.Shifts, func(x *Shift) *Shift { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ShiftRecurrence) Equal(other *ShiftRecurrence) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.Frequency, other.Frequency) {
+		return false
+	}
+	if !equalComparablePointer(x.RepeatEvery, other.RepeatEvery) {
+		return false
+	}
+	if !equalSlice(x.OnDays, other.OnDays, func(x, y *string) bool { return equalComparablePointer(x, y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.On, other.On) {
+		return false
+	}
+	if !equalSlice(x.Months, other.Months, func(x, y *string) bool { return equalComparablePointer(x, y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.DateOfMonth, other.DateOfMonth) {
+		return false
+	}
+	if !equalComparablePointer(x.DayOfWeekClassifier, other.DayOfWeekClassifier) {
+		return false
+	}
+	if !equalComparablePointer(x.DayOfWeek, other.DayOfWeek) {
+		return false
+	}
+	if !x.End.Equal(other.End) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ShiftRecurrence) Copy() *ShiftRecurrence {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Frequency = copyShallowPointer(x.Frequency)
+	copied.RepeatEvery = copyShallowPointer(x.RepeatEvery)
+	copied.OnDays = copySlice(x.OnDays, func(x *string) *string { return copyShallowPointer(x) })
+	copied.On = copyShallowPointer(x.On)
+	copied.Months = copySlice(x.Months, func(x *string) *string { return copyShallowPointer(x) })
+	copied.DateOfMonth = copyShallowPointer(x.DateOfMonth)
+	copied.DayOfWeekClassifier = copyShallowPointer(x.DayOfWeekClassifier)
+	copied.DayOfWeek = copyShallowPointer(x.DayOfWeek)
+	copied.End = x.End.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *ShiftReference) Equal(other *ShiftReference) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *ShiftReference) Copy() *ShiftReference {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Name = copyShallowPointer(x.Name)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Site) Equal(other *Site) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.Address1, other.Address1) {
+		return false
+	}
+	if !equalComparablePointer(x.Address2, other.Address2) {
+		return false
+	}
+	if !equalComparablePointer(x.City, other.City) {
+		return false
+	}
+	if !equalComparablePointer(x.Country, other.Country) {
+		return false
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Language, other.Language) {
+		return false
+	}
+	if !equalComparablePointer(x.Latitude, other.Latitude) {
+		return false
+	}
+	if !equalComparablePointer(x.Longitude, other.Longitude) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !equalComparablePointer(x.PostalCode, other.PostalCode) {
+		return false
+	}
+	if !equalComparablePointer(x.State, other.State) {
+		return false
+	}
+	if !equalComparablePointer(x.Status, other.Status) {
+		return false
+	}
+	if !equalComparablePointer(x.Timezone, other.Timezone) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Site) Copy() *Site {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Address1 = copyShallowPointer(x.Address1)
+	copied.Address2 = copyShallowPointer(x.Address2)
+	copied.City = copyShallowPointer(x.City)
+	copied.Country = copyShallowPointer(x.Country)
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Language = copyShallowPointer(x.Language)
+	copied.Latitude = copyShallowPointer(x.Latitude)
+	copied.Longitude = copyShallowPointer(x.Longitude)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.PostalCode = copyShallowPointer(x.PostalCode)
+	copied.State = copyShallowPointer(x.State)
+	copied.Status = copyShallowPointer(x.Status)
+	copied.Timezone = copyShallowPointer(x.Timezone)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *SiteDistribution) Equal(other *SiteDistribution) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Site.Equal(other.Site) {
+		return false
+	}
+	if x.ActivePeople != other.ActivePeople {
+		return false
+	}
+	if x.ActiveDevices != other.ActiveDevices {
+		return false
+	}
+	if !equalMap(x.LicenseTypes, other.LicenseTypes, func(x, y int64) bool { return x == y }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *SiteDistribution) Copy() *SiteDistribution {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Site = x.Site.Copy()
+	copied.LicenseTypes = copyMap(x.LicenseTypes, func(x int64) int64 { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *SiteDistributionParams) Equal(other *SiteDistributionParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Sites.Equal(&other.Sites) {
+		return false
+	}
+	if x.IncludeLicenseTypes != other.IncludeLicenseTypes {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *SiteDistributionParams) Copy() *SiteDistributionParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Sites = *x.Sites.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *SitePagination) Equal(other *SitePagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Sites, other.Sites, func(x, y *Site) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *SitePagination) Copy() *SitePagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Sites = copySlice(x.Sites, func(x *Site) *Site { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Snapshot) Equal(other *Snapshot) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.ExportedAt != other.ExportedAt {
+		return false
+	}
+	if !equalSlice(x.Sites, other.Sites, func(x, y *Site) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.People, other.People, func(x, y *Person) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Devices, other.Devices, func(x, y *Device) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Groups, other.Groups, func(x, y *Group) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalMap(x.Rosters, other.Rosters, func(x, y []*GroupMember) bool {
+		return equalSlice(x, y, func(x, y *GroupMember) bool { return x.Equal(y) })
+	}) {
+		return false
+	}
+	if !equalMap(x.Shifts, other.Shifts, func(x, y []*Shift) bool { return equalSlice(x, y, func(x, y *Shift) bool { return x.Equal(y) }) }) {
+		return false
+	}
+	if !equalSlice(x.Services, other.Services, func(x, y *Service) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.ServiceDependencies, other.ServiceDependencies, func(x, y *ServiceDependency) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.DynamicTeams, other.DynamicTeams, func(x, y *DynamicTeam) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Snapshot) Copy() *Snapshot {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Sites = copySlice(x.Sites, func(x *Site) *Site { return x.Copy() })
+	copied.People = copySlice(x.People, func(x *Person) *Person { return x.Copy() })
+	copied.Devices = copySlice(x.Devices, func(x *Device) *Device { return x.Copy() })
+	copied.Groups = copySlice(x.Groups, func(x *Group) *Group { return x.Copy() })
+	copied.Rosters = copyMap(x.Rosters, func(x []*GroupMember) []*GroupMember {
+		return copySlice(x, func(x *GroupMember) *GroupMember { return x.Copy() })
+	})
+	copied.Shifts = copyMap(x.Shifts, func(x []*Shift) []*Shift { return copySlice(x, func(x *Shift) *Shift { return x.Copy() }) })
+	copied.Services = copySlice(x.Services, func(x *Service) *Service { return x.Copy() })
+	copied.ServiceDependencies = copySlice(x.ServiceDependencies, func(x *ServiceDependency) *ServiceDependency { return x.Copy() })
+	copied.DynamicTeams = copySlice(x.DynamicTeams, func(x *DynamicTeam) *DynamicTeam { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *SubscriberPagination) Equal(other *SubscriberPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Subscribers, other.Subscribers, func(x, y *PersonReference) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *SubscriberPagination) Copy() *SubscriberPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Subscribers = copySlice(x.Subscribers, func(x *PersonReference) *PersonReference { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *Subscription) Equal(other *Subscription) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.Name, other.Name) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !x.Form.Equal(other.Form) {
+		return false
+	}
+	if !x.Owner.Equal(other.Owner) {
+		return false
+	}
+	if !equalMap(x.Criteria, other.Criteria, func(x, y interface{}) bool { return reflect.DeepEqual(x, y) }) {
+		return false
+	}
+	if !equalComparablePointer(x.NotifyOwner, other.NotifyOwner) {
+		return false
+	}
+	if !equalPointer(x.Created, other.Created, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *Subscription) Copy() *Subscription {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.Name = copyShallowPointer(x.Name)
+	copied.Description = copyShallowPointer(x.Description)
+	copied.Form = x.Form.Copy()
+	copied.Owner = x.Owner.Copy()
+	copied.Criteria = copyMap(x.Criteria, func(x interface{}) interface{} { return copyInterface(x) })
+	copied.NotifyOwner = copyShallowPointer(x.NotifyOwner)
+	copied.Created = copyShallowPointer(x.Created)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *SubscriptionPagination) Equal(other *SubscriptionPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Subscriptions, other.Subscriptions, func(x, y *Subscription) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *SubscriptionPagination) Copy() *SubscriptionPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Subscriptions = copySlice(x.Subscriptions, func(x *Subscription) *Subscription { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *SyncWindow) Equal(other *SyncWindow) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalPointer(x.From, other.From, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !x.To.Equal(other.To) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *SyncWindow) Copy() *SyncWindow {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.From = copyShallowPointer(x.From)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *TemporaryAbsence) Equal(other *TemporaryAbsence) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.ID, other.ID) {
+		return false
+	}
+	if !equalComparablePointer(x.AbsenceType, other.AbsenceType) {
+		return false
+	}
+	if !x.Member.Equal(other.Member) {
+		return false
+	}
+	if !equalPointer(x.Start, other.Start, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalPointer(x.End, other.End, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !x.Group.Equal(other.Group) {
+		return false
+	}
+	if !x.Replacement.Equal(other.Replacement) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *TemporaryAbsence) Copy() *TemporaryAbsence {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.ID = copyShallowPointer(x.ID)
+	copied.AbsenceType = copyShallowPointer(x.AbsenceType)
+	copied.Member = x.Member.Copy()
+	copied.Start = copyShallowPointer(x.Start)
+	copied.End = copyShallowPointer(x.End)
+	copied.Group = x.Group.Copy()
+	copied.Replacement = x.Replacement.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *TemporaryAbsencePagination) Equal(other *TemporaryAbsencePagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Absences, other.Absences, func(x, y *TemporaryAbsence) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *TemporaryAbsencePagination) Copy() *TemporaryAbsencePagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Absences = copySlice(x.Absences, func(x *TemporaryAbsence) *TemporaryAbsence { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *TriggerEventParams) Equal(other *TriggerEventParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.FormID != other.FormID {
+		return false
+	}
+	if !equalSlice(x.Recipients, other.Recipients, func(x, y *EventRecipient) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.Priority != other.Priority {
+		return false
+	}
+	if !equalMap(x.Properties, other.Properties, func(x, y interface{}) bool { return reflect.DeepEqual(x, y) }) {
+		return false
+	}
+	if !x.Conference.Equal(other.Conference) {
+		return false
+	}
+	if !equalSlice(x.ResponseOptions, other.ResponseOptions, func(x, y *ResponseOption) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.Attachments, other.Attachments, func(x, y *ReferenceById) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !x.Schedule.Equal(other.Schedule) {
+		return false
+	}
+	if !equalComparablePointer(x.ExpirationInMinutes, other.ExpirationInMinutes) {
+		return false
+	}
+	if !equalComparablePointer(x.BypassPhoneIntro, other.BypassPhoneIntro) {
+		return false
+	}
+	if !equalComparablePointer(x.OverrideDeviceRestrictions, other.OverrideDeviceRestrictions) {
+		return false
+	}
+	if !equalComparablePointer(x.RequirePhonePassword, other.RequirePhonePassword) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *TriggerEventParams) Copy() *TriggerEventParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Recipients = copySlice(x.Recipients, func(x *EventRecipient) *EventRecipient { return x.Copy() })
+	copied.Properties = copyMap(x.Properties, func(x interface{}) interface{} { return copyInterface(x) })
+	copied.Conference = x.Conference.Copy()
+	copied.ResponseOptions = copySlice(x.ResponseOptions, func(x *ResponseOption) *ResponseOption { return x.Copy() })
+	copied.Attachments = copySlice(x.Attachments, func(x *ReferenceById) *ReferenceById { return x.Copy() })
+	copied.Schedule = x.Schedule.Copy()
+	copied.ExpirationInMinutes = copyShallowPointer(x.ExpirationInMinutes)
+	copied.BypassPhoneIntro = copyShallowPointer(x.BypassPhoneIntro)
+	copied.OverrideDeviceRestrictions = copyShallowPointer(x.OverrideDeviceRestrictions)
+	copied.RequirePhonePassword = copyShallowPointer(x.RequirePhonePassword)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *UnresolvedRecipient) Equal(other *UnresolvedRecipient) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Recipient.Equal(other.Recipient) {
+		return false
+	}
+	if x.Reason != other.Reason {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *UnresolvedRecipient) Copy() *UnresolvedRecipient {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Recipient = x.Recipient.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *UpdateIncidentParams) Equal(other *UpdateIncidentParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.Summary, other.Summary) {
+		return false
+	}
+	if !equalComparablePointer(x.Description, other.Description) {
+		return false
+	}
+	if !equalComparablePointer(x.Severity, other.Severity) {
+		return false
+	}
+	if !equalComparablePointer(x.Status, other.Status) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *UpdateIncidentParams) Copy() *UpdateIncidentParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Summary = copyShallowPointer(x.Summary)
+	copied.Description = copyShallowPointer(x.Description)
+	copied.Severity = copyShallowPointer(x.Severity)
+	copied.Status = copyShallowPointer(x.Status)
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *UpdateServiceParams) Equal(other *UpdateServiceParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.TargetName != other.TargetName {
+		return false
+	}
+	if !equalMap(x.Description, other.Description, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if x.ServiceType != other.ServiceType {
+		return false
+	}
+	if !equalMap(x.ServiceTier, other.ServiceTier, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.OwnedBy, other.OwnedBy, func(x, y *GroupReference) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !equalSlice(x.ServiceLinks, other.ServiceLinks, func(x, y *ServiceLink) bool { return x.Equal(y) }) {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *UpdateServiceParams) Copy() *UpdateServiceParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Description = copyMap(x.Description, func(x string) string { return x })
+	copied.ServiceTier = copyMap(x.ServiceTier, func(x string) string { return x })
+	copied.OwnedBy = copyMap(x.OwnedBy, func(x *GroupReference) *GroupReference { return x.Copy() })
+	copied.ServiceLinks = copySlice(x.ServiceLinks, func(x *ServiceLink) *ServiceLink { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *UpdateSiteParams) Equal(other *UpdateSiteParams) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Name != other.Name {
+		return false
+	}
+	if x.Country != other.Country {
+		return false
+	}
+	if x.Language != other.Language {
+		return false
+	}
+	if x.Timezone != other.Timezone {
+		return false
+	}
+	if !equalMap(x.Address1, other.Address1, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.Address2, other.Address2, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.City, other.City, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.Latitude, other.Latitude, func(x, y float64) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.Longitude, other.Longitude, func(x, y float64) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.PostalCode, other.PostalCode, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if !equalMap(x.State, other.State, func(x, y string) bool { return x == y }) {
+		return false
+	}
+	if x.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *UpdateSiteParams) Copy() *UpdateSiteParams {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Address1 = copyMap(x.Address1, func(x string) string { return x })
+	copied.Address2 = copyMap(x.Address2, func(x string) string { return x })
+	copied.City = copyMap(x.City, func(x string) string { return x })
+	copied.Latitude = copyMap(x.Latitude, func(x float64) float64 { return x })
+	copied.Longitude = copyMap(x.Longitude, func(x float64) float64 { return x })
+	copied.PostalCode = copyMap(x.PostalCode, func(x string) string { return x })
+	copied.State = copyMap(x.State, func(x string) string { return x })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *UserDelivery) Equal(other *UserDelivery) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !x.Event.Equal(other.Event) {
+		return false
+	}
+	if !x.Person.Equal(other.Person) {
+		return false
+	}
+	if !equalComparablePointer(x.DeliveryStatus, other.DeliveryStatus) {
+		return false
+	}
+	if !equalPointer(x.At, other.At, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !x.Response.Equal(other.Response) {
+		return false
+	}
+	if !equalSlice(x.Notifications, other.Notifications, func(x, y *DeliveryNotification) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *UserDelivery) Copy() *UserDelivery {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Event = x.Event.Copy()
+	copied.Person = x.Person.Copy()
+	copied.DeliveryStatus = copyShallowPointer(x.DeliveryStatus)
+	copied.At = copyShallowPointer(x.At)
+	copied.Response = x.Response.Copy()
+	copied.Notifications = copySlice(x.Notifications, func(x *DeliveryNotification) *DeliveryNotification { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *UserDeliveryPagination) Equal(other *UserDeliveryPagination) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalSlice(x.Deliveries, other.Deliveries, func(x, y *UserDelivery) bool { return x.Equal(y) }) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *UserDeliveryPagination) Copy() *UserDeliveryPagination {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Pagination = x.Pagination.Copy()
+	copied.Deliveries = copySlice(x.Deliveries, func(x *UserDelivery) *UserDelivery { return x.Copy() })
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *UserDeliveryResponse) Equal(other *UserDeliveryResponse) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.Text, other.Text) {
+		return false
+	}
+	if !equalComparablePointer(x.Contribution, other.Contribution) {
+		return false
+	}
+	if !equalComparablePointer(x.Comment, other.Comment) {
+		return false
+	}
+	if !equalPointer(x.Received, other.Received, func(x, y Timestamp) bool { return x.Equal(y) }) {
+		return false
+	}
+	if !x.Device.Equal(other.Device) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *UserDeliveryResponse) Copy() *UserDeliveryResponse {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.Text = copyShallowPointer(x.Text)
+	copied.Contribution = copyShallowPointer(x.Contribution)
+	copied.Comment = copyShallowPointer(x.Comment)
+	copied.Received = copyShallowPointer(x.Received)
+	copied.Device = x.Device.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *UserQuotas) Equal(other *UserQuotas) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if !equalComparablePointer(x.StakeholderUsersEnabled, other.StakeholderUsersEnabled) {
+		return false
+	}
+	if !x.StakeholderUsers.Equal(other.StakeholderUsers) {
+		return false
+	}
+	if !x.FullUsers.Equal(other.FullUsers) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *UserQuotas) Copy() *UserQuotas {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	copied.StakeholderUsersEnabled = copyShallowPointer(x.StakeholderUsersEnabled)
+	copied.StakeholderUsers = x.StakeholderUsers.Copy()
+	copied.FullUsers = x.FullUsers.Copy()
+	return &copied
+}
+
+// Equal reports whether x and other hold the same values, ignoring pagination links.
+func (x *XMattersError) Equal(other *XMattersError) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	if x.Code != other.Code {
+		return false
+	}
+	if x.Reason != other.Reason {
+		return false
+	}
+	if x.Message != other.Message {
+		return false
+	}
+	if x.Subcode != other.Subcode {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of x, or nil if x is nil.
+func (x *XMattersError) Copy() *XMattersError {
+	if x == nil {
+		return nil
+	}
+	copied := *x
+	return &copied
+}
+
+// equalPointer reports whether two pointers are both nil or point to equal values.
+func equalPointer[T any](a, b *T, equal func(x, y T) bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equal(*a, *b)
+}
+
+// equalComparablePointer reports whether two pointers are both nil or point to equal comparable values.
+func equalComparablePointer[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// equalSlice reports whether two slices have the same length and equal elements, treating nil as empty.
+func equalSlice[T any](a, b []T, equal func(x, y T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalMap reports whether two maps have the same keys and equal values, treating nil as empty.
+func equalMap[K comparable, V any](a, b map[K]V, equal func(x, y V) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		other, ok := b[key]
+		if !ok || !equal(value, other) {
+			return false
+		}
+	}
+	return true
+}
+
+// copyPointer returns a pointer to a copy of the value a pointer points to, or nil if it is nil.
+func copyPointer[T any](src *T, copyValue func(x T) T) *T {
+	if src == nil {
+		return nil
+	}
+	value := copyValue(*src)
+	return &value
+}
+
+// copyShallowPointer returns a pointer to a copy of a value that has no nested pointers, or nil if it is nil.
+func copyShallowPointer[T any](src *T) *T {
+	if src == nil {
+		return nil
+	}
+	value := *src
+	return &value
+}
+
+// copySlice returns a copy of a slice with each element copied, preserving nil.
+func copySlice[T any](src []T, copyValue func(x T) T) []T {
+	if src == nil {
+		return nil
+	}
+	copied := make([]T, len(src))
+	for i, value := range src {
+		copied[i] = copyValue(value)
+	}
+	return copied
+}
+
+// copyMap returns a copy of a map with each value copied, preserving nil.
+func copyMap[K comparable, V any](src map[K]V, copyValue func(x V) V) map[K]V {
+	if src == nil {
+		return nil
+	}
+	copied := make(map[K]V, len(src))
+	for key, value := range src {
+		copied[key] = copyValue(value)
+	}
+	return copied
+}
+
+// copyInterface returns a deep copy of decoded JSON values, and other values as-is.
+func copyInterface(src interface{}) interface{} {
+	switch value := src.(type) {
+	case map[string]interface{}:
+		return copyMap(value, copyInterface)
+	case []interface{}:
+		return copySlice(value, copyInterface)
+	}
+	return src
+}